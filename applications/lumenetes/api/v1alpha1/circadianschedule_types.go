@@ -4,16 +4,25 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// CircadianAnchor is a named solar event a CircadianKeyframe resolves
-// against (see internal/sun.Times) - "sunrise", "solarNoon", "sunset", or
-// "solarMidnight".
+// CircadianAnchor is what a CircadianKeyframe resolves against - either a
+// named solar event (see internal/sun.Times): "sunrise", "solarNoon",
+// "sunset", "solarMidnight", or one of the civil/nautical/astronomical
+// dawn/dusk twilights - or "clockTime", a fixed wall-clock time in the
+// schedule's TimeZone (see CircadianKeyframe.Time).
 type CircadianAnchor string
 
 const (
-	CircadianAnchorSunrise       CircadianAnchor = "sunrise"
-	CircadianAnchorSolarNoon     CircadianAnchor = "solarNoon"
-	CircadianAnchorSunset        CircadianAnchor = "sunset"
-	CircadianAnchorSolarMidnight CircadianAnchor = "solarMidnight"
+	CircadianAnchorSunrise          CircadianAnchor = "sunrise"
+	CircadianAnchorSolarNoon        CircadianAnchor = "solarNoon"
+	CircadianAnchorSunset           CircadianAnchor = "sunset"
+	CircadianAnchorSolarMidnight    CircadianAnchor = "solarMidnight"
+	CircadianAnchorCivilDawn        CircadianAnchor = "civilDawn"
+	CircadianAnchorCivilDusk        CircadianAnchor = "civilDusk"
+	CircadianAnchorNauticalDawn     CircadianAnchor = "nauticalDawn"
+	CircadianAnchorNauticalDusk     CircadianAnchor = "nauticalDusk"
+	CircadianAnchorAstronomicalDawn CircadianAnchor = "astronomicalDawn"
+	CircadianAnchorAstronomicalDusk CircadianAnchor = "astronomicalDusk"
+	CircadianAnchorClockTime        CircadianAnchor = "clockTime"
 )
 
 // CircadianOnState is a CircadianKeyframe's tri-state on/off directive -
@@ -33,19 +42,41 @@ const (
 	CircadianOnStateOff       CircadianOnState = "off"
 )
 
+// CircadianMode selects how a CircadianSchedule's curve is defined -
+// "keyframes" (Spec.Keyframes, pinned to solar events plus offsets) or
+// "elevation" (Spec.Elevation, a function of the sun's current elevation
+// angle).
+type CircadianMode string
+
+const (
+	CircadianModeKeyframes CircadianMode = "keyframes"
+	CircadianModeElevation CircadianMode = "elevation"
+)
+
 // +kubebuilder:object:generate=true
 
 // CircadianKeyframe pins an absolute (Brightness, ColorTempK) pair to a
-// point in the solar day - Anchor plus OffsetMinutes, not a wall-clock
-// time, so the schedule keeps making sense across the seasons as sunrise/
-// sunset drift. Unlike SceneLightState's pointer fields (which mean "leave
+// point in the day - Anchor plus OffsetMinutes. Solar anchors keep the
+// schedule making sense across the seasons as sunrise/sunset drift; a
+// clockTime anchor is for the things that don't follow the sun at all
+// (e.g. "dim to 20% at 22:30 every night"), and the two can be mixed
+// freely in one schedule. Unlike SceneLightState's pointer fields (which mean "leave
 // this untouched"), Brightness/ColorTempK here are required: a continuous
 // curve needs a value defined at every keyframe, not a sparse override -
 // there's no "untouched" between two points on a curve.
 type CircadianKeyframe struct {
-	// Anchor is the solar event this keyframe is offset from.
-	// +kubebuilder:validation:Enum=sunrise;solarNoon;sunset;solarMidnight
+	// Anchor is the solar event (or clockTime) this keyframe is offset
+	// from.
+	// +kubebuilder:validation:Enum=sunrise;solarNoon;sunset;solarMidnight;civilDawn;civilDusk;nauticalDawn;nauticalDusk;astronomicalDawn;astronomicalDusk;clockTime
 	Anchor CircadianAnchor `json:"anchor"`
+	// Time is the local wall-clock time, "HH:MM" in the schedule's
+	// TimeZone, a clockTime Anchor resolves to - required for, and only
+	// used by, that anchor. On a DST transition day a Time that doesn't
+	// exist (skipped by spring-forward) or exists twice (repeated by
+	// fall-back) resolves to whichever instant Go's time.Date picks, never
+	// an error - off by at most the DST shift for one night a year.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Time string `json:"time,omitempty"`
 	// OffsetMinutes shifts this keyframe from Anchor, positive is later.
 	// Bounded to +/-12h so internal/circadian.Interpolate's search window
 	// (the day before/of/after "now") is always sufficient to resolve it.
@@ -74,18 +105,80 @@ type CircadianKeyframe struct {
 	On CircadianOnState `json:"on,omitempty"`
 }
 
+// CircadianWeekday is a day of the week a CircadianVariant applies on,
+// read as a local date in the schedule's TimeZone.
+// +kubebuilder:validation:Enum=monday;tuesday;wednesday;thursday;friday;saturday;sunday
+type CircadianWeekday string
+
+const (
+	CircadianWeekdayMonday    CircadianWeekday = "monday"
+	CircadianWeekdayTuesday   CircadianWeekday = "tuesday"
+	CircadianWeekdayWednesday CircadianWeekday = "wednesday"
+	CircadianWeekdayThursday  CircadianWeekday = "thursday"
+	CircadianWeekdayFriday    CircadianWeekday = "friday"
+	CircadianWeekdaySaturday  CircadianWeekday = "saturday"
+	CircadianWeekdaySunday    CircadianWeekday = "sunday"
+)
+
+// +kubebuilder:object:generate=true
+
+// CircadianVariant replaces a CircadianSchedule's Keyframes on the listed
+// days - e.g. a weekend variant that gets up two hours later. A variant is
+// a complete keyframe set for its days, not a patch over the default one:
+// merging two sets keyframe-by-keyframe would need an identity for each
+// keyframe that CircadianKeyframe doesn't have.
+type CircadianVariant struct {
+	// Days this variant applies on. A day may only be listed by one
+	// variant.
+	// +kubebuilder:validation:MinItems=1
+	Days []CircadianWeekday `json:"days"`
+	// Keyframes used on Days instead of the schedule's own - same rules
+	// as CircadianScheduleSpec.Keyframes.
+	// +kubebuilder:validation:MinItems=2
+	Keyframes []CircadianKeyframe `json:"keyframes"`
+}
+
+// +kubebuilder:object:generate=true
+
+// CircadianElevationPoint maps one sun elevation angle to a (Brightness,
+// ColorTempK) pair - CircadianKeyframe's counterpart for
+// CircadianModeElevation. Anchoring to elevation rather than to a solar
+// event means the curve's shape follows the season on its own: a midwinter
+// noon sun sitting 15 degrees up gets the same output a midsummer sun gets
+// at 15 degrees on its way up in mid-morning, instead of the full
+// solarNoon keyframe. The tradeoff is symmetry - morning and evening at the
+// same elevation always produce the same output, so there's no way to
+// (say) warm up faster in the evening than the morning cools down.
+type CircadianElevationPoint struct {
+	// Degrees is the sun's elevation above the horizon, negative below it.
+	// Sunrise/sunset are at about -0.833 (see internal/sun.Elevation),
+	// civil twilight ends at -6.
+	// +kubebuilder:validation:Minimum=-90
+	// +kubebuilder:validation:Maximum=90
+	Degrees float64 `json:"degrees"`
+	// Brightness at this elevation, 0-100.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Brightness int32 `json:"brightness"`
+	// ColorTempK at this elevation, in Kelvin.
+	// +kubebuilder:validation:Minimum=1000
+	// +kubebuilder:validation:Maximum=10000
+	ColorTempK int32 `json:"colorTempK"`
+}
+
 // +kubebuilder:object:generate=true
 
 // CircadianScheduleSpec declares a continuous brightness/colorTempK curve
 // for all of a Group's lights, anchored to sun position rather than fixed
-// times. Unlike Scene, there's no per-light Lights list - a circadian
-// curve is one uniform "ambient tone" applied to every light in Group, not
-// per-fixture state. A CircadianSchedule does no enactment itself -
-// internal/groupcontroller.Reconciler applies the current interpolated
-// value onto each target Light.Spec when the owning Group's
-// Spec.ActiveScene references this schedule (Kind: CircadianSchedule),
-// reusing the same per-light capability-sentinel skip Scene enactment
-// already uses.
+// times - either via keyframes pinned to solar events, or directly via the
+// sun's elevation angle (see Mode). Unlike Scene, there's no per-light
+// Lights list - a circadian curve is one uniform "ambient tone" applied to
+// every light in Group, not per-fixture state. A CircadianSchedule does no
+// enactment itself - internal/groupcontroller.Reconciler applies the
+// current interpolated value onto each target Light.Spec when the owning
+// Group's Spec.ActiveScene references this schedule (Kind:
+// CircadianSchedule), reusing the same per-light capability-sentinel skip
+// Scene enactment already uses.
 type CircadianScheduleSpec struct {
 	// Group is the name of the Group this schedule applies to. A Group's
 	// Spec.ActiveScene must reference this schedule's own metadata.name
@@ -107,12 +200,38 @@ type CircadianScheduleSpec struct {
 	// +kubebuilder:validation:Minimum=-180
 	// +kubebuilder:validation:Maximum=180
 	Longitude float64 `json:"longitude"`
-	// Keyframes define the curve, at least 2, resolved and interpolated
-	// against "now" by internal/circadian.Interpolate. Order in this list
-	// doesn't matter - they're sorted by resolved instant before
-	// interpolating.
+	// TimeZone is the IANA time zone name (e.g. "Europe/London") clockTime
+	// keyframes' Time and Variants' Days are read in. Required if any
+	// keyframe uses clockTime or any Variants are set - deliberately not
+	// defaulted to UTC, for the same "a silently wrong place is worse than
+	// a validation error" reason as Latitude.
+	TimeZone string `json:"timeZone,omitempty"`
+	// Mode selects which of Keyframes/Elevation defines the curve - the
+	// other is ignored. Defaults to "keyframes".
+	// +kubebuilder:validation:Enum=keyframes;elevation
+	// +kubebuilder:default=keyframes
+	Mode CircadianMode `json:"mode,omitempty"`
+	// Keyframes define the curve in "keyframes" mode, at least 2, resolved
+	// and interpolated against "now" by internal/circadian.Interpolate.
+	// Order in this list doesn't matter - they're sorted by resolved
+	// instant before interpolating. Used on every day no Variant lists -
+	// may be left empty only if Variants cover the whole week.
 	// +kubebuilder:validation:MinItems=2
-	Keyframes []CircadianKeyframe `json:"keyframes"`
+	Keyframes []CircadianKeyframe `json:"keyframes,omitempty"`
+	// Variants swap in a different keyframe set on particular days of
+	// the week (in TimeZone), e.g. a later start at the weekend - see
+	// internal/circadian.InterpolateVariants for how the day boundary is
+	// smoothed over. Only used in "keyframes" mode.
+	Variants []CircadianVariant `json:"variants,omitempty"`
+	// Elevation defines the curve in "elevation" mode, at least 2 points,
+	// interpolated against the sun's elevation right now by
+	// internal/circadian.InterpolateElevation - clamped to the first/last
+	// point outside the table's range. Order in this list doesn't matter.
+	// Elevation mode never manages on/off (there's no per-point On), since
+	// a step function keyed on elevation would switch at the same angle
+	// morning and evening.
+	// +kubebuilder:validation:MinItems=2
+	Elevation []CircadianElevationPoint `json:"elevation,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
@@ -122,11 +241,11 @@ type CircadianScheduleSpec struct {
 // Spec.ActiveScene - useful for tuning Keyframes via `kubectl get` before
 // wiring it live.
 type CircadianScheduleStatus struct {
-	// CurrentBrightness is what Spec.Keyframes interpolates to right now,
-	// nil if ValidationError is set.
+	// CurrentBrightness is what Spec.Keyframes (or Spec.Elevation)
+	// interpolates to right now, nil if ValidationError is set.
 	CurrentBrightness *int32 `json:"currentBrightness,omitempty"`
-	// CurrentColorTempK is what Spec.Keyframes interpolates to right now,
-	// nil if ValidationError is set.
+	// CurrentColorTempK is what Spec.Keyframes (or Spec.Elevation)
+	// interpolates to right now, nil if ValidationError is set.
 	CurrentColorTempK *int32 `json:"currentColorTempK,omitempty"`
 	// ValidationError reports why Spec couldn't be interpolated - e.g.
 	// Group is unset, or internal/circadian.Interpolate rejected
@@ -46,6 +46,35 @@ type ActiveSceneRef struct {
 
 // +kubebuilder:object:generate=true
 
+// AwaySpec configures a Group's presence simulation - see GroupSpec.Away.
+type AwaySpec struct {
+	// Enabled turns presence simulation on. Toggled by the web UI's
+	// GroupService.SetAway as well as by editing the Group directly.
+	Enabled bool `json:"enabled"`
+	// JitterMinutes bounds how far each day's replay is randomly shifted
+	// from the recorded pattern it's drawn from, in either direction - so
+	// the house doesn't switch on at exactly the same minute a watcher
+	// could have seen a week ago. At least 1: with omitempty, an explicit
+	// 0 would be indistinguishable from unset and defaulted anyway.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=180
+	// +kubebuilder:default=20
+	JitterMinutes int32 `json:"jitterMinutes,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+// OnTransition is one recorded change of a Group's on/off state - see
+// GroupStatus.OnHistory.
+type OnTransition struct {
+	// At is when the change was observed.
+	At metav1.Time `json:"at"`
+	// On is the group's state from At onwards.
+	On bool `json:"on"`
+}
+
+// +kubebuilder:object:generate=true
+
 // GroupSpec is the user-declared list of Lights belonging to this group.
 type GroupSpec struct {
 	// Lights are the names of Light CRs that belong to this group.
@@ -72,6 +101,18 @@ type GroupSpec struct {
 	// of this Group's own reconcile timing (see that package's doc
 	// comment).
 	ActiveScene *ActiveSceneRef `json:"activeScene,omitempty"`
+	// Away, while Enabled, overrides ActiveScene with presence simulation:
+	// every light in Spec.Lights is switched on and off together, replaying
+	// a day drawn from Status.OnHistory with a random shift (see
+	// internal/away.Replay). Only On is touched - brightness/color are left
+	// at whatever ActiveScene last set. ActiveScene itself is left alone,
+	// so disabling Away hands straight back to it on the next reconcile,
+	// same as any other manual override being corrected. The group's
+	// on/off state from just before Away was enabled (Status.PreAwayOn)
+	// is restored first, so an ActiveScene that doesn't set On itself - a
+	// CircadianSchedule with no On keyframes, or none at all - doesn't
+	// leave the lights wherever the replay last put them.
+	Away *AwaySpec `json:"away,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
@@ -94,6 +135,16 @@ type GroupStatus struct {
 	// ActiveScene is unset/Off, or the named referent was found and
 	// validated fine.
 	ActiveSceneError string `json:"activeSceneError,omitempty"`
+	// OnHistory records when this group's lights went on and off (on
+	// meaning any reachable light in Spec.Lights is on), oldest first,
+	// covering the last week - the pattern Spec.Away replays. Sampled from
+	// each Light's Status on every reconcile, and not recorded at all while
+	// Away is enabled so the simulation never learns from itself.
+	OnHistory []OnTransition `json:"onHistory,omitempty"`
+	// PreAwayOn is the group's on/off state when Spec.Away was enabled,
+	// captured before the first replay and restored once Away is disabled
+	// again (see GroupSpec.Away). Nil whenever Away isn't in effect.
+	PreAwayOn *bool `json:"preAwayOn,omitempty"`
 	// LastSynced is when this status was last recomputed.
 	LastSynced metav1.Time `json:"lastSynced,omitempty"`
 }
@@ -105,6 +156,7 @@ type GroupStatus struct {
 // +kubebuilder:printcolumn:name="Missing",type="string",JSONPath=".status.missingLights"
 // +kubebuilder:printcolumn:name="Active Kind",type="string",JSONPath=".spec.activeScene.kind"
 // +kubebuilder:printcolumn:name="Active Name",type="string",JSONPath=".spec.activeScene.name"
+// +kubebuilder:printcolumn:name="Away",type="boolean",JSONPath=".spec.away.enabled"
 // +kubebuilder:printcolumn:name="Scene Error",type="string",JSONPath=".status.activeSceneError",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
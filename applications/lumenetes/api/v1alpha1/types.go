@@ -115,6 +115,28 @@ type LightStatus struct {
 	// attempt succeeded (or none has been made, or nothing currently
 	// differs between Spec and Status).
 	EnactError string `json:"enactError,omitempty"`
+	// PendingChanges is every field where Spec differed from the observed
+	// state above as of the controller's last reconcile, or empty if they
+	// agreed. Recorded in every mode, but mostly useful under --dry-run,
+	// where it's the only record of what the controller would have pushed
+	// to the bridge - so turning dry-run off can be a checked decision
+	// rather than a leap of faith. Never recorded for a Reactive light
+	// (see LightSpec.Reactive), whose Spec isn't enacted at all.
+	PendingChanges []PendingChange `json:"pendingChanges,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+// PendingChange is one field where a Light's Spec (desired) differs from
+// its Status (observed). Want/Have are rendered as strings rather than
+// typed values, since the field they describe varies from entry to entry.
+type PendingChange struct {
+	// Field is the LightSpec field's JSON name, e.g. "brightness".
+	Field string `json:"field"`
+	// Want is Spec's value for Field.
+	Want string `json:"want"`
+	// Have is Status's value for Field.
+	Have string `json:"have"`
 }
 
 // +kubebuilder:object:root=true
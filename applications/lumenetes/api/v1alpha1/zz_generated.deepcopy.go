@@ -23,6 +23,36 @@ func (in *ActiveSceneRef) DeepCopy() *ActiveSceneRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AwaySpec) DeepCopyInto(out *AwaySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwaySpec.
+func (in *AwaySpec) DeepCopy() *AwaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AwaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircadianElevationPoint) DeepCopyInto(out *CircadianElevationPoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircadianElevationPoint.
+func (in *CircadianElevationPoint) DeepCopy() *CircadianElevationPoint {
+	if in == nil {
+		return nil
+	}
+	out := new(CircadianElevationPoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CircadianKeyframe) DeepCopyInto(out *CircadianKeyframe) {
 	*out = *in
@@ -105,6 +135,18 @@ func (in *CircadianScheduleSpec) DeepCopyInto(out *CircadianScheduleSpec) {
 		*out = make([]CircadianKeyframe, len(*in))
 		copy(*out, *in)
 	}
+	if in.Variants != nil {
+		in, out := &in.Variants, &out.Variants
+		*out = make([]CircadianVariant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Elevation != nil {
+		in, out := &in.Elevation, &out.Elevation
+		*out = make([]CircadianElevationPoint, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircadianScheduleSpec.
@@ -143,6 +185,31 @@ func (in *CircadianScheduleStatus) DeepCopy() *CircadianScheduleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircadianVariant) DeepCopyInto(out *CircadianVariant) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]CircadianWeekday, len(*in))
+		copy(*out, *in)
+	}
+	if in.Keyframes != nil {
+		in, out := &in.Keyframes, &out.Keyframes
+		*out = make([]CircadianKeyframe, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircadianVariant.
+func (in *CircadianVariant) DeepCopy() *CircadianVariant {
+	if in == nil {
+		return nil
+	}
+	out := new(CircadianVariant)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Group) DeepCopyInto(out *Group) {
 	*out = *in
@@ -215,6 +282,11 @@ func (in *GroupSpec) DeepCopyInto(out *GroupSpec) {
 		*out = new(ActiveSceneRef)
 		**out = **in
 	}
+	if in.Away != nil {
+		in, out := &in.Away, &out.Away
+		*out = new(AwaySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupSpec.
@@ -235,6 +307,18 @@ func (in *GroupStatus) DeepCopyInto(out *GroupStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.OnHistory != nil {
+		in, out := &in.OnHistory, &out.OnHistory
+		*out = make([]OnTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreAwayOn != nil {
+		in, out := &in.PreAwayOn, &out.PreAwayOn
+		*out = new(bool)
+		**out = **in
+	}
 	in.LastSynced.DeepCopyInto(&out.LastSynced)
 }
 
@@ -417,6 +501,11 @@ func (in *LightStatus) DeepCopyInto(out *LightStatus) {
 	*out = *in
 	in.LastSynced.DeepCopyInto(&out.LastSynced)
 	in.LastEnactAttempt.DeepCopyInto(&out.LastEnactAttempt)
+	if in.PendingChanges != nil {
+		in, out := &in.PendingChanges, &out.PendingChanges
+		*out = make([]PendingChange, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LightStatus.
@@ -429,6 +518,37 @@ func (in *LightStatus) DeepCopy() *LightStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnTransition) DeepCopyInto(out *OnTransition) {
+	*out = *in
+	in.At.DeepCopyInto(&out.At)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnTransition.
+func (in *OnTransition) DeepCopy() *OnTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(OnTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingChange) DeepCopyInto(out *PendingChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingChange.
+func (in *PendingChange) DeepCopy() *PendingChange {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Scene) DeepCopyInto(out *Scene) {
 	*out = *in
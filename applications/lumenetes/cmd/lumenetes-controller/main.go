@@ -14,6 +14,9 @@ import (
 	"net/http"
 	"os"
 	"time"
+	// The image is FROM scratch, with no zoneinfo of its own for
+	// CircadianSchedule.Spec.TimeZone to load from.
+	_ "time/tzdata"
 
 	lighthue "github.com/liamawhite/lumenetes/internal/hue"
 	lumenetesv1alpha1 "github.com/liamawhite/lumenetes/api/v1alpha1"
@@ -74,7 +77,7 @@ func main() {
 	flag.BoolVar(&dryRun, "dry-run", false, "If true, the Light reconciler only logs spec/status drift instead of enacting it against the bridge")
 	flag.DurationVar(&switchPollInterval, "switch-poll-interval", 5*time.Minute, "How often to poll bridges for switch discovery/battery/reachability - the sub-second event path is handled by the eventstream, not this poller")
 	flag.StringVar(&webhookCertDir, "webhook-cert-dir", "/tmp/k8s-webhook-server/serving-certs", "Directory containing tls.crt/tls.key for the Light validating webhook server - controller-runtime's own default locally, overridden to the mounted cert Secret's path in-cluster (see pkg/components/lumenetescontroller)")
-	flag.StringVar(&uiBindAddr, "ui-bind-address", ":8082", "Address the read-only web UI (Connect API + embedded frontend, see internal/server) binds to")
+	flag.StringVar(&uiBindAddr, "ui-bind-address", ":8082", "Address the web UI (Connect API + embedded frontend, see internal/server) binds to")
 	flag.Parse()
 
 	// ctrl.Log.WithName(...) alone never attaches a real logging backend -
@@ -268,17 +271,19 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Read-only web UI over the same lumenetes.io CRDs this manager already
+	// Web UI over the same lumenetes.io CRDs this manager already
 	// watches/reconciles - a Connect API (internal/*service, backed by
 	// mgr.GetClient() rather than a second client of its own) plus the
 	// embedded React frontend (internal/webui), served as a plain
 	// manager.Server Runnable rather than a separate binary/container: this
 	// process already holds the RBAC and cached client the UI's reads need,
 	// so a second container would only duplicate both for no benefit.
-	// OnlyServeWhenLeader is left false (the zero value) - unlike the
-	// reconcilers above, serving read-only data has no correctness reason to
-	// sit idle on a non-leader replica, though with a single replica today
-	// this doesn't yet matter in practice.
+	// Read-only apart from GroupService.SetAway, a plain Group spec write
+	// like any kubectl edit. OnlyServeWhenLeader is left false (the zero
+	// value) - unlike the reconcilers above, serving this has no
+	// correctness reason to sit idle on a non-leader replica (SetAway's
+	// write goes to the API server, not to any leader-held state), though
+	// with a single replica today this doesn't yet matter in practice.
 	uiHandler, err := server.New(
 		bridgeservice.New(mgr.GetClient()),
 		lightservice.New(mgr.GetClient()),
@@ -25,28 +25,49 @@ const (
 type CircadianAnchor int32
 
 const (
-	CircadianAnchor_CIRCADIAN_ANCHOR_UNSPECIFIED    CircadianAnchor = 0
-	CircadianAnchor_CIRCADIAN_ANCHOR_SUNRISE        CircadianAnchor = 1
-	CircadianAnchor_CIRCADIAN_ANCHOR_SOLAR_NOON     CircadianAnchor = 2
-	CircadianAnchor_CIRCADIAN_ANCHOR_SUNSET         CircadianAnchor = 3
-	CircadianAnchor_CIRCADIAN_ANCHOR_SOLAR_MIDNIGHT CircadianAnchor = 4
+	CircadianAnchor_CIRCADIAN_ANCHOR_UNSPECIFIED       CircadianAnchor = 0
+	CircadianAnchor_CIRCADIAN_ANCHOR_SUNRISE           CircadianAnchor = 1
+	CircadianAnchor_CIRCADIAN_ANCHOR_SOLAR_NOON        CircadianAnchor = 2
+	CircadianAnchor_CIRCADIAN_ANCHOR_SUNSET            CircadianAnchor = 3
+	CircadianAnchor_CIRCADIAN_ANCHOR_SOLAR_MIDNIGHT    CircadianAnchor = 4
+	CircadianAnchor_CIRCADIAN_ANCHOR_CIVIL_DAWN        CircadianAnchor = 5
+	CircadianAnchor_CIRCADIAN_ANCHOR_CIVIL_DUSK        CircadianAnchor = 6
+	CircadianAnchor_CIRCADIAN_ANCHOR_NAUTICAL_DAWN     CircadianAnchor = 7
+	CircadianAnchor_CIRCADIAN_ANCHOR_NAUTICAL_DUSK     CircadianAnchor = 8
+	CircadianAnchor_CIRCADIAN_ANCHOR_ASTRONOMICAL_DAWN CircadianAnchor = 9
+	CircadianAnchor_CIRCADIAN_ANCHOR_ASTRONOMICAL_DUSK CircadianAnchor = 10
+	CircadianAnchor_CIRCADIAN_ANCHOR_CLOCK_TIME        CircadianAnchor = 11
 )
 
 // Enum value maps for CircadianAnchor.
 var (
 	CircadianAnchor_name = map[int32]string{
-		0: "CIRCADIAN_ANCHOR_UNSPECIFIED",
-		1: "CIRCADIAN_ANCHOR_SUNRISE",
-		2: "CIRCADIAN_ANCHOR_SOLAR_NOON",
-		3: "CIRCADIAN_ANCHOR_SUNSET",
-		4: "CIRCADIAN_ANCHOR_SOLAR_MIDNIGHT",
+		0:  "CIRCADIAN_ANCHOR_UNSPECIFIED",
+		1:  "CIRCADIAN_ANCHOR_SUNRISE",
+		2:  "CIRCADIAN_ANCHOR_SOLAR_NOON",
+		3:  "CIRCADIAN_ANCHOR_SUNSET",
+		4:  "CIRCADIAN_ANCHOR_SOLAR_MIDNIGHT",
+		5:  "CIRCADIAN_ANCHOR_CIVIL_DAWN",
+		6:  "CIRCADIAN_ANCHOR_CIVIL_DUSK",
+		7:  "CIRCADIAN_ANCHOR_NAUTICAL_DAWN",
+		8:  "CIRCADIAN_ANCHOR_NAUTICAL_DUSK",
+		9:  "CIRCADIAN_ANCHOR_ASTRONOMICAL_DAWN",
+		10: "CIRCADIAN_ANCHOR_ASTRONOMICAL_DUSK",
+		11: "CIRCADIAN_ANCHOR_CLOCK_TIME",
 	}
 	CircadianAnchor_value = map[string]int32{
-		"CIRCADIAN_ANCHOR_UNSPECIFIED":    0,
-		"CIRCADIAN_ANCHOR_SUNRISE":        1,
-		"CIRCADIAN_ANCHOR_SOLAR_NOON":     2,
-		"CIRCADIAN_ANCHOR_SUNSET":         3,
-		"CIRCADIAN_ANCHOR_SOLAR_MIDNIGHT": 4,
+		"CIRCADIAN_ANCHOR_UNSPECIFIED":       0,
+		"CIRCADIAN_ANCHOR_SUNRISE":           1,
+		"CIRCADIAN_ANCHOR_SOLAR_NOON":        2,
+		"CIRCADIAN_ANCHOR_SUNSET":            3,
+		"CIRCADIAN_ANCHOR_SOLAR_MIDNIGHT":    4,
+		"CIRCADIAN_ANCHOR_CIVIL_DAWN":        5,
+		"CIRCADIAN_ANCHOR_CIVIL_DUSK":        6,
+		"CIRCADIAN_ANCHOR_NAUTICAL_DAWN":     7,
+		"CIRCADIAN_ANCHOR_NAUTICAL_DUSK":     8,
+		"CIRCADIAN_ANCHOR_ASTRONOMICAL_DAWN": 9,
+		"CIRCADIAN_ANCHOR_ASTRONOMICAL_DUSK": 10,
+		"CIRCADIAN_ANCHOR_CLOCK_TIME":        11,
 	}
 )
 
@@ -126,6 +147,119 @@ func (CircadianOnState) EnumDescriptor() ([]byte, []int) {
 	return file_lumenetes_v1_circadian_schedule_proto_rawDescGZIP(), []int{1}
 }
 
+type CircadianMode int32
+
+const (
+	CircadianMode_CIRCADIAN_MODE_UNSPECIFIED CircadianMode = 0
+	CircadianMode_CIRCADIAN_MODE_KEYFRAMES   CircadianMode = 1
+	CircadianMode_CIRCADIAN_MODE_ELEVATION   CircadianMode = 2
+)
+
+// Enum value maps for CircadianMode.
+var (
+	CircadianMode_name = map[int32]string{
+		0: "CIRCADIAN_MODE_UNSPECIFIED",
+		1: "CIRCADIAN_MODE_KEYFRAMES",
+		2: "CIRCADIAN_MODE_ELEVATION",
+	}
+	CircadianMode_value = map[string]int32{
+		"CIRCADIAN_MODE_UNSPECIFIED": 0,
+		"CIRCADIAN_MODE_KEYFRAMES":   1,
+		"CIRCADIAN_MODE_ELEVATION":   2,
+	}
+)
+
+func (x CircadianMode) Enum() *CircadianMode {
+	p := new(CircadianMode)
+	*p = x
+	return p
+}
+
+func (x CircadianMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CircadianMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_lumenetes_v1_circadian_schedule_proto_enumTypes[2].Descriptor()
+}
+
+func (CircadianMode) Type() protoreflect.EnumType {
+	return &file_lumenetes_v1_circadian_schedule_proto_enumTypes[2]
+}
+
+func (x CircadianMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CircadianMode.Descriptor instead.
+func (CircadianMode) EnumDescriptor() ([]byte, []int) {
+	return file_lumenetes_v1_circadian_schedule_proto_rawDescGZIP(), []int{2}
+}
+
+type CircadianWeekday int32
+
+const (
+	CircadianWeekday_CIRCADIAN_WEEKDAY_UNSPECIFIED CircadianWeekday = 0
+	CircadianWeekday_CIRCADIAN_WEEKDAY_MONDAY      CircadianWeekday = 1
+	CircadianWeekday_CIRCADIAN_WEEKDAY_TUESDAY     CircadianWeekday = 2
+	CircadianWeekday_CIRCADIAN_WEEKDAY_WEDNESDAY   CircadianWeekday = 3
+	CircadianWeekday_CIRCADIAN_WEEKDAY_THURSDAY    CircadianWeekday = 4
+	CircadianWeekday_CIRCADIAN_WEEKDAY_FRIDAY      CircadianWeekday = 5
+	CircadianWeekday_CIRCADIAN_WEEKDAY_SATURDAY    CircadianWeekday = 6
+	CircadianWeekday_CIRCADIAN_WEEKDAY_SUNDAY      CircadianWeekday = 7
+)
+
+// Enum value maps for CircadianWeekday.
+var (
+	CircadianWeekday_name = map[int32]string{
+		0: "CIRCADIAN_WEEKDAY_UNSPECIFIED",
+		1: "CIRCADIAN_WEEKDAY_MONDAY",
+		2: "CIRCADIAN_WEEKDAY_TUESDAY",
+		3: "CIRCADIAN_WEEKDAY_WEDNESDAY",
+		4: "CIRCADIAN_WEEKDAY_THURSDAY",
+		5: "CIRCADIAN_WEEKDAY_FRIDAY",
+		6: "CIRCADIAN_WEEKDAY_SATURDAY",
+		7: "CIRCADIAN_WEEKDAY_SUNDAY",
+	}
+	CircadianWeekday_value = map[string]int32{
+		"CIRCADIAN_WEEKDAY_UNSPECIFIED": 0,
+		"CIRCADIAN_WEEKDAY_MONDAY":      1,
+		"CIRCADIAN_WEEKDAY_TUESDAY":     2,
+		"CIRCADIAN_WEEKDAY_WEDNESDAY":   3,
+		"CIRCADIAN_WEEKDAY_THURSDAY":    4,
+		"CIRCADIAN_WEEKDAY_FRIDAY":      5,
+		"CIRCADIAN_WEEKDAY_SATURDAY":    6,
+		"CIRCADIAN_WEEKDAY_SUNDAY":      7,
+	}
+)
+
+func (x CircadianWeekday) Enum() *CircadianWeekday {
+	p := new(CircadianWeekday)
+	*p = x
+	return p
+}
+
+func (x CircadianWeekday) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CircadianWeekday) Descriptor() protoreflect.EnumDescriptor {
+	return file_lumenetes_v1_circadian_schedule_proto_enumTypes[3].Descriptor()
+}
+
+func (CircadianWeekday) Type() protoreflect.EnumType {
+	return &file_lumenetes_v1_circadian_schedule_proto_enumTypes[3]
+}
+
+func (x CircadianWeekday) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CircadianWeekday.Descriptor instead.
+func (CircadianWeekday) EnumDescriptor() ([]byte, []int) {
+	return file_lumenetes_v1_circadian_schedule_proto_rawDescGZIP(), []int{3}
+}
+
 type CircadianKeyframe struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Anchor        CircadianAnchor        `protobuf:"varint,1,opt,name=anchor,proto3,enum=lumenetes.v1.CircadianAnchor" json:"anchor,omitempty"`
@@ -133,6 +267,8 @@ type CircadianKeyframe struct {
 	Brightness    int32                  `protobuf:"varint,3,opt,name=brightness,proto3" json:"brightness,omitempty"`
 	ColorTempK    int32                  `protobuf:"varint,4,opt,name=color_temp_k,json=colorTempK,proto3" json:"color_temp_k,omitempty"`
 	On            CircadianOnState       `protobuf:"varint,5,opt,name=on,proto3,enum=lumenetes.v1.CircadianOnState" json:"on,omitempty"`
+	// "HH:MM" in the schedule's time_zone, only set for CLOCK_TIME.
+	Time          string `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -202,24 +338,147 @@ func (x *CircadianKeyframe) GetOn() CircadianOnState {
 	return CircadianOnState_CIRCADIAN_ON_STATE_UNCHANGED
 }
 
+func (x *CircadianKeyframe) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type CircadianVariant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []CircadianWeekday     `protobuf:"varint,1,rep,packed,name=days,proto3,enum=lumenetes.v1.CircadianWeekday" json:"days,omitempty"`
+	Keyframes     []*CircadianKeyframe   `protobuf:"bytes,2,rep,name=keyframes,proto3" json:"keyframes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CircadianVariant) Reset() {
+	*x = CircadianVariant{}
+	mi := &file_lumenetes_v1_circadian_schedule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircadianVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircadianVariant) ProtoMessage() {}
+
+func (x *CircadianVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_lumenetes_v1_circadian_schedule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircadianVariant.ProtoReflect.Descriptor instead.
+func (*CircadianVariant) Descriptor() ([]byte, []int) {
+	return file_lumenetes_v1_circadian_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CircadianVariant) GetDays() []CircadianWeekday {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *CircadianVariant) GetKeyframes() []*CircadianKeyframe {
+	if x != nil {
+		return x.Keyframes
+	}
+	return nil
+}
+
+type CircadianElevationPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Degrees       float64                `protobuf:"fixed64,1,opt,name=degrees,proto3" json:"degrees,omitempty"`
+	Brightness    int32                  `protobuf:"varint,2,opt,name=brightness,proto3" json:"brightness,omitempty"`
+	ColorTempK    int32                  `protobuf:"varint,3,opt,name=color_temp_k,json=colorTempK,proto3" json:"color_temp_k,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CircadianElevationPoint) Reset() {
+	*x = CircadianElevationPoint{}
+	mi := &file_lumenetes_v1_circadian_schedule_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircadianElevationPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircadianElevationPoint) ProtoMessage() {}
+
+func (x *CircadianElevationPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_lumenetes_v1_circadian_schedule_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircadianElevationPoint.ProtoReflect.Descriptor instead.
+func (*CircadianElevationPoint) Descriptor() ([]byte, []int) {
+	return file_lumenetes_v1_circadian_schedule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CircadianElevationPoint) GetDegrees() float64 {
+	if x != nil {
+		return x.Degrees
+	}
+	return 0
+}
+
+func (x *CircadianElevationPoint) GetBrightness() int32 {
+	if x != nil {
+		return x.Brightness
+	}
+	return 0
+}
+
+func (x *CircadianElevationPoint) GetColorTempK() int32 {
+	if x != nil {
+		return x.ColorTempK
+	}
+	return 0
+}
+
 type CircadianSchedule struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Group             string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
-	Latitude          float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude         float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Keyframes         []*CircadianKeyframe   `protobuf:"bytes,5,rep,name=keyframes,proto3" json:"keyframes,omitempty"`
-	CurrentBrightness *int32                 `protobuf:"varint,6,opt,name=current_brightness,json=currentBrightness,proto3,oneof" json:"current_brightness,omitempty"`
-	CurrentColorTempK *int32                 `protobuf:"varint,7,opt,name=current_color_temp_k,json=currentColorTempK,proto3,oneof" json:"current_color_temp_k,omitempty"`
-	ValidationError   string                 `protobuf:"bytes,8,opt,name=validation_error,json=validationError,proto3" json:"validation_error,omitempty"`
-	LastSynced        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_synced,json=lastSynced,proto3" json:"last_synced,omitempty"`
+	state             protoimpl.MessageState     `protogen:"open.v1"`
+	Id                string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Group             string                     `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Latitude          float64                    `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude         float64                    `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Keyframes         []*CircadianKeyframe       `protobuf:"bytes,5,rep,name=keyframes,proto3" json:"keyframes,omitempty"`
+	CurrentBrightness *int32                     `protobuf:"varint,6,opt,name=current_brightness,json=currentBrightness,proto3,oneof" json:"current_brightness,omitempty"`
+	CurrentColorTempK *int32                     `protobuf:"varint,7,opt,name=current_color_temp_k,json=currentColorTempK,proto3,oneof" json:"current_color_temp_k,omitempty"`
+	ValidationError   string                     `protobuf:"bytes,8,opt,name=validation_error,json=validationError,proto3" json:"validation_error,omitempty"`
+	LastSynced        *timestamppb.Timestamp     `protobuf:"bytes,9,opt,name=last_synced,json=lastSynced,proto3" json:"last_synced,omitempty"`
+	Mode              CircadianMode              `protobuf:"varint,10,opt,name=mode,proto3,enum=lumenetes.v1.CircadianMode" json:"mode,omitempty"`
+	Elevation         []*CircadianElevationPoint `protobuf:"bytes,11,rep,name=elevation,proto3" json:"elevation,omitempty"`
+	TimeZone          string                     `protobuf:"bytes,12,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Variants          []*CircadianVariant        `protobuf:"bytes,13,rep,name=variants,proto3" json:"variants,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CircadianSchedule) Reset() {
 	*x = CircadianSchedule{}
-	mi := &file_lumenetes_v1_circadian_schedule_proto_msgTypes[1]
+	mi := &file_lumenetes_v1_circadian_schedule_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -231,7 +490,7 @@ func (x *CircadianSchedule) String() string {
 func (*CircadianSchedule) ProtoMessage() {}
 
 func (x *CircadianSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_lumenetes_v1_circadian_schedule_proto_msgTypes[1]
+	mi := &file_lumenetes_v1_circadian_schedule_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -244,7 +503,7 @@ func (x *CircadianSchedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CircadianSchedule.ProtoReflect.Descriptor instead.
 func (*CircadianSchedule) Descriptor() ([]byte, []int) {
-	return file_lumenetes_v1_circadian_schedule_proto_rawDescGZIP(), []int{1}
+	return file_lumenetes_v1_circadian_schedule_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CircadianSchedule) GetId() string {
@@ -310,6 +569,34 @@ func (x *CircadianSchedule) GetLastSynced() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CircadianSchedule) GetMode() CircadianMode {
+	if x != nil {
+		return x.Mode
+	}
+	return CircadianMode_CIRCADIAN_MODE_UNSPECIFIED
+}
+
+func (x *CircadianSchedule) GetElevation() []*CircadianElevationPoint {
+	if x != nil {
+		return x.Elevation
+	}
+	return nil
+}
+
+func (x *CircadianSchedule) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CircadianSchedule) GetVariants() []*CircadianVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
 type ListCircadianSchedulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -318,7 +605,7 @@ type ListCircadianSchedulesRequest struct {
 
 func (x *ListCircadianSchedulesRequest) Reset() {
 	*x = ListCircadianSchedulesRequest{}
-	mi := &file_lumenetes_v1_circadian_schedule_proto_msgTypes[2]
+	mi := &file_lumenetes_v1_circadian_schedule_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -330,7 +617,7 @@ func (x *ListCircadianSchedulesRequest) String() string {
 func (*ListCircadianSchedulesRequest) ProtoMessage() {}
 
 func (x *ListCircadianSchedulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lumenetes_v1_circadian_schedule_proto_msgTypes[2]
+	mi := &file_lumenetes_v1_circadian_schedule_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +630,7 @@ func (x *ListCircadianSchedulesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCircadianSchedulesRequest.ProtoReflect.Descriptor instead.
 func (*ListCircadianSchedulesRequest) Descriptor() ([]byte, []int) {
-	return file_lumenetes_v1_circadian_schedule_proto_rawDescGZIP(), []int{2}
+	return file_lumenetes_v1_circadian_schedule_proto_rawDescGZIP(), []int{4}
 }
 
 type ListCircadianSchedulesResponse struct {
@@ -355,7 +642,7 @@ type ListCircadianSchedulesResponse struct {
 
 func (x *ListCircadianSchedulesResponse) Reset() {
 	*x = ListCircadianSchedulesResponse{}
-	mi := &file_lumenetes_v1_circadian_schedule_proto_msgTypes[3]
+	mi := &file_lumenetes_v1_circadian_schedule_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -367,7 +654,7 @@ func (x *ListCircadianSchedulesResponse) String() string {
 func (*ListCircadianSchedulesResponse) ProtoMessage() {}
 
 func (x *ListCircadianSchedulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lumenetes_v1_circadian_schedule_proto_msgTypes[3]
+	mi := &file_lumenetes_v1_circadian_schedule_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -380,7 +667,7 @@ func (x *ListCircadianSchedulesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCircadianSchedulesResponse.ProtoReflect.Descriptor instead.
 func (*ListCircadianSchedulesResponse) Descriptor() ([]byte, []int) {
-	return file_lumenetes_v1_circadian_schedule_proto_rawDescGZIP(), []int{3}
+	return file_lumenetes_v1_circadian_schedule_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListCircadianSchedulesResponse) GetCircadianSchedules() []*CircadianSchedule {
@@ -394,7 +681,7 @@ var File_lumenetes_v1_circadian_schedule_proto protoreflect.FileDescriptor
 
 const file_lumenetes_v1_circadian_schedule_proto_rawDesc = "" +
 	"\n" +
-	"%lumenetes/v1/circadian_schedule.proto\x12\flumenetes.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe3\x01\n" +
+	"%lumenetes/v1/circadian_schedule.proto\x12\flumenetes.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf7\x01\n" +
 	"\x11CircadianKeyframe\x125\n" +
 	"\x06anchor\x18\x01 \x01(\x0e2\x1d.lumenetes.v1.CircadianAnchorR\x06anchor\x12%\n" +
 	"\x0eoffset_minutes\x18\x02 \x01(\x05R\roffsetMinutes\x12\x1e\n" +
@@ -403,7 +690,18 @@ const file_lumenetes_v1_circadian_schedule_proto_rawDesc = "" +
 	"brightness\x12 \n" +
 	"\fcolor_temp_k\x18\x04 \x01(\x05R\n" +
 	"colorTempK\x12.\n" +
-	"\x02on\x18\x05 \x01(\x0e2\x1e.lumenetes.v1.CircadianOnStateR\x02on\"\xb4\x03\n" +
+	"\x02on\x18\x05 \x01(\x0e2\x1e.lumenetes.v1.CircadianOnStateR\x02on\x12\x12\n" +
+	"\x04time\x18\x06 \x01(\tR\x04time\"\x85\x01\n" +
+	"\x10CircadianVariant\x122\n" +
+	"\x04days\x18\x01 \x03(\x0e2\x1e.lumenetes.v1.CircadianWeekdayR\x04days\x12=\n" +
+	"\tkeyframes\x18\x02 \x03(\v2\x1f.lumenetes.v1.CircadianKeyframeR\tkeyframes\"u\n" +
+	"\x17CircadianElevationPoint\x12\x18\n" +
+	"\adegrees\x18\x01 \x01(\x01R\adegrees\x12\x1e\n" +
+	"\n" +
+	"brightness\x18\x02 \x01(\x05R\n" +
+	"brightness\x12 \n" +
+	"\fcolor_temp_k\x18\x03 \x01(\x05R\n" +
+	"colorTempK\"\x83\x05\n" +
 	"\x11CircadianSchedule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05group\x18\x02 \x01(\tR\x05group\x12\x1a\n" +
@@ -414,22 +712,48 @@ const file_lumenetes_v1_circadian_schedule_proto_rawDesc = "" +
 	"\x14current_color_temp_k\x18\a \x01(\x05H\x01R\x11currentColorTempK\x88\x01\x01\x12)\n" +
 	"\x10validation_error\x18\b \x01(\tR\x0fvalidationError\x12;\n" +
 	"\vlast_synced\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"lastSyncedB\x15\n" +
+	"lastSynced\x12/\n" +
+	"\x04mode\x18\n" +
+	" \x01(\x0e2\x1b.lumenetes.v1.CircadianModeR\x04mode\x12C\n" +
+	"\televation\x18\v \x03(\v2%.lumenetes.v1.CircadianElevationPointR\televation\x12\x1b\n" +
+	"\ttime_zone\x18\f \x01(\tR\btimeZone\x12:\n" +
+	"\bvariants\x18\r \x03(\v2\x1e.lumenetes.v1.CircadianVariantR\bvariantsB\x15\n" +
 	"\x13_current_brightnessB\x17\n" +
 	"\x15_current_color_temp_k\"\x1f\n" +
 	"\x1dListCircadianSchedulesRequest\"r\n" +
 	"\x1eListCircadianSchedulesResponse\x12P\n" +
-	"\x13circadian_schedules\x18\x01 \x03(\v2\x1f.lumenetes.v1.CircadianScheduleR\x12circadianSchedules*\xb4\x01\n" +
+	"\x13circadian_schedules\x18\x01 \x03(\v2\x1f.lumenetes.v1.CircadianScheduleR\x12circadianSchedules*\xaf\x03\n" +
 	"\x0fCircadianAnchor\x12 \n" +
 	"\x1cCIRCADIAN_ANCHOR_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18CIRCADIAN_ANCHOR_SUNRISE\x10\x01\x12\x1f\n" +
 	"\x1bCIRCADIAN_ANCHOR_SOLAR_NOON\x10\x02\x12\x1b\n" +
 	"\x17CIRCADIAN_ANCHOR_SUNSET\x10\x03\x12#\n" +
-	"\x1fCIRCADIAN_ANCHOR_SOLAR_MIDNIGHT\x10\x04*k\n" +
+	"\x1fCIRCADIAN_ANCHOR_SOLAR_MIDNIGHT\x10\x04\x12\x1f\n" +
+	"\x1bCIRCADIAN_ANCHOR_CIVIL_DAWN\x10\x05\x12\x1f\n" +
+	"\x1bCIRCADIAN_ANCHOR_CIVIL_DUSK\x10\x06\x12\"\n" +
+	"\x1eCIRCADIAN_ANCHOR_NAUTICAL_DAWN\x10\a\x12\"\n" +
+	"\x1eCIRCADIAN_ANCHOR_NAUTICAL_DUSK\x10\b\x12&\n" +
+	"\"CIRCADIAN_ANCHOR_ASTRONOMICAL_DAWN\x10\t\x12&\n" +
+	"\"CIRCADIAN_ANCHOR_ASTRONOMICAL_DUSK\x10\n" +
+	"\x12\x1f\n" +
+	"\x1bCIRCADIAN_ANCHOR_CLOCK_TIME\x10\v*k\n" +
 	"\x10CircadianOnState\x12 \n" +
 	"\x1cCIRCADIAN_ON_STATE_UNCHANGED\x10\x00\x12\x19\n" +
 	"\x15CIRCADIAN_ON_STATE_ON\x10\x01\x12\x1a\n" +
-	"\x16CIRCADIAN_ON_STATE_OFF\x10\x022\x8f\x01\n" +
+	"\x16CIRCADIAN_ON_STATE_OFF\x10\x02*k\n" +
+	"\rCircadianMode\x12\x1e\n" +
+	"\x1aCIRCADIAN_MODE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18CIRCADIAN_MODE_KEYFRAMES\x10\x01\x12\x1c\n" +
+	"\x18CIRCADIAN_MODE_ELEVATION\x10\x02*\x8f\x02\n" +
+	"\x10CircadianWeekday\x12!\n" +
+	"\x1dCIRCADIAN_WEEKDAY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18CIRCADIAN_WEEKDAY_MONDAY\x10\x01\x12\x1d\n" +
+	"\x19CIRCADIAN_WEEKDAY_TUESDAY\x10\x02\x12\x1f\n" +
+	"\x1bCIRCADIAN_WEEKDAY_WEDNESDAY\x10\x03\x12\x1e\n" +
+	"\x1aCIRCADIAN_WEEKDAY_THURSDAY\x10\x04\x12\x1c\n" +
+	"\x18CIRCADIAN_WEEKDAY_FRIDAY\x10\x05\x12\x1e\n" +
+	"\x1aCIRCADIAN_WEEKDAY_SATURDAY\x10\x06\x12\x1c\n" +
+	"\x18CIRCADIAN_WEEKDAY_SUNDAY\x10\a2\x8f\x01\n" +
 	"\x18CircadianScheduleService\x12s\n" +
 	"\x16ListCircadianSchedules\x12+.lumenetes.v1.ListCircadianSchedulesRequest\x1a,.lumenetes.v1.ListCircadianSchedulesResponseB>Z<github.com/liamawhite/lumenetes/gen/lumenetes/v1;lumenetesv1b\x06proto3"
 
@@ -445,30 +769,39 @@ func file_lumenetes_v1_circadian_schedule_proto_rawDescGZIP() []byte {
 	return file_lumenetes_v1_circadian_schedule_proto_rawDescData
 }
 
-var file_lumenetes_v1_circadian_schedule_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_lumenetes_v1_circadian_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_lumenetes_v1_circadian_schedule_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_lumenetes_v1_circadian_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_lumenetes_v1_circadian_schedule_proto_goTypes = []any{
 	(CircadianAnchor)(0),                   // 0: lumenetes.v1.CircadianAnchor
 	(CircadianOnState)(0),                  // 1: lumenetes.v1.CircadianOnState
-	(*CircadianKeyframe)(nil),              // 2: lumenetes.v1.CircadianKeyframe
-	(*CircadianSchedule)(nil),              // 3: lumenetes.v1.CircadianSchedule
-	(*ListCircadianSchedulesRequest)(nil),  // 4: lumenetes.v1.ListCircadianSchedulesRequest
-	(*ListCircadianSchedulesResponse)(nil), // 5: lumenetes.v1.ListCircadianSchedulesResponse
-	(*timestamppb.Timestamp)(nil),          // 6: google.protobuf.Timestamp
+	(CircadianMode)(0),                     // 2: lumenetes.v1.CircadianMode
+	(CircadianWeekday)(0),                  // 3: lumenetes.v1.CircadianWeekday
+	(*CircadianKeyframe)(nil),              // 4: lumenetes.v1.CircadianKeyframe
+	(*CircadianVariant)(nil),               // 5: lumenetes.v1.CircadianVariant
+	(*CircadianElevationPoint)(nil),        // 6: lumenetes.v1.CircadianElevationPoint
+	(*CircadianSchedule)(nil),              // 7: lumenetes.v1.CircadianSchedule
+	(*ListCircadianSchedulesRequest)(nil),  // 8: lumenetes.v1.ListCircadianSchedulesRequest
+	(*ListCircadianSchedulesResponse)(nil), // 9: lumenetes.v1.ListCircadianSchedulesResponse
+	(*timestamppb.Timestamp)(nil),          // 10: google.protobuf.Timestamp
 }
 var file_lumenetes_v1_circadian_schedule_proto_depIdxs = []int32{
-	0, // 0: lumenetes.v1.CircadianKeyframe.anchor:type_name -> lumenetes.v1.CircadianAnchor
-	1, // 1: lumenetes.v1.CircadianKeyframe.on:type_name -> lumenetes.v1.CircadianOnState
-	2, // 2: lumenetes.v1.CircadianSchedule.keyframes:type_name -> lumenetes.v1.CircadianKeyframe
-	6, // 3: lumenetes.v1.CircadianSchedule.last_synced:type_name -> google.protobuf.Timestamp
-	3, // 4: lumenetes.v1.ListCircadianSchedulesResponse.circadian_schedules:type_name -> lumenetes.v1.CircadianSchedule
-	4, // 5: lumenetes.v1.CircadianScheduleService.ListCircadianSchedules:input_type -> lumenetes.v1.ListCircadianSchedulesRequest
-	5, // 6: lumenetes.v1.CircadianScheduleService.ListCircadianSchedules:output_type -> lumenetes.v1.ListCircadianSchedulesResponse
-	6, // [6:7] is the sub-list for method output_type
-	5, // [5:6] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0,  // 0: lumenetes.v1.CircadianKeyframe.anchor:type_name -> lumenetes.v1.CircadianAnchor
+	1,  // 1: lumenetes.v1.CircadianKeyframe.on:type_name -> lumenetes.v1.CircadianOnState
+	3,  // 2: lumenetes.v1.CircadianVariant.days:type_name -> lumenetes.v1.CircadianWeekday
+	4,  // 3: lumenetes.v1.CircadianVariant.keyframes:type_name -> lumenetes.v1.CircadianKeyframe
+	4,  // 4: lumenetes.v1.CircadianSchedule.keyframes:type_name -> lumenetes.v1.CircadianKeyframe
+	10, // 5: lumenetes.v1.CircadianSchedule.last_synced:type_name -> google.protobuf.Timestamp
+	2,  // 6: lumenetes.v1.CircadianSchedule.mode:type_name -> lumenetes.v1.CircadianMode
+	6,  // 7: lumenetes.v1.CircadianSchedule.elevation:type_name -> lumenetes.v1.CircadianElevationPoint
+	5,  // 8: lumenetes.v1.CircadianSchedule.variants:type_name -> lumenetes.v1.CircadianVariant
+	7,  // 9: lumenetes.v1.ListCircadianSchedulesResponse.circadian_schedules:type_name -> lumenetes.v1.CircadianSchedule
+	8,  // 10: lumenetes.v1.CircadianScheduleService.ListCircadianSchedules:input_type -> lumenetes.v1.ListCircadianSchedulesRequest
+	9,  // 11: lumenetes.v1.CircadianScheduleService.ListCircadianSchedules:output_type -> lumenetes.v1.ListCircadianSchedulesResponse
+	11, // [11:12] is the sub-list for method output_type
+	10, // [10:11] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_lumenetes_v1_circadian_schedule_proto_init() }
@@ -476,14 +809,14 @@ func file_lumenetes_v1_circadian_schedule_proto_init() {
 	if File_lumenetes_v1_circadian_schedule_proto != nil {
 		return
 	}
-	file_lumenetes_v1_circadian_schedule_proto_msgTypes[1].OneofWrappers = []any{}
+	file_lumenetes_v1_circadian_schedule_proto_msgTypes[3].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lumenetes_v1_circadian_schedule_proto_rawDesc), len(file_lumenetes_v1_circadian_schedule_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   4,
+			NumEnums:      4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -129,6 +129,58 @@ func (x *ActiveSceneRef) GetName() string {
 	return ""
 }
 
+type AwayMode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	JitterMinutes int32                  `protobuf:"varint,2,opt,name=jitter_minutes,json=jitterMinutes,proto3" json:"jitter_minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AwayMode) Reset() {
+	*x = AwayMode{}
+	mi := &file_lumenetes_v1_group_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AwayMode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AwayMode) ProtoMessage() {}
+
+func (x *AwayMode) ProtoReflect() protoreflect.Message {
+	mi := &file_lumenetes_v1_group_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AwayMode.ProtoReflect.Descriptor instead.
+func (*AwayMode) Descriptor() ([]byte, []int) {
+	return file_lumenetes_v1_group_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AwayMode) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AwayMode) GetJitterMinutes() int32 {
+	if x != nil {
+		return x.JitterMinutes
+	}
+	return 0
+}
+
 type Group struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -138,13 +190,14 @@ type Group struct {
 	LightCount       int32                  `protobuf:"varint,5,opt,name=light_count,json=lightCount,proto3" json:"light_count,omitempty"`
 	ActiveSceneError string                 `protobuf:"bytes,6,opt,name=active_scene_error,json=activeSceneError,proto3" json:"active_scene_error,omitempty"`
 	LastSynced       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_synced,json=lastSynced,proto3" json:"last_synced,omitempty"`
+	Away             *AwayMode              `protobuf:"bytes,8,opt,name=away,proto3" json:"away,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Group) Reset() {
 	*x = Group{}
-	mi := &file_lumenetes_v1_group_proto_msgTypes[1]
+	mi := &file_lumenetes_v1_group_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -156,7 +209,7 @@ func (x *Group) String() string {
 func (*Group) ProtoMessage() {}
 
 func (x *Group) ProtoReflect() protoreflect.Message {
-	mi := &file_lumenetes_v1_group_proto_msgTypes[1]
+	mi := &file_lumenetes_v1_group_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -169,7 +222,7 @@ func (x *Group) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Group.ProtoReflect.Descriptor instead.
 func (*Group) Descriptor() ([]byte, []int) {
-	return file_lumenetes_v1_group_proto_rawDescGZIP(), []int{1}
+	return file_lumenetes_v1_group_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Group) GetId() string {
@@ -221,6 +274,13 @@ func (x *Group) GetLastSynced() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Group) GetAway() *AwayMode {
+	if x != nil {
+		return x.Away
+	}
+	return nil
+}
+
 type ListGroupsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -229,7 +289,7 @@ type ListGroupsRequest struct {
 
 func (x *ListGroupsRequest) Reset() {
 	*x = ListGroupsRequest{}
-	mi := &file_lumenetes_v1_group_proto_msgTypes[2]
+	mi := &file_lumenetes_v1_group_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -241,7 +301,7 @@ func (x *ListGroupsRequest) String() string {
 func (*ListGroupsRequest) ProtoMessage() {}
 
 func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lumenetes_v1_group_proto_msgTypes[2]
+	mi := &file_lumenetes_v1_group_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -254,7 +314,7 @@ func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
 func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_lumenetes_v1_group_proto_rawDescGZIP(), []int{2}
+	return file_lumenetes_v1_group_proto_rawDescGZIP(), []int{3}
 }
 
 type ListGroupsResponse struct {
@@ -266,7 +326,7 @@ type ListGroupsResponse struct {
 
 func (x *ListGroupsResponse) Reset() {
 	*x = ListGroupsResponse{}
-	mi := &file_lumenetes_v1_group_proto_msgTypes[3]
+	mi := &file_lumenetes_v1_group_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -278,7 +338,7 @@ func (x *ListGroupsResponse) String() string {
 func (*ListGroupsResponse) ProtoMessage() {}
 
 func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lumenetes_v1_group_proto_msgTypes[3]
+	mi := &file_lumenetes_v1_group_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,7 +351,7 @@ func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
 func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_lumenetes_v1_group_proto_rawDescGZIP(), []int{3}
+	return file_lumenetes_v1_group_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListGroupsResponse) GetGroups() []*Group {
@@ -301,6 +361,102 @@ func (x *ListGroupsResponse) GetGroups() []*Group {
 	return nil
 }
 
+type SetAwayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAwayRequest) Reset() {
+	*x = SetAwayRequest{}
+	mi := &file_lumenetes_v1_group_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAwayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAwayRequest) ProtoMessage() {}
+
+func (x *SetAwayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lumenetes_v1_group_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAwayRequest.ProtoReflect.Descriptor instead.
+func (*SetAwayRequest) Descriptor() ([]byte, []int) {
+	return file_lumenetes_v1_group_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetAwayRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetAwayRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetAwayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAwayResponse) Reset() {
+	*x = SetAwayResponse{}
+	mi := &file_lumenetes_v1_group_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAwayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAwayResponse) ProtoMessage() {}
+
+func (x *SetAwayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lumenetes_v1_group_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAwayResponse.ProtoReflect.Descriptor instead.
+func (*SetAwayResponse) Descriptor() ([]byte, []int) {
+	return file_lumenetes_v1_group_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetAwayResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
 var File_lumenetes_v1_group_proto protoreflect.FileDescriptor
 
 const file_lumenetes_v1_group_proto_rawDesc = "" +
@@ -308,7 +464,10 @@ const file_lumenetes_v1_group_proto_rawDesc = "" +
 	"\x18lumenetes/v1/group.proto\x12\flumenetes.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"W\n" +
 	"\x0eActiveSceneRef\x121\n" +
 	"\x04kind\x18\x01 \x01(\x0e2\x1d.lumenetes.v1.ActiveSceneKindR\x04kind\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\xa3\x02\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"K\n" +
+	"\bAwayMode\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12%\n" +
+	"\x0ejitter_minutes\x18\x02 \x01(\x05R\rjitterMinutes\"\xcf\x02\n" +
 	"\x05Group\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06lights\x18\x02 \x03(\tR\x06lights\x12?\n" +
@@ -318,19 +477,26 @@ const file_lumenetes_v1_group_proto_rawDesc = "" +
 	"lightCount\x12,\n" +
 	"\x12active_scene_error\x18\x06 \x01(\tR\x10activeSceneError\x12;\n" +
 	"\vlast_synced\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"lastSynced\"\x13\n" +
+	"lastSynced\x12*\n" +
+	"\x04away\x18\b \x01(\v2\x16.lumenetes.v1.AwayModeR\x04away\"\x13\n" +
 	"\x11ListGroupsRequest\"A\n" +
 	"\x12ListGroupsResponse\x12+\n" +
-	"\x06groups\x18\x01 \x03(\v2\x13.lumenetes.v1.GroupR\x06groups*\xb6\x01\n" +
+	"\x06groups\x18\x01 \x03(\v2\x13.lumenetes.v1.GroupR\x06groups\"E\n" +
+	"\x0eSetAwayRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"<\n" +
+	"\x0fSetAwayResponse\x12)\n" +
+	"\x05group\x18\x01 \x01(\v2\x13.lumenetes.v1.GroupR\x05group*\xb6\x01\n" +
 	"\x0fActiveSceneKind\x12!\n" +
 	"\x1dACTIVE_SCENE_KIND_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17ACTIVE_SCENE_KIND_SCENE\x10\x01\x12(\n" +
 	"$ACTIVE_SCENE_KIND_CIRCADIAN_SCHEDULE\x10\x02\x12\x19\n" +
 	"\x15ACTIVE_SCENE_KIND_OFF\x10\x03\x12\x1e\n" +
-	"\x1aACTIVE_SCENE_KIND_REACTIVE\x10\x042_\n" +
+	"\x1aACTIVE_SCENE_KIND_REACTIVE\x10\x042\xa7\x01\n" +
 	"\fGroupService\x12O\n" +
 	"\n" +
-	"ListGroups\x12\x1f.lumenetes.v1.ListGroupsRequest\x1a .lumenetes.v1.ListGroupsResponseB>Z<github.com/liamawhite/lumenetes/gen/lumenetes/v1;lumenetesv1b\x06proto3"
+	"ListGroups\x12\x1f.lumenetes.v1.ListGroupsRequest\x1a .lumenetes.v1.ListGroupsResponse\x12F\n" +
+	"\aSetAway\x12\x1c.lumenetes.v1.SetAwayRequest\x1a\x1d.lumenetes.v1.SetAwayResponseB>Z<github.com/liamawhite/lumenetes/gen/lumenetes/v1;lumenetesv1b\x06proto3"
 
 var (
 	file_lumenetes_v1_group_proto_rawDescOnce sync.Once
@@ -345,27 +511,34 @@ func file_lumenetes_v1_group_proto_rawDescGZIP() []byte {
 }
 
 var file_lumenetes_v1_group_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_lumenetes_v1_group_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_lumenetes_v1_group_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_lumenetes_v1_group_proto_goTypes = []any{
 	(ActiveSceneKind)(0),          // 0: lumenetes.v1.ActiveSceneKind
 	(*ActiveSceneRef)(nil),        // 1: lumenetes.v1.ActiveSceneRef
-	(*Group)(nil),                 // 2: lumenetes.v1.Group
-	(*ListGroupsRequest)(nil),     // 3: lumenetes.v1.ListGroupsRequest
-	(*ListGroupsResponse)(nil),    // 4: lumenetes.v1.ListGroupsResponse
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*AwayMode)(nil),              // 2: lumenetes.v1.AwayMode
+	(*Group)(nil),                 // 3: lumenetes.v1.Group
+	(*ListGroupsRequest)(nil),     // 4: lumenetes.v1.ListGroupsRequest
+	(*ListGroupsResponse)(nil),    // 5: lumenetes.v1.ListGroupsResponse
+	(*SetAwayRequest)(nil),        // 6: lumenetes.v1.SetAwayRequest
+	(*SetAwayResponse)(nil),       // 7: lumenetes.v1.SetAwayResponse
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
 }
 var file_lumenetes_v1_group_proto_depIdxs = []int32{
 	0, // 0: lumenetes.v1.ActiveSceneRef.kind:type_name -> lumenetes.v1.ActiveSceneKind
 	1, // 1: lumenetes.v1.Group.active_scene:type_name -> lumenetes.v1.ActiveSceneRef
-	5, // 2: lumenetes.v1.Group.last_synced:type_name -> google.protobuf.Timestamp
-	2, // 3: lumenetes.v1.ListGroupsResponse.groups:type_name -> lumenetes.v1.Group
-	3, // 4: lumenetes.v1.GroupService.ListGroups:input_type -> lumenetes.v1.ListGroupsRequest
-	4, // 5: lumenetes.v1.GroupService.ListGroups:output_type -> lumenetes.v1.ListGroupsResponse
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	8, // 2: lumenetes.v1.Group.last_synced:type_name -> google.protobuf.Timestamp
+	2, // 3: lumenetes.v1.Group.away:type_name -> lumenetes.v1.AwayMode
+	3, // 4: lumenetes.v1.ListGroupsResponse.groups:type_name -> lumenetes.v1.Group
+	3, // 5: lumenetes.v1.SetAwayResponse.group:type_name -> lumenetes.v1.Group
+	4, // 6: lumenetes.v1.GroupService.ListGroups:input_type -> lumenetes.v1.ListGroupsRequest
+	6, // 7: lumenetes.v1.GroupService.SetAway:input_type -> lumenetes.v1.SetAwayRequest
+	5, // 8: lumenetes.v1.GroupService.ListGroups:output_type -> lumenetes.v1.ListGroupsResponse
+	7, // 9: lumenetes.v1.GroupService.SetAway:output_type -> lumenetes.v1.SetAwayResponse
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_lumenetes_v1_group_proto_init() }
@@ -379,7 +552,7 @@ func file_lumenetes_v1_group_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lumenetes_v1_group_proto_rawDesc), len(file_lumenetes_v1_group_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
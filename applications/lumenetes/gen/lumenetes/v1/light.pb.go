@@ -48,8 +48,11 @@ type Light struct {
 	LastSynced       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=last_synced,json=lastSynced,proto3" json:"last_synced,omitempty"`
 	LastEnactAttempt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=last_enact_attempt,json=lastEnactAttempt,proto3" json:"last_enact_attempt,omitempty"`
 	EnactError       string                 `protobuf:"bytes,19,opt,name=enact_error,json=enactError,proto3" json:"enact_error,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Fields where desired differs from observed as of the controller's last
+	// reconcile - recorded even in dry-run, where nothing is enacted.
+	PendingChanges []*PendingChange `protobuf:"bytes,20,rep,name=pending_changes,json=pendingChanges,proto3" json:"pending_changes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Light) Reset() {
@@ -215,6 +218,73 @@ func (x *Light) GetEnactError() string {
 	return ""
 }
 
+func (x *Light) GetPendingChanges() []*PendingChange {
+	if x != nil {
+		return x.PendingChanges
+	}
+	return nil
+}
+
+type PendingChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Want          string                 `protobuf:"bytes,2,opt,name=want,proto3" json:"want,omitempty"`
+	Have          string                 `protobuf:"bytes,3,opt,name=have,proto3" json:"have,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingChange) Reset() {
+	*x = PendingChange{}
+	mi := &file_lumenetes_v1_light_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingChange) ProtoMessage() {}
+
+func (x *PendingChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lumenetes_v1_light_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingChange.ProtoReflect.Descriptor instead.
+func (*PendingChange) Descriptor() ([]byte, []int) {
+	return file_lumenetes_v1_light_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PendingChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *PendingChange) GetWant() string {
+	if x != nil {
+		return x.Want
+	}
+	return ""
+}
+
+func (x *PendingChange) GetHave() string {
+	if x != nil {
+		return x.Have
+	}
+	return ""
+}
+
 type ListLightsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -223,7 +293,7 @@ type ListLightsRequest struct {
 
 func (x *ListLightsRequest) Reset() {
 	*x = ListLightsRequest{}
-	mi := &file_lumenetes_v1_light_proto_msgTypes[1]
+	mi := &file_lumenetes_v1_light_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -235,7 +305,7 @@ func (x *ListLightsRequest) String() string {
 func (*ListLightsRequest) ProtoMessage() {}
 
 func (x *ListLightsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lumenetes_v1_light_proto_msgTypes[1]
+	mi := &file_lumenetes_v1_light_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -248,7 +318,7 @@ func (x *ListLightsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLightsRequest.ProtoReflect.Descriptor instead.
 func (*ListLightsRequest) Descriptor() ([]byte, []int) {
-	return file_lumenetes_v1_light_proto_rawDescGZIP(), []int{1}
+	return file_lumenetes_v1_light_proto_rawDescGZIP(), []int{2}
 }
 
 type ListLightsResponse struct {
@@ -260,7 +330,7 @@ type ListLightsResponse struct {
 
 func (x *ListLightsResponse) Reset() {
 	*x = ListLightsResponse{}
-	mi := &file_lumenetes_v1_light_proto_msgTypes[2]
+	mi := &file_lumenetes_v1_light_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -272,7 +342,7 @@ func (x *ListLightsResponse) String() string {
 func (*ListLightsResponse) ProtoMessage() {}
 
 func (x *ListLightsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lumenetes_v1_light_proto_msgTypes[2]
+	mi := &file_lumenetes_v1_light_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,7 +355,7 @@ func (x *ListLightsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLightsResponse.ProtoReflect.Descriptor instead.
 func (*ListLightsResponse) Descriptor() ([]byte, []int) {
-	return file_lumenetes_v1_light_proto_rawDescGZIP(), []int{2}
+	return file_lumenetes_v1_light_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListLightsResponse) GetLights() []*Light {
@@ -295,11 +365,92 @@ func (x *ListLightsResponse) GetLights() []*Light {
 	return nil
 }
 
+type ListDriftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDriftRequest) Reset() {
+	*x = ListDriftRequest{}
+	mi := &file_lumenetes_v1_light_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriftRequest) ProtoMessage() {}
+
+func (x *ListDriftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lumenetes_v1_light_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriftRequest.ProtoReflect.Descriptor instead.
+func (*ListDriftRequest) Descriptor() ([]byte, []int) {
+	return file_lumenetes_v1_light_proto_rawDescGZIP(), []int{4}
+}
+
+type ListDriftResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only lights with at least one pending change.
+	Lights        []*Light `protobuf:"bytes,1,rep,name=lights,proto3" json:"lights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDriftResponse) Reset() {
+	*x = ListDriftResponse{}
+	mi := &file_lumenetes_v1_light_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriftResponse) ProtoMessage() {}
+
+func (x *ListDriftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lumenetes_v1_light_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriftResponse.ProtoReflect.Descriptor instead.
+func (*ListDriftResponse) Descriptor() ([]byte, []int) {
+	return file_lumenetes_v1_light_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListDriftResponse) GetLights() []*Light {
+	if x != nil {
+		return x.Lights
+	}
+	return nil
+}
+
 var File_lumenetes_v1_light_proto protoreflect.FileDescriptor
 
 const file_lumenetes_v1_light_proto_rawDesc = "" +
 	"\n" +
-	"\x18lumenetes/v1/light.proto\x12\flumenetes.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcd\x05\n" +
+	"\x18lumenetes/v1/light.proto\x12\flumenetes.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x93\x06\n" +
 	"\x05Light\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
@@ -324,13 +475,22 @@ const file_lumenetes_v1_light_proto_rawDesc = "" +
 	"lastSynced\x12H\n" +
 	"\x12last_enact_attempt\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\x10lastEnactAttempt\x12\x1f\n" +
 	"\venact_error\x18\x13 \x01(\tR\n" +
-	"enactError\"\x13\n" +
+	"enactError\x12D\n" +
+	"\x0fpending_changes\x18\x14 \x03(\v2\x1b.lumenetes.v1.PendingChangeR\x0ependingChanges\"M\n" +
+	"\rPendingChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04want\x18\x02 \x01(\tR\x04want\x12\x12\n" +
+	"\x04have\x18\x03 \x01(\tR\x04have\"\x13\n" +
 	"\x11ListLightsRequest\"A\n" +
 	"\x12ListLightsResponse\x12+\n" +
-	"\x06lights\x18\x01 \x03(\v2\x13.lumenetes.v1.LightR\x06lights2_\n" +
+	"\x06lights\x18\x01 \x03(\v2\x13.lumenetes.v1.LightR\x06lights\"\x12\n" +
+	"\x10ListDriftRequest\"@\n" +
+	"\x11ListDriftResponse\x12+\n" +
+	"\x06lights\x18\x01 \x03(\v2\x13.lumenetes.v1.LightR\x06lights2\xad\x01\n" +
 	"\fLightService\x12O\n" +
 	"\n" +
-	"ListLights\x12\x1f.lumenetes.v1.ListLightsRequest\x1a .lumenetes.v1.ListLightsResponseB>Z<github.com/liamawhite/lumenetes/gen/lumenetes/v1;lumenetesv1b\x06proto3"
+	"ListLights\x12\x1f.lumenetes.v1.ListLightsRequest\x1a .lumenetes.v1.ListLightsResponse\x12L\n" +
+	"\tListDrift\x12\x1e.lumenetes.v1.ListDriftRequest\x1a\x1f.lumenetes.v1.ListDriftResponseB>Z<github.com/liamawhite/lumenetes/gen/lumenetes/v1;lumenetesv1b\x06proto3"
 
 var (
 	file_lumenetes_v1_light_proto_rawDescOnce sync.Once
@@ -344,24 +504,31 @@ func file_lumenetes_v1_light_proto_rawDescGZIP() []byte {
 	return file_lumenetes_v1_light_proto_rawDescData
 }
 
-var file_lumenetes_v1_light_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_lumenetes_v1_light_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_lumenetes_v1_light_proto_goTypes = []any{
 	(*Light)(nil),                 // 0: lumenetes.v1.Light
-	(*ListLightsRequest)(nil),     // 1: lumenetes.v1.ListLightsRequest
-	(*ListLightsResponse)(nil),    // 2: lumenetes.v1.ListLightsResponse
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*PendingChange)(nil),         // 1: lumenetes.v1.PendingChange
+	(*ListLightsRequest)(nil),     // 2: lumenetes.v1.ListLightsRequest
+	(*ListLightsResponse)(nil),    // 3: lumenetes.v1.ListLightsResponse
+	(*ListDriftRequest)(nil),      // 4: lumenetes.v1.ListDriftRequest
+	(*ListDriftResponse)(nil),     // 5: lumenetes.v1.ListDriftResponse
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
 }
 var file_lumenetes_v1_light_proto_depIdxs = []int32{
-	3, // 0: lumenetes.v1.Light.last_synced:type_name -> google.protobuf.Timestamp
-	3, // 1: lumenetes.v1.Light.last_enact_attempt:type_name -> google.protobuf.Timestamp
-	0, // 2: lumenetes.v1.ListLightsResponse.lights:type_name -> lumenetes.v1.Light
-	1, // 3: lumenetes.v1.LightService.ListLights:input_type -> lumenetes.v1.ListLightsRequest
-	2, // 4: lumenetes.v1.LightService.ListLights:output_type -> lumenetes.v1.ListLightsResponse
-	4, // [4:5] is the sub-list for method output_type
-	3, // [3:4] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	6, // 0: lumenetes.v1.Light.last_synced:type_name -> google.protobuf.Timestamp
+	6, // 1: lumenetes.v1.Light.last_enact_attempt:type_name -> google.protobuf.Timestamp
+	1, // 2: lumenetes.v1.Light.pending_changes:type_name -> lumenetes.v1.PendingChange
+	0, // 3: lumenetes.v1.ListLightsResponse.lights:type_name -> lumenetes.v1.Light
+	0, // 4: lumenetes.v1.ListDriftResponse.lights:type_name -> lumenetes.v1.Light
+	2, // 5: lumenetes.v1.LightService.ListLights:input_type -> lumenetes.v1.ListLightsRequest
+	4, // 6: lumenetes.v1.LightService.ListDrift:input_type -> lumenetes.v1.ListDriftRequest
+	3, // 7: lumenetes.v1.LightService.ListLights:output_type -> lumenetes.v1.ListLightsResponse
+	5, // 8: lumenetes.v1.LightService.ListDrift:output_type -> lumenetes.v1.ListDriftResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_lumenetes_v1_light_proto_init() }
@@ -375,7 +542,7 @@ func file_lumenetes_v1_light_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lumenetes_v1_light_proto_rawDesc), len(file_lumenetes_v1_light_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -35,11 +35,17 @@ const (
 const (
 	// GroupServiceListGroupsProcedure is the fully-qualified name of the GroupService's ListGroups RPC.
 	GroupServiceListGroupsProcedure = "/lumenetes.v1.GroupService/ListGroups"
+	// GroupServiceSetAwayProcedure is the fully-qualified name of the GroupService's SetAway RPC.
+	GroupServiceSetAwayProcedure = "/lumenetes.v1.GroupService/SetAway"
 )
 
 // GroupServiceClient is a client for the lumenetes.v1.GroupService service.
 type GroupServiceClient interface {
 	ListGroups(context.Context, *connect.Request[v1.ListGroupsRequest]) (*connect.Response[v1.ListGroupsResponse], error)
+	// SetAway turns a Group's presence simulation on or off - the one
+	// write this API exposes, limited to a single toggle rather than a
+	// general Group update.
+	SetAway(context.Context, *connect.Request[v1.SetAwayRequest]) (*connect.Response[v1.SetAwayResponse], error)
 }
 
 // NewGroupServiceClient constructs a client for the lumenetes.v1.GroupService service. By default,
@@ -59,12 +65,19 @@ func NewGroupServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(groupServiceMethods.ByName("ListGroups")),
 			connect.WithClientOptions(opts...),
 		),
+		setAway: connect.NewClient[v1.SetAwayRequest, v1.SetAwayResponse](
+			httpClient,
+			baseURL+GroupServiceSetAwayProcedure,
+			connect.WithSchema(groupServiceMethods.ByName("SetAway")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // groupServiceClient implements GroupServiceClient.
 type groupServiceClient struct {
 	listGroups *connect.Client[v1.ListGroupsRequest, v1.ListGroupsResponse]
+	setAway    *connect.Client[v1.SetAwayRequest, v1.SetAwayResponse]
 }
 
 // ListGroups calls lumenetes.v1.GroupService.ListGroups.
@@ -72,9 +85,18 @@ func (c *groupServiceClient) ListGroups(ctx context.Context, req *connect.Reques
 	return c.listGroups.CallUnary(ctx, req)
 }
 
+// SetAway calls lumenetes.v1.GroupService.SetAway.
+func (c *groupServiceClient) SetAway(ctx context.Context, req *connect.Request[v1.SetAwayRequest]) (*connect.Response[v1.SetAwayResponse], error) {
+	return c.setAway.CallUnary(ctx, req)
+}
+
 // GroupServiceHandler is an implementation of the lumenetes.v1.GroupService service.
 type GroupServiceHandler interface {
 	ListGroups(context.Context, *connect.Request[v1.ListGroupsRequest]) (*connect.Response[v1.ListGroupsResponse], error)
+	// SetAway turns a Group's presence simulation on or off - the one
+	// write this API exposes, limited to a single toggle rather than a
+	// general Group update.
+	SetAway(context.Context, *connect.Request[v1.SetAwayRequest]) (*connect.Response[v1.SetAwayResponse], error)
 }
 
 // NewGroupServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -90,10 +112,18 @@ func NewGroupServiceHandler(svc GroupServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(groupServiceMethods.ByName("ListGroups")),
 		connect.WithHandlerOptions(opts...),
 	)
+	groupServiceSetAwayHandler := connect.NewUnaryHandler(
+		GroupServiceSetAwayProcedure,
+		svc.SetAway,
+		connect.WithSchema(groupServiceMethods.ByName("SetAway")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/lumenetes.v1.GroupService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GroupServiceListGroupsProcedure:
 			groupServiceListGroupsHandler.ServeHTTP(w, r)
+		case GroupServiceSetAwayProcedure:
+			groupServiceSetAwayHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -106,3 +136,7 @@ type UnimplementedGroupServiceHandler struct{}
 func (UnimplementedGroupServiceHandler) ListGroups(context.Context, *connect.Request[v1.ListGroupsRequest]) (*connect.Response[v1.ListGroupsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lumenetes.v1.GroupService.ListGroups is not implemented"))
 }
+
+func (UnimplementedGroupServiceHandler) SetAway(context.Context, *connect.Request[v1.SetAwayRequest]) (*connect.Response[v1.SetAwayResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lumenetes.v1.GroupService.SetAway is not implemented"))
+}
@@ -35,11 +35,16 @@ const (
 const (
 	// LightServiceListLightsProcedure is the fully-qualified name of the LightService's ListLights RPC.
 	LightServiceListLightsProcedure = "/lumenetes.v1.LightService/ListLights"
+	// LightServiceListDriftProcedure is the fully-qualified name of the LightService's ListDrift RPC.
+	LightServiceListDriftProcedure = "/lumenetes.v1.LightService/ListDrift"
 )
 
 // LightServiceClient is a client for the lumenetes.v1.LightService service.
 type LightServiceClient interface {
 	ListLights(context.Context, *connect.Request[v1.ListLightsRequest]) (*connect.Response[v1.ListLightsResponse], error)
+	// ListDrift returns what the controller would change if it weren't (or
+	// isn't) in dry-run mode.
+	ListDrift(context.Context, *connect.Request[v1.ListDriftRequest]) (*connect.Response[v1.ListDriftResponse], error)
 }
 
 // NewLightServiceClient constructs a client for the lumenetes.v1.LightService service. By default,
@@ -59,12 +64,19 @@ func NewLightServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(lightServiceMethods.ByName("ListLights")),
 			connect.WithClientOptions(opts...),
 		),
+		listDrift: connect.NewClient[v1.ListDriftRequest, v1.ListDriftResponse](
+			httpClient,
+			baseURL+LightServiceListDriftProcedure,
+			connect.WithSchema(lightServiceMethods.ByName("ListDrift")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // lightServiceClient implements LightServiceClient.
 type lightServiceClient struct {
 	listLights *connect.Client[v1.ListLightsRequest, v1.ListLightsResponse]
+	listDrift  *connect.Client[v1.ListDriftRequest, v1.ListDriftResponse]
 }
 
 // ListLights calls lumenetes.v1.LightService.ListLights.
@@ -72,9 +84,17 @@ func (c *lightServiceClient) ListLights(ctx context.Context, req *connect.Reques
 	return c.listLights.CallUnary(ctx, req)
 }
 
+// ListDrift calls lumenetes.v1.LightService.ListDrift.
+func (c *lightServiceClient) ListDrift(ctx context.Context, req *connect.Request[v1.ListDriftRequest]) (*connect.Response[v1.ListDriftResponse], error) {
+	return c.listDrift.CallUnary(ctx, req)
+}
+
 // LightServiceHandler is an implementation of the lumenetes.v1.LightService service.
 type LightServiceHandler interface {
 	ListLights(context.Context, *connect.Request[v1.ListLightsRequest]) (*connect.Response[v1.ListLightsResponse], error)
+	// ListDrift returns what the controller would change if it weren't (or
+	// isn't) in dry-run mode.
+	ListDrift(context.Context, *connect.Request[v1.ListDriftRequest]) (*connect.Response[v1.ListDriftResponse], error)
 }
 
 // NewLightServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -90,10 +110,18 @@ func NewLightServiceHandler(svc LightServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(lightServiceMethods.ByName("ListLights")),
 		connect.WithHandlerOptions(opts...),
 	)
+	lightServiceListDriftHandler := connect.NewUnaryHandler(
+		LightServiceListDriftProcedure,
+		svc.ListDrift,
+		connect.WithSchema(lightServiceMethods.ByName("ListDrift")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/lumenetes.v1.LightService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case LightServiceListLightsProcedure:
 			lightServiceListLightsHandler.ServeHTTP(w, r)
+		case LightServiceListDriftProcedure:
+			lightServiceListDriftHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -106,3 +134,7 @@ type UnimplementedLightServiceHandler struct{}
 func (UnimplementedLightServiceHandler) ListLights(context.Context, *connect.Request[v1.ListLightsRequest]) (*connect.Response[v1.ListLightsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lumenetes.v1.LightService.ListLights is not implemented"))
 }
+
+func (UnimplementedLightServiceHandler) ListDrift(context.Context, *connect.Request[v1.ListDriftRequest]) (*connect.Response[v1.ListDriftResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lumenetes.v1.LightService.ListDrift is not implemented"))
+}
@@ -0,0 +1,107 @@
+// Package away records a Group's on/off history and replays it as presence
+// simulation (see GroupSpec.Away) - pure functions over
+// GroupStatus.OnHistory, called by internal/groupcontroller.Reconciler,
+// with no client or clock of their own.
+package away
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	lumenetesv1alpha1 "github.com/liamawhite/lumenetes/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HistoryDays is how many days of on/off history Record keeps, and so how
+// far back Replay can draw a day from - a week covers both a weekday and
+// a weekend pattern without the status growing unboundedly.
+const HistoryDays = 7
+
+const day = 24 * time.Hour
+
+// ErrNoHistory is returned by Replay when too little history has been
+// recorded to draw a whole day from - e.g. right after a Group is created,
+// or when the group has never been seen reachable.
+var ErrNoHistory = errors.New("not enough on/off history recorded yet")
+
+// Record returns history after observing the group as on at now: a new
+// transition is appended only if on differs from the last recorded state,
+// and transitions older than HistoryDays are dropped - except the newest
+// of those, which is still needed to know the state at the start of the
+// window. changed reports whether the result differs from history, so the
+// caller can skip a no-op status write.
+func Record(history []lumenetesv1alpha1.OnTransition, on bool, now time.Time) (next []lumenetesv1alpha1.OnTransition, changed bool) {
+	next = history
+	if len(next) == 0 || next[len(next)-1].On != on {
+		next = append(next[:len(next):len(next)], lumenetesv1alpha1.OnTransition{At: metav1.NewTime(now), On: on})
+		changed = true
+	}
+
+	cutoff := now.Add(-HistoryDays * day)
+	drop := 0
+	for drop+1 < len(next) && !next[drop+1].At.After(cutoff) {
+		drop++
+	}
+	if drop > 0 {
+		next = next[drop:]
+		changed = true
+	}
+	return next, changed
+}
+
+// Replay returns whether the group should be on at now, by looking up its
+// recorded state at the same time of day on one of the last HistoryDays
+// days, shifted by up to ±jitter. The day and the shift are chosen at
+// random, but deterministically from seed (the Group's name) and now's UTC
+// date - so every reconcile within the same day agrees, rather than the
+// lights flickering as a fresh draw is made each time, while two groups
+// (or two days) still don't replay in lockstep. The draw changes at UTC
+// midnight, which can flip the state then; days are whole 24h steps back,
+// so across a DST change the replay runs an hour off local time for a
+// week - both well within the jitter a watcher would expect anyway.
+//
+// Only days whose shifted window is fully covered by history are
+// candidates; ErrNoHistory if there are none.
+func Replay(history []lumenetesv1alpha1.OnTransition, seed string, now time.Time, jitter time.Duration) (bool, error) {
+	if len(history) == 0 {
+		return false, ErrNoHistory
+	}
+	if jitter < 0 {
+		return false, fmt.Errorf("away: negative jitter %s", jitter)
+	}
+	jitterMinutes := int64(jitter / time.Minute)
+
+	earliest := history[0].At.Time
+	var candidates []int
+	for k := 1; k <= HistoryDays; k++ {
+		if !now.Add(-time.Duration(k)*day - time.Duration(jitterMinutes)*time.Minute).Before(earliest) {
+			candidates = append(candidates, k)
+		}
+	}
+	if len(candidates) == 0 {
+		return false, ErrNoHistory
+	}
+
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s/%s", seed, now.UTC().Format(time.DateOnly))
+	draw := h.Sum64()
+	k := candidates[draw%uint64(len(candidates))]
+	shift := int64((draw>>32)%uint64(2*jitterMinutes+1)) - jitterMinutes
+
+	return stateAt(history, now.Add(-time.Duration(k)*day+time.Duration(shift)*time.Minute)), nil
+}
+
+// stateAt returns the recorded state at t - the On of the last transition
+// at or before t. Callers guarantee t isn't before history[0].
+func stateAt(history []lumenetesv1alpha1.OnTransition, t time.Time) bool {
+	on := history[0].On
+	for _, transition := range history {
+		if transition.At.After(t) {
+			break
+		}
+		on = transition.On
+	}
+	return on
+}
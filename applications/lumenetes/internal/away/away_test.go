@@ -0,0 +1,171 @@
+package away
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	lumenetesv1alpha1 "github.com/liamawhite/lumenetes/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func transition(at time.Time, on bool) lumenetesv1alpha1.OnTransition {
+	return lumenetesv1alpha1.OnTransition{At: metav1.NewTime(at), On: on}
+}
+
+func TestRecord_AppendsOnlyOnChange(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 18, 0, 0, 0, time.UTC)
+
+	history, changed := Record(nil, true, now)
+	if !changed || len(history) != 1 || !history[0].On {
+		t.Fatalf("Record(nil, true) = %v, %v; want one on transition, changed", history, changed)
+	}
+
+	history, changed = Record(history, true, now.Add(time.Minute))
+	if changed || len(history) != 1 {
+		t.Errorf("Record with unchanged state = %v, %v; want unchanged", history, changed)
+	}
+
+	history, changed = Record(history, false, now.Add(time.Hour))
+	if !changed || len(history) != 2 || history[1].On {
+		t.Errorf("Record(off) = %v, %v; want an off transition appended", history, changed)
+	}
+}
+
+func TestRecord_DoesNotAliasCallerSlice(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 18, 0, 0, 0, time.UTC)
+	history := make([]lumenetesv1alpha1.OnTransition, 1, 4)
+	history[0] = transition(now, true)
+
+	next, _ := Record(history, false, now.Add(time.Hour))
+	next[0].On = false
+	if !history[0].On {
+		t.Error("Record's result shares its backing array with the input")
+	}
+}
+
+func TestRecord_PrunesKeepingStateAtWindowStart(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 18, 0, 0, 0, time.UTC)
+	history := []lumenetesv1alpha1.OnTransition{
+		transition(now.Add(-10*day), true),
+		transition(now.Add(-9*day), false),
+		transition(now.Add(-8*day), true),
+		transition(now.Add(-2*day), false),
+	}
+
+	got, changed := Record(history, false, now)
+	if !changed {
+		t.Fatal("Record didn't report pruning as a change")
+	}
+	if len(got) != 2 || !got[0].At.Equal(&history[2].At) || !got[1].At.Equal(&history[3].At) {
+		t.Errorf("Record pruned to %v, want the -8d and -2d transitions", got)
+	}
+}
+
+func TestReplay_NoHistory(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 18, 0, 0, 0, time.UTC)
+	if _, err := Replay(nil, "living-room", now, 20*time.Minute); !errors.Is(err, ErrNoHistory) {
+		t.Errorf("Replay(nil) error = %v, want ErrNoHistory", err)
+	}
+
+	// Less than a day (plus jitter) recorded: no day to draw from yet.
+	recent := []lumenetesv1alpha1.OnTransition{transition(now.Add(-12*time.Hour), true)}
+	if _, err := Replay(recent, "living-room", now, 20*time.Minute); !errors.Is(err, ErrNoHistory) {
+		t.Errorf("Replay with 12h of history error = %v, want ErrNoHistory", err)
+	}
+}
+
+// dailyPattern is a week of the same evening: on at 18:00, off at 23:00.
+func dailyPattern(now time.Time) []lumenetesv1alpha1.OnTransition {
+	midnight := now.Truncate(day)
+	history := []lumenetesv1alpha1.OnTransition{transition(midnight.Add(-HistoryDays*day-time.Hour), false)}
+	for k := HistoryDays; k >= 1; k-- {
+		d := midnight.Add(-time.Duration(k) * day)
+		history = append(history, transition(d.Add(18*time.Hour), true), transition(d.Add(23*time.Hour), false))
+	}
+	return history
+}
+
+func TestReplay_FollowsRecordedPatternWithinJitter(t *testing.T) {
+	today := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	history := dailyPattern(today)
+	jitter := 30 * time.Minute
+
+	cases := []struct {
+		at   time.Duration
+		want bool
+	}{
+		{12 * time.Hour, false},
+		{18*time.Hour + 31*time.Minute, true},
+		{21 * time.Hour, true},
+		{23*time.Hour + 31*time.Minute, false},
+	}
+	for _, tc := range cases {
+		got, err := Replay(history, "living-room", today.Add(tc.at), jitter)
+		if err != nil {
+			t.Fatalf("Replay at +%s: %v", tc.at, err)
+		}
+		if got != tc.want {
+			t.Errorf("Replay at +%s = %v, want %v", tc.at, got, tc.want)
+		}
+	}
+}
+
+func TestReplay_StableWithinADay(t *testing.T) {
+	// Every reconcile on the same day must make the same draw, or the
+	// lights would flicker around each transition.
+	today := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	history := dailyPattern(today)
+
+	var switches int
+	prev := false
+	for m := 0; m < 24*60; m++ {
+		on, err := Replay(history, "living-room", today.Add(time.Duration(m)*time.Minute), 30*time.Minute)
+		if err != nil {
+			t.Fatalf("Replay: %v", err)
+		}
+		if on != prev {
+			switches++
+		}
+		prev = on
+	}
+	if switches != 2 {
+		t.Errorf("lights switched %d times over the day, want exactly 2 (on then off)", switches)
+	}
+}
+
+func TestReplay_JitterVariesBetweenGroups(t *testing.T) {
+	today := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	history := dailyPattern(today)
+
+	switchOn := func(seed string, date time.Time) time.Time {
+		t.Helper()
+		for m := 17 * 60; m < 20*60; m++ {
+			at := date.Add(time.Duration(m) * time.Minute)
+			on, err := Replay(history, seed, at, time.Hour)
+			if err != nil {
+				t.Fatalf("Replay: %v", err)
+			}
+			if on {
+				return at
+			}
+		}
+		t.Fatalf("%s never switched on around 18:00 on %s", seed, date.Format(time.DateOnly))
+		return time.Time{}
+	}
+
+	seen := map[time.Duration]bool{}
+	for _, seed := range []string{"living-room", "kitchen", "bedroom", "hallway"} {
+		seen[switchOn(seed, today).Sub(today)] = true
+	}
+	if len(seen) < 2 {
+		t.Errorf("four groups all switched on at the same minute, want their jitter to differ")
+	}
+}
+
+func TestReplay_NegativeJitter(t *testing.T) {
+	today := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	if _, err := Replay(dailyPattern(today), "living-room", today, -time.Minute); err == nil {
+		t.Error("expected error for negative jitter")
+	}
+}
@@ -1,6 +1,6 @@
-// Package circadian interpolates a CircadianSchedule's keyframes to a
-// single (brightness, colorTempK) pair for a given instant - the pure
-// computation internal/groupcontroller.Reconciler and
+// Package circadian interpolates a CircadianSchedule's keyframes (or
+// elevation table) to a single (brightness, colorTempK) pair for a given
+// instant - the pure computation internal/groupcontroller.Reconciler and
 // internal/circadianschedulecontroller both call, so neither duplicates
 // the other's notion of "what does this schedule output right now."
 package circadian
@@ -8,6 +8,7 @@ package circadian
 import (
 	"fmt"
 	"math"
+	"slices"
 	"sort"
 	"time"
 
@@ -15,6 +16,66 @@ import (
 	"github.com/liamawhite/lumenetes/internal/sun"
 )
 
+// Evaluate returns spec's output at now, dispatching on spec.Mode to
+// Interpolate or InterpolateElevation - the one entry point callers should
+// use, so a new mode never needs each of them to grow its own switch.
+func Evaluate(spec lumenetesv1alpha1.CircadianScheduleSpec, now time.Time) (brightness, colorTempK int32, on lumenetesv1alpha1.CircadianOnState, err error) {
+	coords := sun.Coordinates{Latitude: spec.Latitude, Longitude: spec.Longitude}
+	switch spec.Mode {
+	case "", lumenetesv1alpha1.CircadianModeKeyframes:
+		var loc *time.Location
+		if spec.TimeZone != "" {
+			if loc, err = time.LoadLocation(spec.TimeZone); err != nil {
+				return 0, 0, lumenetesv1alpha1.CircadianOnStateUnchanged, fmt.Errorf("circadian: invalid timeZone: %w", err)
+			}
+		}
+		if len(spec.Variants) == 0 {
+			return Interpolate(spec.Keyframes, coords, loc, now)
+		}
+		return InterpolateVariants(spec.Keyframes, spec.Variants, coords, loc, now)
+	case lumenetesv1alpha1.CircadianModeElevation:
+		brightness, colorTempK, err = InterpolateElevation(spec.Elevation, coords, now)
+		return brightness, colorTempK, lumenetesv1alpha1.CircadianOnStateUnchanged, err
+	default:
+		return 0, 0, lumenetesv1alpha1.CircadianOnStateUnchanged, fmt.Errorf("circadian: unknown mode %q", spec.Mode)
+	}
+}
+
+// InterpolateElevation returns the brightness/colorTempK points
+// interpolates to for the sun's elevation at now, as seen from coords:
+// linear between the two points bracketing the current elevation, clamped
+// to the lowest/highest point's values outside the table's range. Unlike
+// Interpolate there's no polar day/night case to handle at all - the
+// elevation is always defined, it just never crosses the horizon.
+//
+// Errors if fewer than 2 points are given, or two points share the same
+// Degrees (there'd be no single value to interpolate to between them).
+func InterpolateElevation(points []lumenetesv1alpha1.CircadianElevationPoint, coords sun.Coordinates, now time.Time) (brightness, colorTempK int32, err error) {
+	if len(points) < 2 {
+		return 0, 0, fmt.Errorf("circadian: need at least 2 elevation points, got %d", len(points))
+	}
+	sorted := slices.Clone(points)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Degrees < sorted[j].Degrees })
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Degrees == sorted[i-1].Degrees {
+			return 0, 0, fmt.Errorf("circadian: duplicate elevation point at %g degrees", sorted[i].Degrees)
+		}
+	}
+
+	elevation := sun.Elevation(coords, now)
+	if elevation <= sorted[0].Degrees {
+		return sorted[0].Brightness, sorted[0].ColorTempK, nil
+	}
+	last := sorted[len(sorted)-1]
+	if elevation >= last.Degrees {
+		return last.Brightness, last.ColorTempK, nil
+	}
+	i := sort.Search(len(sorted), func(i int) bool { return sorted[i].Degrees > elevation })
+	below, above := sorted[i-1], sorted[i]
+	frac := (elevation - below.Degrees) / (above.Degrees - below.Degrees)
+	return lerp(below.Brightness, above.Brightness, frac), lerp(below.ColorTempK, above.ColorTempK, frac), nil
+}
+
 // resolvedInstant is one keyframe resolved to an absolute instant on one
 // specific day.
 type resolvedInstant struct {
@@ -25,15 +86,29 @@ type resolvedInstant struct {
 }
 
 // Interpolate returns the brightness/colorTempK keyframes interpolates to
-// at now, for a location at coords. Every keyframe's Anchor+OffsetMinutes
-// is resolved against the UTC calendar day before, of, and after now (three
-// sun.Compute calls) so a keyframe near solar midnight always has the
-// correct same-day neighbor to bracket now against, even right around
-// midnight itself - a single day's sun.Times can't represent both "last
-// night's last keyframe" and "this morning's first keyframe"
-// simultaneously. now is then bracketed between the two resolved instants
+// at now, for a location at coords, with clockTime keyframes read in loc
+// (nil if the schedule has no TimeZone). Every keyframe's
+// Anchor+OffsetMinutes is resolved against the calendar day before, of,
+// and after now's own in loc (UTC if nil) - three sun.ComputeClamped calls
+// - so a keyframe near solar midnight always has the correct same-day
+// neighbor to bracket now against, even right around midnight itself - a
+// single day's sun.Times can't represent both "last night's last keyframe"
+// and "this morning's first keyframe" simultaneously. clockTime keyframes
+// are resolved on the same three local days via time.Date, so a DST
+// transition shifts them by the right amount on the right night, and a
+// mix of solar and clock anchors is then sorted purely by resolved
+// instant, whichever order that leaves them in on a given day (a 22:30
+// keyframe can land before sunset in midsummer and after it in
+// midwinter). now is then bracketed between the two resolved instants
 // nearest it (one at-or-before, one strictly after) and linearly
-// interpolated between them.
+// interpolated between them - in absolute time, so the span across a DST
+// transition is its real length, not its wall-clock one.
+//
+// On a polar day or night, sunrise/sunset resolve via
+// sun.ComputeClamped's fallback (collapsed onto solar noon, or stretched
+// out to the solar midnights either side of it) rather than failing, so a
+// schedule at high latitude keeps producing output through midsummer/
+// midwinter instead of going dark with a validation error.
 //
 // On is resolved separately from Brightness/ColorTempK: it's a step
 // function, not a curve, so the effective value at now is whatever the
@@ -45,26 +120,101 @@ type resolvedInstant struct {
 // at all.
 //
 // Errors (never a silently wrong value) if: fewer than 2 keyframes are
-// given, an anchor doesn't resolve (an unknown CircadianAnchor, or
-// sun.Compute failing - e.g. polar day/night on one of the three days
-// searched), or now can't be bracketed within the resolved window (should
-// be unreachable given CircadianKeyframe.OffsetMinutes' +/-12h bound, but
-// checked defensively rather than trusted).
-func Interpolate(keyframes []lumenetesv1alpha1.CircadianKeyframe, coords sun.Coordinates, now time.Time) (brightness, colorTempK int32, on lumenetesv1alpha1.CircadianOnState, err error) {
+// given, an anchor doesn't resolve (an unknown CircadianAnchor, or a
+// clockTime keyframe with a malformed Time or no loc to read it in), or now
+// can't be bracketed within the resolved window (should be unreachable
+// given CircadianKeyframe.OffsetMinutes' +/-12h bound, but checked
+// defensively rather than trusted).
+func Interpolate(keyframes []lumenetesv1alpha1.CircadianKeyframe, coords sun.Coordinates, loc *time.Location, now time.Time) (brightness, colorTempK int32, on lumenetesv1alpha1.CircadianOnState, err error) {
+	return interpolate(func(time.Weekday) []lumenetesv1alpha1.CircadianKeyframe { return keyframes }, coords, loc, now)
+}
+
+// InterpolateVariants is Interpolate with a keyframe set per day of the
+// week: each of the three days in Interpolate's window resolves whichever
+// variant lists that local date's weekday (falling back to keyframes for
+// a day no variant lists), rather than the same set every day. Because the
+// window always holds yesterday's, today's and tomorrow's instants side
+// by side, crossing midnight from a weekday into a weekend variant is
+// still a plain interpolation from Friday's last keyframe to Saturday's
+// first - no jump at 00:00.
+//
+// Errors, on top of Interpolate's own errors, if: loc is nil (a weekday
+// only means something in a time zone), a weekday is listed by more than
+// one variant, or any weekday ends up with fewer than 2 keyframes - all
+// checked for the whole week up front, so a bad Spec is reported
+// immediately rather than only once the window reaches the offending day.
+func InterpolateVariants(keyframes []lumenetesv1alpha1.CircadianKeyframe, variants []lumenetesv1alpha1.CircadianVariant, coords sun.Coordinates, loc *time.Location, now time.Time) (brightness, colorTempK int32, on lumenetesv1alpha1.CircadianOnState, err error) {
 	unchanged := lumenetesv1alpha1.CircadianOnStateUnchanged
-	if len(keyframes) < 2 {
-		return 0, 0, unchanged, fmt.Errorf("circadian: need at least 2 keyframes, got %d", len(keyframes))
+	if loc == nil {
+		return 0, 0, unchanged, fmt.Errorf("circadian: variants need a timeZone to tell which weekday it is")
 	}
 
-	day := now.UTC().Truncate(24 * time.Hour)
-	resolved := make([]resolvedInstant, 0, len(keyframes)*3)
+	var week [7][]lumenetesv1alpha1.CircadianKeyframe
+	var claimed [7]bool
+	for _, variant := range variants {
+		for _, day := range variant.Days {
+			wd, ok := weekdays[day]
+			if !ok {
+				return 0, 0, unchanged, fmt.Errorf("circadian: unknown weekday %q", day)
+			}
+			if claimed[wd] {
+				return 0, 0, unchanged, fmt.Errorf("circadian: %s is listed by more than one variant", day)
+			}
+			claimed[wd] = true
+			week[wd] = variant.Keyframes
+		}
+	}
+	for wd := range week {
+		if !claimed[wd] {
+			week[wd] = keyframes
+		}
+		if len(week[wd]) < 2 {
+			return 0, 0, unchanged, fmt.Errorf("circadian: need at least 2 keyframes for %s, got %d", time.Weekday(wd), len(week[wd]))
+		}
+	}
+
+	return interpolate(func(wd time.Weekday) []lumenetesv1alpha1.CircadianKeyframe { return week[wd] }, coords, loc, now)
+}
+
+var weekdays = map[lumenetesv1alpha1.CircadianWeekday]time.Weekday{
+	lumenetesv1alpha1.CircadianWeekdayMonday:    time.Monday,
+	lumenetesv1alpha1.CircadianWeekdayTuesday:   time.Tuesday,
+	lumenetesv1alpha1.CircadianWeekdayWednesday: time.Wednesday,
+	lumenetesv1alpha1.CircadianWeekdayThursday:  time.Thursday,
+	lumenetesv1alpha1.CircadianWeekdayFriday:    time.Friday,
+	lumenetesv1alpha1.CircadianWeekdaySaturday:  time.Saturday,
+	lumenetesv1alpha1.CircadianWeekdaySunday:    time.Sunday,
+}
+
+// interpolate is Interpolate/InterpolateVariants' shared implementation,
+// with keyframesOn picking the keyframe set for each day in the window.
+// Days are local calendar dates in loc (UTC if nil) - solar anchors for a
+// date are then resolved against that same calendar date's sun.Times,
+// which is what makes "Saturday's sunrise keyframe" mean Saturday's
+// sunrise in the schedule's own zone, not whichever UTC day it falls on.
+func interpolate(keyframesOn func(time.Weekday) []lumenetesv1alpha1.CircadianKeyframe, coords sun.Coordinates, loc *time.Location, now time.Time) (brightness, colorTempK int32, on lumenetesv1alpha1.CircadianOnState, err error) {
+	unchanged := lumenetesv1alpha1.CircadianOnStateUnchanged
+
+	dayLoc := loc
+	if dayLoc == nil {
+		dayLoc = time.UTC
+	}
+	y, m, d := now.In(dayLoc).Date()
+	resolved := make([]resolvedInstant, 0, len(keyframesOn(now.In(dayLoc).Weekday()))*3)
 	for _, dayOffset := range []int{-1, 0, 1} {
-		times, err := sun.Compute(coords, day.AddDate(0, 0, dayOffset))
-		if err != nil {
-			return 0, 0, unchanged, fmt.Errorf("circadian: %w", err)
+		date := time.Date(y, m, d+dayOffset, 0, 0, 0, 0, time.UTC)
+		keyframes := keyframesOn(date.Weekday())
+		if len(keyframes) < 2 {
+			return 0, 0, unchanged, fmt.Errorf("circadian: need at least 2 keyframes, got %d", len(keyframes))
 		}
+		times := sun.ComputeClamped(coords, date)
 		for _, kf := range keyframes {
-			anchor, err := anchorTime(times, kf.Anchor)
+			var anchor time.Time
+			if kf.Anchor == lumenetesv1alpha1.CircadianAnchorClockTime {
+				anchor, err = clockTime(kf.Time, loc, date)
+			} else {
+				anchor, err = anchorTime(times, kf.Anchor)
+			}
 			if err != nil {
 				return 0, 0, unchanged, err
 			}
@@ -81,7 +231,10 @@ func Interpolate(keyframes []lumenetesv1alpha1.CircadianKeyframe, coords sun.Coo
 		}
 	}
 
-	sort.Slice(resolved, func(i, j int) bool { return resolved[i].at.Before(resolved[j].at) })
+	// Stable so keyframes resolving to the same instant (e.g. sunrise/
+	// sunset collapsing onto solar noon in a polar night) keep their
+	// declared order - the last of them is then consistently "before".
+	sort.SliceStable(resolved, func(i, j int) bool { return resolved[i].at.Before(resolved[j].at) })
 
 	var before, after *resolvedInstant
 	var beforeIdx int
@@ -129,11 +282,36 @@ func anchorTime(times sun.Times, anchor lumenetesv1alpha1.CircadianAnchor) (time
 		return times.Sunset, nil
 	case lumenetesv1alpha1.CircadianAnchorSolarMidnight:
 		return times.SolarMidnight, nil
+	case lumenetesv1alpha1.CircadianAnchorCivilDawn:
+		return times.CivilDawn, nil
+	case lumenetesv1alpha1.CircadianAnchorCivilDusk:
+		return times.CivilDusk, nil
+	case lumenetesv1alpha1.CircadianAnchorNauticalDawn:
+		return times.NauticalDawn, nil
+	case lumenetesv1alpha1.CircadianAnchorNauticalDusk:
+		return times.NauticalDusk, nil
+	case lumenetesv1alpha1.CircadianAnchorAstronomicalDawn:
+		return times.AstronomicalDawn, nil
+	case lumenetesv1alpha1.CircadianAnchorAstronomicalDusk:
+		return times.AstronomicalDusk, nil
 	default:
 		return time.Time{}, fmt.Errorf("circadian: unknown anchor %q", anchor)
 	}
 }
 
+// clockTime resolves an "HH:MM" clockTime keyframe in loc on date's
+// calendar day.
+func clockTime(hhmm string, loc *time.Location, date time.Time) (time.Time, error) {
+	if loc == nil {
+		return time.Time{}, fmt.Errorf("circadian: clockTime keyframe %q needs a timeZone", hhmm)
+	}
+	t, err := time.Parse("15:04", hhmm)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("circadian: invalid clockTime keyframe time %q, want HH:MM", hhmm)
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), t.Hour(), t.Minute(), 0, 0, loc), nil
+}
+
 func lerp(a, b int32, frac float64) int32 {
 	return a + int32(math.Round(float64(b-a)*frac))
 }
@@ -3,6 +3,7 @@ package circadian
 import (
 	"testing"
 	"time"
+	_ "time/tzdata"
 
 	lumenetesv1alpha1 "github.com/liamawhite/lumenetes/api/v1alpha1"
 	"github.com/liamawhite/lumenetes/internal/sun"
@@ -20,12 +21,12 @@ func fourKeyframes() []lumenetesv1alpha1.CircadianKeyframe {
 }
 
 func TestInterpolate_TooFewKeyframes(t *testing.T) {
-	_, _, _, err := Interpolate(nil, equator, time.Now())
+	_, _, _, err := Interpolate(nil, equator, time.UTC, time.Now())
 	if err == nil {
 		t.Fatal("expected error for 0 keyframes")
 	}
 	one := []lumenetesv1alpha1.CircadianKeyframe{{Anchor: lumenetesv1alpha1.CircadianAnchorSunrise, Brightness: 10, ColorTempK: 2000}}
-	if _, _, _, err := Interpolate(one, equator, time.Now()); err == nil {
+	if _, _, _, err := Interpolate(one, equator, time.UTC, time.Now()); err == nil {
 		t.Fatal("expected error for 1 keyframe")
 	}
 }
@@ -35,7 +36,7 @@ func TestInterpolate_UnknownAnchor(t *testing.T) {
 		{Anchor: "bogus", Brightness: 10, ColorTempK: 2000},
 		{Anchor: lumenetesv1alpha1.CircadianAnchorSunset, Brightness: 90, ColorTempK: 5000},
 	}
-	if _, _, _, err := Interpolate(kfs, equator, time.Now()); err == nil {
+	if _, _, _, err := Interpolate(kfs, equator, time.UTC, time.Now()); err == nil {
 		t.Fatal("expected error for unknown anchor")
 	}
 }
@@ -48,7 +49,7 @@ func TestInterpolate_ExactlyAtKeyframeInstant(t *testing.T) {
 	}
 	kfs := fourKeyframes()
 
-	b, c, _, err := Interpolate(kfs, equator, times.Sunrise)
+	b, c, _, err := Interpolate(kfs, equator, time.UTC, times.Sunrise)
 	if err != nil {
 		t.Fatalf("Interpolate at sunrise: %v", err)
 	}
@@ -56,7 +57,7 @@ func TestInterpolate_ExactlyAtKeyframeInstant(t *testing.T) {
 		t.Errorf("Interpolate at exact sunrise = (%d, %d), want (15, 2200)", b, c)
 	}
 
-	b, c, _, err = Interpolate(kfs, equator, times.SolarNoon)
+	b, c, _, err = Interpolate(kfs, equator, time.UTC, times.SolarNoon)
 	if err != nil {
 		t.Fatalf("Interpolate at solar noon: %v", err)
 	}
@@ -74,7 +75,7 @@ func TestInterpolate_MidpointBetweenKeyframes(t *testing.T) {
 	kfs := fourKeyframes()
 
 	midpoint := times.Sunrise.Add(times.SolarNoon.Sub(times.Sunrise) / 2)
-	b, c, _, err := Interpolate(kfs, equator, midpoint)
+	b, c, _, err := Interpolate(kfs, equator, time.UTC, midpoint)
 	if err != nil {
 		t.Fatalf("Interpolate at midpoint: %v", err)
 	}
@@ -101,7 +102,7 @@ func TestInterpolate_MidnightWrapBeforeDawn(t *testing.T) {
 	kfs := fourKeyframes()
 
 	before := times.Sunrise.Add(-2 * time.Hour)
-	b, _, _, err := Interpolate(kfs, equator, before)
+	b, _, _, err := Interpolate(kfs, equator, time.UTC, before)
 	if err != nil {
 		t.Fatalf("Interpolate before dawn: %v", err)
 	}
@@ -122,7 +123,7 @@ func TestInterpolate_MidnightWrapAfterDusk(t *testing.T) {
 	kfs := fourKeyframes()
 
 	after := times.Sunset.Add(2 * time.Hour)
-	b, _, _, err := Interpolate(kfs, equator, after)
+	b, _, _, err := Interpolate(kfs, equator, time.UTC, after)
 	if err != nil {
 		t.Fatalf("Interpolate after dusk: %v", err)
 	}
@@ -151,7 +152,7 @@ func TestInterpolate_CrossMidnightSpan(t *testing.T) {
 	}
 
 	midnight := yesterday.Sunset.Add(today.Sunrise.Sub(yesterday.Sunset) / 2)
-	b, c, _, err := Interpolate(kfs, equator, midnight)
+	b, c, _, err := Interpolate(kfs, equator, time.UTC, midnight)
 	if err != nil {
 		t.Fatalf("Interpolate at cross-midnight midpoint: %v", err)
 	}
@@ -177,11 +178,11 @@ func TestInterpolate_OutOfOrderKeyframesStillWork(t *testing.T) {
 	}
 
 	midpoint := times.Sunrise.Add(times.SolarNoon.Sub(times.Sunrise) / 2)
-	bOrdered, cOrdered, _, err := Interpolate(inOrder, equator, midpoint)
+	bOrdered, cOrdered, _, err := Interpolate(inOrder, equator, time.UTC, midpoint)
 	if err != nil {
 		t.Fatalf("Interpolate (in-order): %v", err)
 	}
-	bReversed, cReversed, _, err := Interpolate(reversed, equator, midpoint)
+	bReversed, cReversed, _, err := Interpolate(reversed, equator, time.UTC, midpoint)
 	if err != nil {
 		t.Fatalf("Interpolate (reversed): %v", err)
 	}
@@ -205,7 +206,7 @@ func TestInterpolate_DuplicateKeyframesDontBreakInterpolation(t *testing.T) {
 		{Anchor: lumenetesv1alpha1.CircadianAnchorSunrise, Brightness: 10, ColorTempK: 2000},
 		{Anchor: lumenetesv1alpha1.CircadianAnchorSunset, Brightness: 100, ColorTempK: 6000},
 	}
-	b, c, _, err := Interpolate(kfs, equator, times.Sunrise)
+	b, c, _, err := Interpolate(kfs, equator, time.UTC, times.Sunrise)
 	if err != nil {
 		t.Fatalf("Interpolate with duplicate keyframes: %v", err)
 	}
@@ -226,7 +227,7 @@ func TestInterpolate_MaxOffsetKeyframesStillBracket(t *testing.T) {
 	if err != nil {
 		t.Fatalf("sun.Compute: %v", err)
 	}
-	if _, _, _, err := Interpolate(kfs, equator, times.SolarNoon); err != nil {
+	if _, _, _, err := Interpolate(kfs, equator, time.UTC, times.SolarNoon); err != nil {
 		t.Fatalf("Interpolate with max-offset keyframes: %v", err)
 	}
 }
@@ -239,7 +240,7 @@ func TestInterpolate_OnDefaultsToUnchanged(t *testing.T) {
 	if err != nil {
 		t.Fatalf("sun.Compute: %v", err)
 	}
-	_, _, on, err := Interpolate(fourKeyframes(), equator, times.SolarNoon)
+	_, _, on, err := Interpolate(fourKeyframes(), equator, time.UTC, times.SolarNoon)
 	if err != nil {
 		t.Fatalf("Interpolate: %v", err)
 	}
@@ -263,7 +264,7 @@ func TestInterpolate_OnStepFunction(t *testing.T) {
 		{Anchor: lumenetesv1alpha1.CircadianAnchorSunset, Brightness: 50, ColorTempK: 3000},
 	}
 
-	_, _, on, err := Interpolate(kfs, equator, times.Sunrise.Add(-2*time.Hour))
+	_, _, on, err := Interpolate(kfs, equator, time.UTC, times.Sunrise.Add(-2*time.Hour))
 	if err != nil {
 		t.Fatalf("Interpolate before sunrise: %v", err)
 	}
@@ -271,7 +272,7 @@ func TestInterpolate_OnStepFunction(t *testing.T) {
 		t.Errorf("On 2h before sunrise = %q, want %q", on, lumenetesv1alpha1.CircadianOnStateOff)
 	}
 
-	_, _, on, err = Interpolate(kfs, equator, times.Sunrise)
+	_, _, on, err = Interpolate(kfs, equator, time.UTC, times.Sunrise)
 	if err != nil {
 		t.Fatalf("Interpolate at sunrise: %v", err)
 	}
@@ -279,7 +280,7 @@ func TestInterpolate_OnStepFunction(t *testing.T) {
 		t.Errorf("On at sunrise = %q, want %q", on, lumenetesv1alpha1.CircadianOnStateOn)
 	}
 
-	_, _, on, err = Interpolate(kfs, equator, times.Sunset.Add(2*time.Hour))
+	_, _, on, err = Interpolate(kfs, equator, time.UTC, times.Sunset.Add(2*time.Hour))
 	if err != nil {
 		t.Fatalf("Interpolate after sunset: %v", err)
 	}
@@ -294,3 +295,376 @@ func abs32(v int32) int32 {
 	}
 	return v
 }
+
+func TestInterpolate_PolarNightFallsBackInsteadOfErroring(t *testing.T) {
+	// 75N on the December solstice: the sun never rises, so sunrise and
+	// sunset both collapse onto solar noon - the curve should still
+	// resolve, an hour later already on its way from sunset toward solar
+	// midnight.
+	arctic := sun.Coordinates{Latitude: 75, Longitude: 0}
+	times := sun.ComputeClamped(arctic, time.Date(2026, time.December, 21, 0, 0, 0, 0, time.UTC))
+	if times.Polar != sun.PolarNight {
+		t.Fatalf("test setup: Polar = %q, want polar night", times.Polar)
+	}
+
+	b, _, _, err := Interpolate(fourKeyframes(), arctic, time.UTC, times.SolarNoon.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Interpolate during polar night: %v", err)
+	}
+	if b <= 5 || b >= 70 {
+		t.Errorf("brightness 1h after polar-night solar noon = %d, want strictly between sunset (70) and solar-midnight (5)", b)
+	}
+}
+
+func TestInterpolate_PolarDayFallsBackInsteadOfErroring(t *testing.T) {
+	arctic := sun.Coordinates{Latitude: 75, Longitude: 0}
+	times := sun.ComputeClamped(arctic, time.Date(2026, time.June, 21, 0, 0, 0, 0, time.UTC))
+	if times.Polar != sun.PolarDay {
+		t.Fatalf("test setup: Polar = %q, want polar day", times.Polar)
+	}
+
+	// Halfway from (stretched) sunrise to solar noon: between the sunrise
+	// (15) and solar-noon (100) keyframes.
+	b, _, _, err := Interpolate(fourKeyframes(), arctic, time.UTC, times.SolarNoon.Add(-6*time.Hour))
+	if err != nil {
+		t.Fatalf("Interpolate during polar day: %v", err)
+	}
+	if b <= 15 || b >= 100 {
+		t.Errorf("brightness 6h before polar-day solar noon = %d, want strictly between 15 and 100", b)
+	}
+}
+
+func elevationTable() []lumenetesv1alpha1.CircadianElevationPoint {
+	return []lumenetesv1alpha1.CircadianElevationPoint{
+		{Degrees: 30, Brightness: 100, ColorTempK: 6000},
+		{Degrees: -6, Brightness: 10, ColorTempK: 2000},
+		{Degrees: 0, Brightness: 40, ColorTempK: 2700},
+	}
+}
+
+func TestInterpolateElevation_TooFewPoints(t *testing.T) {
+	one := elevationTable()[:1]
+	if _, _, err := InterpolateElevation(one, equator, time.Now()); err == nil {
+		t.Fatal("expected error for 1 elevation point")
+	}
+}
+
+func TestInterpolateElevation_DuplicateDegrees(t *testing.T) {
+	points := append(elevationTable(), lumenetesv1alpha1.CircadianElevationPoint{Degrees: 0, Brightness: 50, ColorTempK: 3000})
+	if _, _, err := InterpolateElevation(points, equator, time.Now()); err == nil {
+		t.Fatal("expected error for duplicate elevation points")
+	}
+}
+
+func TestInterpolateElevation_ClampsOutsideTable(t *testing.T) {
+	times, err := sun.Compute(equator, time.Date(2026, time.March, 20, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("sun.Compute: %v", err)
+	}
+
+	// ~90 degrees at equinox noon on the equator, far above the top point.
+	b, c, err := InterpolateElevation(elevationTable(), equator, times.SolarNoon)
+	if err != nil {
+		t.Fatalf("InterpolateElevation at noon: %v", err)
+	}
+	if b != 100 || c != 6000 {
+		t.Errorf("InterpolateElevation at noon = (%d, %d), want the highest point (100, 6000)", b, c)
+	}
+
+	// ~-90 at solar midnight, far below the bottom point.
+	b, c, err = InterpolateElevation(elevationTable(), equator, times.SolarMidnight)
+	if err != nil {
+		t.Fatalf("InterpolateElevation at midnight: %v", err)
+	}
+	if b != 10 || c != 2000 {
+		t.Errorf("InterpolateElevation at midnight = (%d, %d), want the lowest point (10, 2000)", b, c)
+	}
+}
+
+func TestInterpolateElevation_BetweenPoints(t *testing.T) {
+	times, err := sun.Compute(equator, time.Date(2026, time.March, 20, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("sun.Compute: %v", err)
+	}
+
+	// Sunrise sits just below 0 degrees, between the -6 (10) and 0 (40)
+	// points, very close to 0.
+	b, _, err := InterpolateElevation(elevationTable(), equator, times.Sunrise)
+	if err != nil {
+		t.Fatalf("InterpolateElevation at sunrise: %v", err)
+	}
+	if b < 30 || b >= 40 {
+		t.Errorf("brightness at sunrise = %d, want just under the 0-degree point's 40", b)
+	}
+}
+
+func TestEvaluate_DispatchesOnMode(t *testing.T) {
+	times, err := sun.Compute(equator, time.Date(2026, time.March, 20, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("sun.Compute: %v", err)
+	}
+	spec := lumenetesv1alpha1.CircadianScheduleSpec{Keyframes: fourKeyframes(), Elevation: elevationTable()}
+
+	if b, _, _, err := Evaluate(spec, times.SolarNoon); err != nil || b != 100 {
+		t.Errorf("Evaluate(default mode) at noon = (%d, %v), want the solar-noon keyframe's 100", b, err)
+	}
+
+	spec.Mode = lumenetesv1alpha1.CircadianModeElevation
+	b, c, on, err := Evaluate(spec, times.SolarMidnight)
+	if err != nil {
+		t.Fatalf("Evaluate(elevation) at midnight: %v", err)
+	}
+	if b != 10 || c != 2000 || on != lumenetesv1alpha1.CircadianOnStateUnchanged {
+		t.Errorf("Evaluate(elevation) at midnight = (%d, %d, %q), want (10, 2000, unchanged)", b, c, on)
+	}
+
+	spec.Mode = "bogus"
+	if _, _, _, err := Evaluate(spec, times.SolarNoon); err == nil {
+		t.Error("expected error for unknown mode")
+	}
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("time.LoadLocation(%q): %v", name, err)
+	}
+	return loc
+}
+
+func clockKeyframe(hhmm string, brightness int32) lumenetesv1alpha1.CircadianKeyframe {
+	return lumenetesv1alpha1.CircadianKeyframe{Anchor: lumenetesv1alpha1.CircadianAnchorClockTime, Time: hhmm, Brightness: brightness, ColorTempK: 2700}
+}
+
+func TestInterpolate_ClockTimeNeedsLocation(t *testing.T) {
+	kfs := []lumenetesv1alpha1.CircadianKeyframe{clockKeyframe("07:00", 100), clockKeyframe("22:30", 20)}
+	if _, _, _, err := Interpolate(kfs, equator, nil, time.Now()); err == nil {
+		t.Fatal("expected error for a clockTime keyframe with no time zone")
+	}
+}
+
+func TestInterpolate_ClockTimeMalformed(t *testing.T) {
+	kfs := []lumenetesv1alpha1.CircadianKeyframe{clockKeyframe("7am", 100), clockKeyframe("22:30", 20)}
+	if _, _, _, err := Interpolate(kfs, equator, time.UTC, time.Now()); err == nil {
+		t.Fatal("expected error for a malformed clockTime")
+	}
+}
+
+func TestInterpolate_ClockTimeInLocalZone(t *testing.T) {
+	// 22:30 in New York is 02:30/03:30 UTC the next day - resolving it on
+	// the UTC calendar day instead of the local one would put it a whole
+	// day off from now.
+	ny := mustLoadLocation(t, "America/New_York")
+	kfs := []lumenetesv1alpha1.CircadianKeyframe{clockKeyframe("07:00", 100), clockKeyframe("22:30", 20)}
+
+	at := time.Date(2026, time.July, 10, 22, 30, 0, 0, ny)
+	b, _, _, err := Interpolate(kfs, equator, ny, at)
+	if err != nil {
+		t.Fatalf("Interpolate: %v", err)
+	}
+	if b != 20 {
+		t.Errorf("brightness at 22:30 local = %d, want the 22:30 keyframe's 20", b)
+	}
+
+	// Halfway through the 8.5h night from 22:30 to 07:00.
+	b, _, _, err = Interpolate(kfs, equator, ny, at.Add(4*time.Hour+15*time.Minute))
+	if err != nil {
+		t.Fatalf("Interpolate: %v", err)
+	}
+	if b != 60 {
+		t.Errorf("brightness mid-night = %d, want 60", b)
+	}
+}
+
+func TestInterpolate_ClockTimeAcrossDSTTransition(t *testing.T) {
+	// Europe/London springs forward at 01:00 UTC on 2026-03-29: 00:30 is
+	// still GMT (00:30Z), 03:30 is already BST (02:30Z), so the span
+	// between them is 2h of real time, not the 3h the wall clock suggests.
+	london := mustLoadLocation(t, "Europe/London")
+	kfs := []lumenetesv1alpha1.CircadianKeyframe{clockKeyframe("00:30", 0), clockKeyframe("03:30", 100)}
+
+	b, _, _, err := Interpolate(kfs, equator, london, time.Date(2026, time.March, 29, 1, 30, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Interpolate: %v", err)
+	}
+	if b != 50 {
+		t.Errorf("brightness an hour into a 2h DST-shortened span = %d, want 50", b)
+	}
+
+	// And on the fall-back night (01:00 UTC on 2026-10-25) the same
+	// wall-clock span is 4h long: 00:30 BST (23:30Z) to 03:30 GMT (03:30Z).
+	b, _, _, err = Interpolate(kfs, equator, london, time.Date(2026, time.October, 25, 1, 30, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Interpolate: %v", err)
+	}
+	if b != 50 {
+		t.Errorf("brightness two hours into a 4h DST-lengthened span = %d, want 50", b)
+	}
+}
+
+func TestInterpolate_MixedAnchorsReorderAcrossSeasons(t *testing.T) {
+	// At Oslo's latitude a 22:30 clock keyframe lands before sunset in
+	// midsummer and hours after it in midwinter - Interpolate must sort by
+	// resolved instant either way rather than assume a fixed order.
+	oslo := mustLoadLocation(t, "Europe/Oslo")
+	coords := sun.Coordinates{Latitude: 59.91, Longitude: 10.75}
+	kfs := []lumenetesv1alpha1.CircadianKeyframe{
+		{Anchor: lumenetesv1alpha1.CircadianAnchorSolarNoon, Brightness: 100, ColorTempK: 6000},
+		{Anchor: lumenetesv1alpha1.CircadianAnchorSunset, Brightness: 60, ColorTempK: 3000},
+		clockKeyframe("22:30", 20),
+		{Anchor: lumenetesv1alpha1.CircadianAnchorSolarMidnight, Brightness: 5, ColorTempK: 2000},
+	}
+
+	june := sun.ComputeClamped(coords, time.Date(2026, time.June, 21, 0, 0, 0, 0, time.UTC))
+	clock := time.Date(2026, time.June, 21, 22, 30, 0, 0, oslo)
+	if !clock.Before(june.Sunset) {
+		t.Fatalf("test setup: 22:30 %s not before sunset %s in June", clock, june.Sunset)
+	}
+	// Between 22:30 (20) and sunset (60).
+	b, _, _, err := Interpolate(kfs, coords, oslo, clock.Add(june.Sunset.Sub(clock)/2))
+	if err != nil {
+		t.Fatalf("Interpolate in June: %v", err)
+	}
+	if b <= 20 || b >= 60 {
+		t.Errorf("June brightness between 22:30 and sunset = %d, want strictly between 20 and 60", b)
+	}
+
+	december := sun.ComputeClamped(coords, time.Date(2026, time.December, 21, 0, 0, 0, 0, time.UTC))
+	// Between sunset (60) and 22:30 (20).
+	b, _, _, err = Interpolate(kfs, coords, oslo, december.Sunset.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Interpolate in December: %v", err)
+	}
+	if b <= 20 || b >= 60 {
+		t.Errorf("December brightness an hour after sunset = %d, want strictly between 20 and 60", b)
+	}
+}
+
+func TestInterpolate_TwilightAnchors(t *testing.T) {
+	times, err := sun.Compute(equator, time.Date(2026, time.March, 20, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("sun.Compute: %v", err)
+	}
+	kfs := []lumenetesv1alpha1.CircadianKeyframe{
+		{Anchor: lumenetesv1alpha1.CircadianAnchorAstronomicalDawn, Brightness: 0, ColorTempK: 2000},
+		{Anchor: lumenetesv1alpha1.CircadianAnchorCivilDawn, Brightness: 30, ColorTempK: 2500},
+		{Anchor: lumenetesv1alpha1.CircadianAnchorCivilDusk, Brightness: 40, ColorTempK: 3000},
+		{Anchor: lumenetesv1alpha1.CircadianAnchorNauticalDusk, Brightness: 10, ColorTempK: 2200},
+	}
+
+	b, c, _, err := Interpolate(kfs, equator, time.UTC, times.CivilDawn)
+	if err != nil {
+		t.Fatalf("Interpolate at civil dawn: %v", err)
+	}
+	if b != 30 || c != 2500 {
+		t.Errorf("Interpolate at civil dawn = (%d, %d), want (30, 2500)", b, c)
+	}
+	b, _, _, err = Interpolate(kfs, equator, time.UTC, times.CivilDusk.Add(times.NauticalDusk.Sub(times.CivilDusk)/2))
+	if err != nil {
+		t.Fatalf("Interpolate between civil and nautical dusk: %v", err)
+	}
+	if b != 25 {
+		t.Errorf("brightness midway between civil (40) and nautical (10) dusk = %d, want 25", b)
+	}
+}
+
+func TestEvaluate_InvalidTimeZone(t *testing.T) {
+	spec := lumenetesv1alpha1.CircadianScheduleSpec{Keyframes: fourKeyframes(), TimeZone: "Mars/Olympus_Mons"}
+	if _, _, _, err := Evaluate(spec, time.Now()); err == nil {
+		t.Fatal("expected error for an unknown time zone")
+	}
+}
+
+func weekendVariant() []lumenetesv1alpha1.CircadianVariant {
+	return []lumenetesv1alpha1.CircadianVariant{{
+		Days:      []lumenetesv1alpha1.CircadianWeekday{lumenetesv1alpha1.CircadianWeekdaySaturday, lumenetesv1alpha1.CircadianWeekdaySunday},
+		Keyframes: []lumenetesv1alpha1.CircadianKeyframe{clockKeyframe("09:00", 100), clockKeyframe("23:00", 20)},
+	}}
+}
+
+func TestInterpolateVariants_PicksVariantByLocalWeekday(t *testing.T) {
+	weekday := []lumenetesv1alpha1.CircadianKeyframe{clockKeyframe("07:00", 100), clockKeyframe("23:00", 20)}
+
+	// Monday 2026-10-19 07:00 is a weekday keyframe.
+	b, _, _, err := InterpolateVariants(weekday, weekendVariant(), equator, time.UTC, time.Date(2026, time.October, 19, 7, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("InterpolateVariants on Monday: %v", err)
+	}
+	if b != 100 {
+		t.Errorf("Monday 07:00 brightness = %d, want 100", b)
+	}
+
+	// Saturday 07:00 is still 8h into the 10h ramp from Friday's 23:00
+	// (20) to the weekend's 09:00 (100).
+	b, _, _, err = InterpolateVariants(weekday, weekendVariant(), equator, time.UTC, time.Date(2026, time.October, 17, 7, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("InterpolateVariants on Saturday: %v", err)
+	}
+	if b != 84 {
+		t.Errorf("Saturday 07:00 brightness = %d, want 84", b)
+	}
+}
+
+func TestInterpolateVariants_InterpolatesAcrossVariantBoundary(t *testing.T) {
+	// Friday's last keyframe (weekday set) and Saturday's first (weekend
+	// variant) bracket the midnight between them - the curve must run
+	// straight through rather than jump when the day changes.
+	weekday := []lumenetesv1alpha1.CircadianKeyframe{clockKeyframe("07:00", 100), clockKeyframe("23:00", 20)}
+	cases := []struct {
+		at   time.Time
+		want int32
+	}{
+		{time.Date(2026, time.October, 16, 23, 30, 0, 0, time.UTC), 24},
+		{time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC), 28},
+		{time.Date(2026, time.October, 17, 4, 0, 0, 0, time.UTC), 60},
+		// And back again: Sunday 23:00 (20) to Monday 07:00 (100).
+		{time.Date(2026, time.October, 19, 3, 0, 0, 0, time.UTC), 60},
+	}
+	for _, tc := range cases {
+		b, _, _, err := InterpolateVariants(weekday, weekendVariant(), equator, time.UTC, tc.at)
+		if err != nil {
+			t.Fatalf("InterpolateVariants at %s: %v", tc.at, err)
+		}
+		if b != tc.want {
+			t.Errorf("brightness at %s = %d, want %d", tc.at.Format(time.RFC1123), b, tc.want)
+		}
+	}
+}
+
+func TestInterpolateVariants_Errors(t *testing.T) {
+	weekday := []lumenetesv1alpha1.CircadianKeyframe{clockKeyframe("07:00", 100), clockKeyframe("23:00", 20)}
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+	if _, _, _, err := InterpolateVariants(weekday, weekendVariant(), equator, nil, now); err == nil {
+		t.Error("expected error for variants with no time zone")
+	}
+
+	overlapping := append(weekendVariant(), lumenetesv1alpha1.CircadianVariant{
+		Days:      []lumenetesv1alpha1.CircadianWeekday{lumenetesv1alpha1.CircadianWeekdaySunday},
+		Keyframes: weekday,
+	})
+	if _, _, _, err := InterpolateVariants(weekday, overlapping, equator, time.UTC, now); err == nil {
+		t.Error("expected error for a day listed by two variants")
+	}
+
+	// No default keyframes, and the variant only covers the weekend.
+	if _, _, _, err := InterpolateVariants(nil, weekendVariant(), equator, time.UTC, now); err == nil {
+		t.Error("expected error for weekdays left with no keyframes")
+	}
+}
+
+func TestEvaluate_UsesVariants(t *testing.T) {
+	spec := lumenetesv1alpha1.CircadianScheduleSpec{
+		Keyframes: []lumenetesv1alpha1.CircadianKeyframe{clockKeyframe("07:00", 100), clockKeyframe("23:00", 20)},
+		Variants:  weekendVariant(),
+		TimeZone:  "UTC",
+	}
+	b, _, _, err := Evaluate(spec, time.Date(2026, time.October, 17, 7, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if b != 84 {
+		t.Errorf("Saturday 07:00 brightness = %d, want the weekend variant's 84", b)
+	}
+}
@@ -11,7 +11,6 @@ import (
 
 	lumenetesv1alpha1 "github.com/liamawhite/lumenetes/api/v1alpha1"
 	"github.com/liamawhite/lumenetes/internal/circadian"
-	"github.com/liamawhite/lumenetes/internal/sun"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -29,7 +28,7 @@ import (
 // responsibility (see internal/groupcontroller.Reconciler, which applies
 // this schedule's current output onto Light.Spec when a Group selects it
 // via Spec.ActiveScene). Validation and interpolation are delegated
-// entirely to internal/circadian.Interpolate's own error rather than
+// entirely to internal/circadian.Evaluate's own error rather than
 // re-checked by hand here, so this controller and groupcontroller can never
 // disagree about whether a given Spec is well-formed.
 type Reconciler struct {
@@ -79,14 +78,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 // evaluate computes schedule's current output, or a validation error if
 // Spec is malformed. Group emptiness is checked here (a CircadianSchedule-
-// only concern - Interpolate has no notion of Group); everything about
-// Keyframes' well-formedness is Interpolate's own error.
+// only concern - Evaluate has no notion of Group); everything about
+// Keyframes'/Elevation's well-formedness is Evaluate's own error.
 func (r *Reconciler) evaluate(schedule *lumenetesv1alpha1.CircadianSchedule) (brightness, colorTempK *int32, validationErr string) {
 	if schedule.Spec.Group == "" {
 		return nil, nil, "spec.group is required"
 	}
-	coords := sun.Coordinates{Latitude: schedule.Spec.Latitude, Longitude: schedule.Spec.Longitude}
-	b, c, _, err := circadian.Interpolate(schedule.Spec.Keyframes, coords, r.now())
+	b, c, _, err := circadian.Evaluate(schedule.Spec, r.now())
 	if err != nil {
 		return nil, nil, err.Error()
 	}
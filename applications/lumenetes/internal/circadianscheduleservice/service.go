@@ -44,14 +44,24 @@ func (s *Service) ListCircadianSchedules(ctx context.Context, req *connect.Reque
 }
 
 func toProto(schedule lumenetesv1alpha1.CircadianSchedule) *v1.CircadianSchedule {
-	keyframes := make([]*v1.CircadianKeyframe, 0, len(schedule.Spec.Keyframes))
-	for _, kf := range schedule.Spec.Keyframes {
-		keyframes = append(keyframes, &v1.CircadianKeyframe{
-			Anchor:        toProtoAnchor(kf.Anchor),
-			OffsetMinutes: kf.OffsetMinutes,
-			Brightness:    kf.Brightness,
-			ColorTempK:    kf.ColorTempK,
-			On:            toProtoOnState(kf.On),
+	variants := make([]*v1.CircadianVariant, 0, len(schedule.Spec.Variants))
+	for _, variant := range schedule.Spec.Variants {
+		days := make([]v1.CircadianWeekday, 0, len(variant.Days))
+		for _, day := range variant.Days {
+			days = append(days, toProtoWeekday(day))
+		}
+		variants = append(variants, &v1.CircadianVariant{
+			Days:      days,
+			Keyframes: toProtoKeyframes(variant.Keyframes),
+		})
+	}
+
+	elevation := make([]*v1.CircadianElevationPoint, 0, len(schedule.Spec.Elevation))
+	for _, point := range schedule.Spec.Elevation {
+		elevation = append(elevation, &v1.CircadianElevationPoint{
+			Degrees:    point.Degrees,
+			Brightness: point.Brightness,
+			ColorTempK: point.ColorTempK,
 		})
 	}
 
@@ -60,7 +70,11 @@ func toProto(schedule lumenetesv1alpha1.CircadianSchedule) *v1.CircadianSchedule
 		Group:             schedule.Spec.Group,
 		Latitude:          schedule.Spec.Latitude,
 		Longitude:         schedule.Spec.Longitude,
-		Keyframes:         keyframes,
+		TimeZone:          schedule.Spec.TimeZone,
+		Mode:              toProtoMode(schedule.Spec.Mode),
+		Keyframes:         toProtoKeyframes(schedule.Spec.Keyframes),
+		Variants:          variants,
+		Elevation:         elevation,
 		CurrentBrightness: schedule.Status.CurrentBrightness,
 		CurrentColorTempK: schedule.Status.CurrentColorTempK,
 		ValidationError:   schedule.Status.ValidationError,
@@ -68,6 +82,53 @@ func toProto(schedule lumenetesv1alpha1.CircadianSchedule) *v1.CircadianSchedule
 	}
 }
 
+func toProtoKeyframes(keyframes []lumenetesv1alpha1.CircadianKeyframe) []*v1.CircadianKeyframe {
+	out := make([]*v1.CircadianKeyframe, 0, len(keyframes))
+	for _, kf := range keyframes {
+		out = append(out, &v1.CircadianKeyframe{
+			Anchor:        toProtoAnchor(kf.Anchor),
+			OffsetMinutes: kf.OffsetMinutes,
+			Brightness:    kf.Brightness,
+			ColorTempK:    kf.ColorTempK,
+			On:            toProtoOnState(kf.On),
+			Time:          kf.Time,
+		})
+	}
+	return out
+}
+
+func toProtoWeekday(day lumenetesv1alpha1.CircadianWeekday) v1.CircadianWeekday {
+	switch day {
+	case lumenetesv1alpha1.CircadianWeekdayMonday:
+		return v1.CircadianWeekday_CIRCADIAN_WEEKDAY_MONDAY
+	case lumenetesv1alpha1.CircadianWeekdayTuesday:
+		return v1.CircadianWeekday_CIRCADIAN_WEEKDAY_TUESDAY
+	case lumenetesv1alpha1.CircadianWeekdayWednesday:
+		return v1.CircadianWeekday_CIRCADIAN_WEEKDAY_WEDNESDAY
+	case lumenetesv1alpha1.CircadianWeekdayThursday:
+		return v1.CircadianWeekday_CIRCADIAN_WEEKDAY_THURSDAY
+	case lumenetesv1alpha1.CircadianWeekdayFriday:
+		return v1.CircadianWeekday_CIRCADIAN_WEEKDAY_FRIDAY
+	case lumenetesv1alpha1.CircadianWeekdaySaturday:
+		return v1.CircadianWeekday_CIRCADIAN_WEEKDAY_SATURDAY
+	case lumenetesv1alpha1.CircadianWeekdaySunday:
+		return v1.CircadianWeekday_CIRCADIAN_WEEKDAY_SUNDAY
+	default:
+		return v1.CircadianWeekday_CIRCADIAN_WEEKDAY_UNSPECIFIED
+	}
+}
+
+func toProtoMode(mode lumenetesv1alpha1.CircadianMode) v1.CircadianMode {
+	switch mode {
+	case "", lumenetesv1alpha1.CircadianModeKeyframes:
+		return v1.CircadianMode_CIRCADIAN_MODE_KEYFRAMES
+	case lumenetesv1alpha1.CircadianModeElevation:
+		return v1.CircadianMode_CIRCADIAN_MODE_ELEVATION
+	default:
+		return v1.CircadianMode_CIRCADIAN_MODE_UNSPECIFIED
+	}
+}
+
 func toProtoAnchor(anchor lumenetesv1alpha1.CircadianAnchor) v1.CircadianAnchor {
 	switch anchor {
 	case lumenetesv1alpha1.CircadianAnchorSunrise:
@@ -78,6 +139,20 @@ func toProtoAnchor(anchor lumenetesv1alpha1.CircadianAnchor) v1.CircadianAnchor
 		return v1.CircadianAnchor_CIRCADIAN_ANCHOR_SUNSET
 	case lumenetesv1alpha1.CircadianAnchorSolarMidnight:
 		return v1.CircadianAnchor_CIRCADIAN_ANCHOR_SOLAR_MIDNIGHT
+	case lumenetesv1alpha1.CircadianAnchorCivilDawn:
+		return v1.CircadianAnchor_CIRCADIAN_ANCHOR_CIVIL_DAWN
+	case lumenetesv1alpha1.CircadianAnchorCivilDusk:
+		return v1.CircadianAnchor_CIRCADIAN_ANCHOR_CIVIL_DUSK
+	case lumenetesv1alpha1.CircadianAnchorNauticalDawn:
+		return v1.CircadianAnchor_CIRCADIAN_ANCHOR_NAUTICAL_DAWN
+	case lumenetesv1alpha1.CircadianAnchorNauticalDusk:
+		return v1.CircadianAnchor_CIRCADIAN_ANCHOR_NAUTICAL_DUSK
+	case lumenetesv1alpha1.CircadianAnchorAstronomicalDawn:
+		return v1.CircadianAnchor_CIRCADIAN_ANCHOR_ASTRONOMICAL_DAWN
+	case lumenetesv1alpha1.CircadianAnchorAstronomicalDusk:
+		return v1.CircadianAnchor_CIRCADIAN_ANCHOR_ASTRONOMICAL_DUSK
+	case lumenetesv1alpha1.CircadianAnchorClockTime:
+		return v1.CircadianAnchor_CIRCADIAN_ANCHOR_CLOCK_TIME
 	default:
 		return v1.CircadianAnchor_CIRCADIAN_ANCHOR_UNSPECIFIED
 	}
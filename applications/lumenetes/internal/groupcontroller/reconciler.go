@@ -1,6 +1,7 @@
 // Package groupcontroller implements the Reconciler that keeps a Group's
-// Status.MissingLights/LightCount in sync with Spec.Lights, and enacts
-// Spec.ActiveScene onto the group's target Lights' Spec.
+// Status.MissingLights/LightCount/OnHistory in sync with Spec.Lights, and
+// enacts Spec.ActiveScene (or, while enabled, Spec.Away) onto the group's
+// target Lights' Spec.
 package groupcontroller
 
 import (
@@ -11,8 +12,8 @@ import (
 
 	"github.com/go-logr/logr"
 	lumenetesv1alpha1 "github.com/liamawhite/lumenetes/api/v1alpha1"
+	"github.com/liamawhite/lumenetes/internal/away"
 	"github.com/liamawhite/lumenetes/internal/circadian"
-	"github.com/liamawhite/lumenetes/internal/sun"
 	"golang.org/x/sync/errgroup"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -66,6 +67,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	found := make([]bool, len(group.Spec.Lights))
+	reachable := make([]bool, len(group.Spec.Lights))
+	lit := make([]bool, len(group.Spec.Lights))
 	var existsGroup errgroup.Group
 	for i, name := range group.Spec.Lights {
 		existsGroup.Go(func() error {
@@ -77,6 +80,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 				return err
 			}
 			found[i] = true
+			reachable[i] = light.Status.Reachable
+			lit[i] = light.Status.On
 			return nil
 		})
 	}
@@ -93,18 +98,55 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	missing := missingLights(group.Spec.Lights, existing)
 	count := int32(len(group.Spec.Lights))
 
-	sceneErr, enactErr := r.enactActiveScene(ctx, logger, &group)
-	if enactErr != nil {
-		logger.Error(enactErr, "failed to enact active scene", "group", group.Name, "activeScene", fmt.Sprintf("%+v", group.Spec.ActiveScene))
+	awayEnabled := group.Spec.Away != nil && group.Spec.Away.Enabled
+	on, known := groupOn(reachable, lit)
+	// While PreAwayOn is still set the lights are in the replay's state,
+	// not one worth recording - including on the reconcile Away ends.
+	history, historyChanged := group.Status.OnHistory, false
+	if known && !awayEnabled && group.Status.PreAwayOn == nil {
+		history, historyChanged = away.Record(history, on, r.now())
 	}
 
-	if slices.Equal(group.Status.MissingLights, missing) && group.Status.LightCount == count && group.Status.ActiveSceneError == sceneErr {
+	var sceneErr string
+	var enactErr error
+	preAwayOn, preAwayChanged := group.Status.PreAwayOn, false
+	if awayEnabled {
+		if preAwayOn == nil {
+			state := preAwayState(on, known, history)
+			preAwayOn, preAwayChanged = &state, true
+		}
+		sceneErr, enactErr = r.enactAway(ctx, logger, &group)
+		if enactErr != nil {
+			logger.Error(enactErr, "failed to enact away mode", "group", group.Name)
+		}
+	} else {
+		// Restored before ActiveScene is enacted, so anything ActiveScene
+		// sets itself still wins. Kept on failure, to retry next time.
+		var restoreErr error
+		if preAwayOn != nil {
+			if restoreErr = r.setGroupOn(ctx, logger, &group, *preAwayOn); restoreErr != nil {
+				logger.Error(restoreErr, "failed to restore pre-away state", "group", group.Name)
+			} else {
+				preAwayOn, preAwayChanged = nil, true
+			}
+		}
+		sceneErr, enactErr = r.enactActiveScene(ctx, logger, &group)
+		if enactErr != nil {
+			logger.Error(enactErr, "failed to enact active scene", "group", group.Name, "activeScene", fmt.Sprintf("%+v", group.Spec.ActiveScene))
+		} else {
+			enactErr = restoreErr
+		}
+	}
+
+	if slices.Equal(group.Status.MissingLights, missing) && group.Status.LightCount == count && group.Status.ActiveSceneError == sceneErr && !historyChanged && !preAwayChanged {
 		return ctrl.Result{}, enactErr
 	}
 
 	group.Status.MissingLights = missing
 	group.Status.LightCount = count
 	group.Status.ActiveSceneError = sceneErr
+	group.Status.OnHistory = history
+	group.Status.PreAwayOn = preAwayOn
 	group.Status.LastSynced = metav1.Now()
 	if err := r.Client.Status().Update(ctx, &group); err != nil {
 		logger.Error(err, "failed to update group status", "group", group.Name)
@@ -126,6 +168,52 @@ func missingLights(specLights []string, existing map[string]bool) []string {
 	return missing
 }
 
+// groupOn reports whether any reachable light is on - the single on/off
+// state Status.OnHistory records for the whole group. known is false if no
+// light is reachable, since an unreachable light's Status is only its
+// last-known state (see LightStatus.Reachable) and recording that would
+// invent a transition that never happened.
+func groupOn(reachable, lit []bool) (on, known bool) {
+	for i := range reachable {
+		if !reachable[i] {
+			continue
+		}
+		known = true
+		if lit[i] {
+			return true, true
+		}
+	}
+	return false, known
+}
+
+// preAwayState is the group's on/off state to restore once Away ends:
+// what's observed now if any light is reachable, otherwise the last
+// recorded transition, otherwise off.
+func preAwayState(on, known bool, history []lumenetesv1alpha1.OnTransition) bool {
+	if known {
+		return on
+	}
+	if len(history) > 0 {
+		return history[len(history)-1].On
+	}
+	return false
+}
+
+// enactAway replays group.Status.OnHistory onto every light in
+// group.Spec.Lights via away.Replay, in place of Spec.ActiveScene. It
+// returns the same (status error, requeue error) pair as
+// enactActiveScene - the former records why there's nothing to replay yet,
+// reusing Status.ActiveSceneError since Away stands in for ActiveScene
+// while enabled.
+func (r *Reconciler) enactAway(ctx context.Context, logger logr.Logger, group *lumenetesv1alpha1.Group) (string, error) {
+	jitter := time.Duration(group.Spec.Away.JitterMinutes) * time.Minute
+	on, err := away.Replay(group.Status.OnHistory, group.Name, r.now(), jitter)
+	if err != nil {
+		return fmt.Sprintf("away: %v", err), nil
+	}
+	return "", r.setGroupOn(ctx, logger, group, on)
+}
+
 // enactActiveScene enforces group.Spec.ActiveScene onto its target Lights'
 // Spec. It returns the string to record in Status.ActiveSceneError (empty
 // unless ActiveScene names a referent that's missing, targets a different
@@ -153,21 +241,26 @@ func (r *Reconciler) enactActiveScene(ctx context.Context, logger logr.Logger, g
 	}
 }
 
-// enactOff turns off (Spec.On = false - brightness/color/colorTempK are
-// left as they are) and clears Spec.Reactive on every light in
+// enactOff turns off every light in group.Spec.Lights - see setGroupOn.
+func (r *Reconciler) enactOff(ctx context.Context, logger logr.Logger, group *lumenetesv1alpha1.Group) error {
+	return r.setGroupOn(ctx, logger, group, false)
+}
+
+// setGroupOn sets Spec.On = on (brightness/color/colorTempK are left as
+// they are) and clears Spec.Reactive on every light in
 // group.Spec.Lights, in parallel - a group's lights are independent
 // Kubernetes objects with no ordering requirement between them, and the
 // shared controller-runtime client is safe for concurrent use (it already
 // serves MaxConcurrentReconciles>1 elsewhere in this binary). Clearing
-// Reactive here matters even though Off itself has nothing to do with
+// Reactive here matters even though Off/Away have nothing to do with
 // reactivity: a light previously owned by a Reactive-mode Group must not
 // stay invisible to internal/lightscontroller.Reconciler once this Group
-// takes over with Off - see LightSpec.Reactive's doc comment for why every
-// non-Reactive enactment path is responsible for clearing it. One light's
-// failure doesn't stop the rest: every goroutine runs to completion
-// regardless of its siblings, and the first error is returned so Reconcile
-// still requeues.
-func (r *Reconciler) enactOff(ctx context.Context, logger logr.Logger, group *lumenetesv1alpha1.Group) error {
+// takes over with Off or Away - see LightSpec.Reactive's doc comment for
+// why every non-Reactive enactment path is responsible for clearing it.
+// One light's failure doesn't stop the rest: every goroutine runs to
+// completion regardless of its siblings, and the first error is returned
+// so Reconcile still requeues.
+func (r *Reconciler) setGroupOn(ctx context.Context, logger logr.Logger, group *lumenetesv1alpha1.Group, on bool) error {
 	var g errgroup.Group
 	for _, name := range group.Spec.Lights {
 		g.Go(func() error {
@@ -176,16 +269,16 @@ func (r *Reconciler) enactOff(ctx context.Context, logger logr.Logger, group *lu
 				if apierrors.IsNotFound(err) {
 					return nil
 				}
-				logger.Error(err, "failed to get light to turn off", "group", group.Name, "light", name)
+				logger.Error(err, "failed to get light to switch", "group", group.Name, "light", name, "on", on)
 				return err
 			}
-			if !light.Spec.On && !light.Spec.Reactive {
+			if light.Spec.On == on && !light.Spec.Reactive {
 				return nil
 			}
-			light.Spec.On = false
+			light.Spec.On = on
 			light.Spec.Reactive = false
 			if err := r.Client.Update(ctx, &light); err != nil {
-				logger.Error(err, "failed to turn off light", "group", group.Name, "light", name)
+				logger.Error(err, "failed to switch light", "group", group.Name, "light", name, "on", on)
 				return err
 			}
 			return nil
@@ -257,7 +350,7 @@ func (r *Reconciler) applyReactiveLightState(ctx context.Context, name string) e
 // enactScene resolves name as a Scene, validates it targets this group,
 // and applies each of its Lights entries that's a member of
 // group.Spec.Lights onto the target Light's Spec, in parallel (see
-// enactOff's doc comment for why concurrent per-light writes are safe
+// setGroupOn's doc comment for why concurrent per-light writes are safe
 // here). Membership is re-derived directly against group.Spec.Lights
 // rather than trusting Scene.Status.InvalidLights, which can be stale
 // (Scene's own reconciler may not have caught up yet).
@@ -327,8 +420,7 @@ func (r *Reconciler) enactCircadianSchedule(ctx context.Context, logger logr.Log
 		return fmt.Sprintf("circadian schedule %q targets group %q, not %q", schedule.Name, schedule.Spec.Group, group.Name), nil
 	}
 
-	coords := sun.Coordinates{Latitude: schedule.Spec.Latitude, Longitude: schedule.Spec.Longitude}
-	brightness, colorTempK, onState, err := circadian.Interpolate(schedule.Spec.Keyframes, coords, r.now())
+	brightness, colorTempK, onState, err := circadian.Evaluate(schedule.Spec, r.now())
 	if err != nil {
 		return fmt.Sprintf("circadian schedule %q: %v", schedule.Name, err), nil
 	}
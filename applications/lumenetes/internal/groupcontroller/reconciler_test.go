@@ -502,7 +502,7 @@ func TestReconcile_ActiveCircadianScheduleValid(t *testing.T) {
 		t.Fatalf("sun.Compute: %v", err)
 	}
 	now := sunTimes.SolarNoon
-	wantBrightness, wantColorTempK, _, err := circadian.Interpolate(fourKeyframes(), equator, now)
+	wantBrightness, wantColorTempK, _, err := circadian.Interpolate(fourKeyframes(), equator, time.UTC, now)
 	if err != nil {
 		t.Fatalf("circadian.Interpolate: %v", err)
 	}
@@ -919,3 +919,193 @@ func TestReconcile_TransitionOutOfReactive_ClearsReactiveFlag(t *testing.T) {
 		t.Error("light a Spec.Reactive = true, want false - stuck reactive flag would hide this light from lightscontroller forever")
 	}
 }
+
+func TestReconcile_RecordsOnHistoryFromReachableLights(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 18, 0, 0, 0, time.UTC)
+	group := &lumenetesv1alpha1.Group{
+		ObjectMeta: metav1.ObjectMeta{Name: "living-room"},
+		Spec:       lumenetesv1alpha1.GroupSpec{Lights: []string{"a", "b"}},
+	}
+	lightA := &lumenetesv1alpha1.Light{ObjectMeta: metav1.ObjectMeta{Name: "a"}, Status: lumenetesv1alpha1.LightStatus{Reachable: true, On: false}}
+	// Unreachable, so its stale On must not count.
+	lightB := &lumenetesv1alpha1.Light{ObjectMeta: metav1.ObjectMeta{Name: "b"}, Status: lumenetesv1alpha1.LightStatus{Reachable: false, On: true}}
+	c := newFakeClient(t, group, lightA, lightB)
+	r := &Reconciler{Client: c, Now: func() time.Time { return now }}
+
+	if _, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKey{Name: "living-room"}}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	var got lumenetesv1alpha1.Group
+	if err := c.Get(t.Context(), client.ObjectKey{Name: "living-room"}, &got); err != nil {
+		t.Fatalf("get group: %v", err)
+	}
+	if len(got.Status.OnHistory) != 1 || got.Status.OnHistory[0].On {
+		t.Errorf("Status.OnHistory = %v, want a single off transition", got.Status.OnHistory)
+	}
+}
+
+// eveningHistory is a week of on at 18:00, off at 23:00 UTC, ending the
+// day before today.
+func eveningHistory(today time.Time) []lumenetesv1alpha1.OnTransition {
+	history := []lumenetesv1alpha1.OnTransition{{At: metav1.NewTime(today.AddDate(0, 0, -8)), On: false}}
+	for k := 7; k >= 1; k-- {
+		d := today.AddDate(0, 0, -k)
+		history = append(history,
+			lumenetesv1alpha1.OnTransition{At: metav1.NewTime(d.Add(18 * time.Hour)), On: true},
+			lumenetesv1alpha1.OnTransition{At: metav1.NewTime(d.Add(23 * time.Hour)), On: false},
+		)
+	}
+	return history
+}
+
+func TestReconcile_AwayReplaysHistoryInsteadOfActiveScene(t *testing.T) {
+	today := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	history := eveningHistory(today)
+	group := &lumenetesv1alpha1.Group{
+		ObjectMeta: metav1.ObjectMeta{Name: "living-room"},
+		Spec: lumenetesv1alpha1.GroupSpec{
+			Lights:      []string{"a"},
+			ActiveScene: offRef(),
+			Away:        &lumenetesv1alpha1.AwaySpec{Enabled: true, JitterMinutes: 20},
+		},
+		Status: lumenetesv1alpha1.GroupStatus{OnHistory: history},
+	}
+	// Reachable and off: recording this would add a transition if Away
+	// didn't pause recording.
+	lightA := &lumenetesv1alpha1.Light{
+		ObjectMeta: metav1.ObjectMeta{Name: "a"},
+		Spec:       lumenetesv1alpha1.LightSpec{On: false, Reactive: true},
+		Status:     lumenetesv1alpha1.LightStatus{Reachable: true, On: false},
+	}
+	c := newFakeClient(t, group, lightA)
+	now := today.Add(21 * time.Hour)
+	r := &Reconciler{Client: c, Now: func() time.Time { return now }}
+
+	if _, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKey{Name: "living-room"}}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	got := getLight(t, c, "a")
+	if !got.Spec.On {
+		t.Error("light a Spec.On = false at 21:00, want true - Away should override the Off ActiveScene")
+	}
+	if got.Spec.Reactive {
+		t.Error("light a Spec.Reactive = true, want cleared by Away")
+	}
+	var gotGroup lumenetesv1alpha1.Group
+	if err := c.Get(t.Context(), client.ObjectKey{Name: "living-room"}, &gotGroup); err != nil {
+		t.Fatalf("get group: %v", err)
+	}
+	if len(gotGroup.Status.OnHistory) != len(history) {
+		t.Errorf("Status.OnHistory grew to %d entries while Away was enabled, want %d", len(gotGroup.Status.OnHistory), len(history))
+	}
+
+	// Disabling Away hands straight back to the Off ActiveScene.
+	gotGroup.Spec.Away.Enabled = false
+	if err := c.Update(t.Context(), &gotGroup); err != nil {
+		t.Fatalf("disable away: %v", err)
+	}
+	if _, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKey{Name: "living-room"}}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if got := getLight(t, c, "a"); got.Spec.On {
+		t.Error("light a Spec.On = true after disabling Away, want the Off ActiveScene re-enacted")
+	}
+}
+
+func TestReconcile_AwayWithoutHistory(t *testing.T) {
+	group := &lumenetesv1alpha1.Group{
+		ObjectMeta: metav1.ObjectMeta{Name: "living-room"},
+		Spec: lumenetesv1alpha1.GroupSpec{
+			Lights: []string{"a"},
+			Away:   &lumenetesv1alpha1.AwaySpec{Enabled: true},
+		},
+	}
+	lightA := &lumenetesv1alpha1.Light{ObjectMeta: metav1.ObjectMeta{Name: "a"}, Spec: lumenetesv1alpha1.LightSpec{On: true}}
+	c := newFakeClient(t, group, lightA)
+	r := &Reconciler{Client: c}
+
+	if _, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKey{Name: "living-room"}}); err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+
+	var got lumenetesv1alpha1.Group
+	if err := c.Get(t.Context(), client.ObjectKey{Name: "living-room"}, &got); err != nil {
+		t.Fatalf("get group: %v", err)
+	}
+	if got.Status.ActiveSceneError == "" {
+		t.Error("Status.ActiveSceneError empty, want it to explain there's no history to replay")
+	}
+	if !getLight(t, c, "a").Spec.On {
+		t.Error("light a Spec.On changed with nothing to replay")
+	}
+}
+
+// TestReconcile_AwayEndRestoresPreAwayState covers a Group with nothing
+// to hand back to that sets On (here no ActiveScene at all): ending Away
+// puts its lights back how they were before it, rather than wherever the
+// last replay left them.
+func TestReconcile_AwayEndRestoresPreAwayState(t *testing.T) {
+	today := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	history := eveningHistory(today)
+	group := &lumenetesv1alpha1.Group{
+		ObjectMeta: metav1.ObjectMeta{Name: "living-room"},
+		Spec: lumenetesv1alpha1.GroupSpec{
+			Lights: []string{"a"},
+			Away:   &lumenetesv1alpha1.AwaySpec{Enabled: true, JitterMinutes: 20},
+		},
+		Status: lumenetesv1alpha1.GroupStatus{OnHistory: history},
+	}
+	lightA := &lumenetesv1alpha1.Light{
+		ObjectMeta: metav1.ObjectMeta{Name: "a"},
+		Spec:       lumenetesv1alpha1.LightSpec{On: true},
+		Status:     lumenetesv1alpha1.LightStatus{Reachable: true, On: true},
+	}
+	c := newFakeClient(t, group, lightA)
+	// Mid-morning, when every recorded day was off.
+	now := today.Add(10 * time.Hour)
+	r := &Reconciler{Client: c, Now: func() time.Time { return now }}
+	req := ctrl.Request{NamespacedName: client.ObjectKey{Name: "living-room"}}
+
+	if _, err := r.Reconcile(t.Context(), req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if getLight(t, c, "a").Spec.On {
+		t.Fatal("light a Spec.On = true at 10:00, want the replay to switch it off")
+	}
+	var gotGroup lumenetesv1alpha1.Group
+	if err := c.Get(t.Context(), client.ObjectKey{Name: "living-room"}, &gotGroup); err != nil {
+		t.Fatalf("get group: %v", err)
+	}
+	if gotGroup.Status.PreAwayOn == nil || !*gotGroup.Status.PreAwayOn {
+		t.Fatalf("Status.PreAwayOn = %v, want true", gotGroup.Status.PreAwayOn)
+	}
+
+	// The replay's state as the poller would next observe it.
+	light := getLight(t, c, "a")
+	light.Status.On = false
+	if err := c.Status().Update(t.Context(), &light); err != nil {
+		t.Fatalf("update light status: %v", err)
+	}
+
+	gotGroup.Spec.Away.Enabled = false
+	if err := c.Update(t.Context(), &gotGroup); err != nil {
+		t.Fatalf("disable away: %v", err)
+	}
+	if _, err := r.Reconcile(t.Context(), req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if !getLight(t, c, "a").Spec.On {
+		t.Error("light a Spec.On = false after disabling Away, want its pre-away state (on) restored")
+	}
+	if err := c.Get(t.Context(), client.ObjectKey{Name: "living-room"}, &gotGroup); err != nil {
+		t.Fatalf("get group: %v", err)
+	}
+	if gotGroup.Status.PreAwayOn != nil {
+		t.Errorf("Status.PreAwayOn = %v after Away ended, want nil", *gotGroup.Status.PreAwayOn)
+	}
+	if len(gotGroup.Status.OnHistory) != len(history) {
+		t.Errorf("Status.OnHistory has %d entries, want %d - the replay's off state shouldn't be recorded", len(gotGroup.Status.OnHistory), len(history))
+	}
+}
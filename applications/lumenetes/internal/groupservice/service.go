@@ -1,12 +1,16 @@
 // Package groupservice implements the lumenetes.v1.GroupService Connect
-// handler by listing Group CRs directly from the Kubernetes API -
-// read-only, no local storage of any kind.
+// handler by listing Group CRs directly from the Kubernetes API - no
+// local storage of any kind. Read-only apart from SetAway, which only
+// ever touches Spec.Away.
 package groupservice
 
 import (
 	"context"
+	"errors"
 
 	"connectrpc.com/connect"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lumenetesv1alpha1 "github.com/liamawhite/lumenetes/api/v1alpha1"
@@ -39,6 +43,36 @@ func (s *Service) ListGroups(ctx context.Context, req *connect.Request[v1.ListGr
 	return connect.NewResponse(resp), nil
 }
 
+// SetAway enables or disables req's Group's presence simulation (see
+// GroupSpec.Away), leaving the rest of its Spec - including an existing
+// Away.JitterMinutes - as it is. Retried on conflict, since the Group may
+// be edited concurrently by kubectl or Pulumi.
+func (s *Service) SetAway(ctx context.Context, req *connect.Request[v1.SetAwayRequest]) (*connect.Response[v1.SetAwayResponse], error) {
+	if req.Msg.GroupId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("group_id is required"))
+	}
+
+	var group lumenetesv1alpha1.Group
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := s.client.Get(ctx, client.ObjectKey{Name: req.Msg.GroupId}, &group); err != nil {
+			return err
+		}
+		if group.Spec.Away == nil {
+			group.Spec.Away = &lumenetesv1alpha1.AwaySpec{}
+		}
+		group.Spec.Away.Enabled = req.Msg.Enabled
+		return s.client.Update(ctx, &group)
+	})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&v1.SetAwayResponse{Group: toProto(group)}), nil
+}
+
 func toProto(group lumenetesv1alpha1.Group) *v1.Group {
 	return &v1.Group{
 		Id:               group.Name,
@@ -48,6 +82,17 @@ func toProto(group lumenetesv1alpha1.Group) *v1.Group {
 		LightCount:       group.Status.LightCount,
 		ActiveSceneError: group.Status.ActiveSceneError,
 		LastSynced:       protoutil.Time(group.Status.LastSynced),
+		Away:             toProtoAway(group.Spec.Away),
+	}
+}
+
+func toProtoAway(spec *lumenetesv1alpha1.AwaySpec) *v1.AwayMode {
+	if spec == nil {
+		return nil
+	}
+	return &v1.AwayMode{
+		Enabled:       spec.Enabled,
+		JitterMinutes: spec.JitterMinutes,
 	}
 }
 
@@ -169,6 +169,9 @@ func (p *Poller) upsert(ctx context.Context, logger logr.Logger, l lighthue.Ligh
 		Model:       l.Model,
 		Reachable:   true,
 		LastSynced:  metav1.Now(),
+		// Reconciler's, not the bridge's - carried over so every poll
+		// doesn't wipe it and force a rewrite on the next Reconcile.
+		PendingChanges: light.Status.PendingChanges,
 	}
 	if err := p.Client.Status().Update(ctx, light); err != nil {
 		logger.Error(err, "failed to update light status", "light", l.ID)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	lighthue "github.com/liamawhite/lumenetes/internal/hue"
 	lumenetesv1alpha1 "github.com/liamawhite/lumenetes/api/v1alpha1"
@@ -34,7 +35,7 @@ import (
 // (name/on/brightness/color/colorTempK/deviceId/etc.) - by design, from
 // two equally-authoritative sources (see EventConsumer's mergeLightStatus
 // doc comment) - Reconciler only ever writes its own bookkeeping fields
-// (LastEnactAttempt/EnactError). Once enactment succeeds, the eventstream
+// (LastEnactAttempt/EnactError/PendingChanges). Once enactment succeeds, the eventstream
 // pushes the bulb's new state to Status on its own within about a second;
 // there's nothing left for Reconciler to actively wait for or trigger.
 //
@@ -57,8 +58,8 @@ import (
 type Reconciler struct {
 	Client  client.Client
 	Bridges []bridges.Config
-	// DryRun, true by default, means Reconcile only ever logs drift instead
-	// of enacting it.
+	// DryRun, true by default, means Reconcile only ever records drift (in
+	// Status.PendingChanges, plus a log line) instead of enacting it.
 	DryRun bool
 }
 
@@ -84,14 +85,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// A Reactive-mode Group owns this light's Spec now (mirroring Status
 	// onto it) - never enact against the bridge for it, regardless of
 	// what diffLight would find (see LightSpec.Reactive's doc comment).
+	// Drift recorded before the Group went Reactive no longer means
+	// anything, so it's cleared rather than left to show in ListDrift.
 	if light.Spec.Reactive {
+		if len(light.Status.PendingChanges) != 0 {
+			light.Status.PendingChanges = nil
+			if err := r.Client.Status().Update(ctx, &light); err != nil {
+				logger.Error(err, "failed to clear pending changes", "light", light.Name)
+			}
+		}
 		return ctrl.Result{}, nil
 	}
 
 	diffs := diffLight(light.Spec, light.Status)
+	pending := pendingChanges(diffs)
 	if len(diffs) == 0 {
-		if light.Status.EnactError != "" {
+		if light.Status.EnactError != "" || len(light.Status.PendingChanges) != 0 {
 			light.Status.EnactError = ""
+			light.Status.PendingChanges = nil
 			if err := r.Client.Status().Update(ctx, &light); err != nil {
 				logger.Error(err, "failed to clear enact error", "light", light.Name)
 			}
@@ -102,11 +113,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	if r.DryRun {
 		logger.Info("light spec differs from observed state",
 			"light", light.Name, "dryRun", r.DryRun, "diffs", diffs)
+		// Only write when the set of pending changes actually moved -
+		// this write itself re-triggers Reconcile, which would otherwise
+		// loop forever on an unchanged diff.
+		if !slices.Equal(light.Status.PendingChanges, pending) {
+			light.Status.PendingChanges = pending
+			if err := r.Client.Status().Update(ctx, &light); err != nil {
+				logger.Error(err, "failed to record pending changes", "light", light.Name)
+			}
+		}
 		return ctrl.Result{}, nil
 	}
 
 	enactErr := r.enact(ctx, &light, diffs)
 
+	light.Status.PendingChanges = pending
 	light.Status.LastEnactAttempt = metav1.Now()
 	if enactErr != nil {
 		light.Status.EnactError = enactErr.Error()
@@ -213,6 +234,23 @@ type fieldDiff struct {
 	Have  any    `json:"have"`
 }
 
+// pendingChanges renders diffs as the string-valued PendingChange entries
+// LightStatus carries, in the same order.
+func pendingChanges(diffs []fieldDiff) []lumenetesv1alpha1.PendingChange {
+	if len(diffs) == 0 {
+		return nil
+	}
+	out := make([]lumenetesv1alpha1.PendingChange, 0, len(diffs))
+	for _, d := range diffs {
+		out = append(out, lumenetesv1alpha1.PendingChange{
+			Field: d.Field,
+			Want:  fmt.Sprint(d.Want),
+			Have:  fmt.Sprint(d.Have),
+		})
+	}
+	return out
+}
+
 // diffLight returns every field where spec and status disagree. Plain,
 // dependency-free function so it's unit-testable without envtest. Every
 // LightSpec field is always fully seeded from live state at creation (see
@@ -144,6 +144,21 @@ func TestHasField(t *testing.T) {
 	}
 }
 
+func TestPendingChanges(t *testing.T) {
+	diffs := []fieldDiff{{"on", true, false}, {"brightness", int32(80), int32(-1)}, {"color", "#ff0000", ""}}
+	want := []lumenetesv1alpha1.PendingChange{
+		{Field: "on", Want: "true", Have: "false"},
+		{Field: "brightness", Want: "80", Have: "-1"},
+		{Field: "color", Want: "#ff0000", Have: ""},
+	}
+	if got := pendingChanges(diffs); !slices.Equal(got, want) {
+		t.Errorf("pendingChanges() = %+v, want %+v", got, want)
+	}
+	if got := pendingChanges(nil); got != nil {
+		t.Errorf("pendingChanges(nil) = %+v, want nil", got)
+	}
+}
+
 func TestBridgesFindByID(t *testing.T) {
 	cfgs := []bridges.Config{{ID: "abc", AppKey: "key1"}, {ID: "def", AppKey: "key2"}}
 
@@ -232,6 +247,38 @@ func TestReconcile_ReactiveGroupMember_SkipsEnactmentEntirely(t *testing.T) {
 	}
 }
 
+// TestReconcile_ReactiveGroupMember_ClearsStalePendingChanges confirms
+// drift recorded before a Light's Group went Reactive is cleared, rather
+// than reported by ListDrift and lumenetes_light_drift forever - while
+// still not enacting anything.
+func TestReconcile_ReactiveGroupMember_ClearsStalePendingChanges(t *testing.T) {
+	light := &lumenetesv1alpha1.Light{
+		ObjectMeta: metav1.ObjectMeta{Name: "light-1"},
+		Spec:       lumenetesv1alpha1.LightSpec{On: false, Brightness: 10, Color: "#000000", ColorTempK: 2000, Reactive: true},
+		Status: lumenetesv1alpha1.LightStatus{
+			On: true, Brightness: 80, Color: "#ffffff", ColorTempK: 5000, Reachable: true,
+			PendingChanges: []lumenetesv1alpha1.PendingChange{{Field: "on", Want: "false", Have: "true"}},
+		},
+	}
+	fakeClient := newFakeClientBuilder(t).WithObjects(light).WithStatusSubresource(&lumenetesv1alpha1.Light{}).Build()
+	r := &Reconciler{Client: fakeClient}
+
+	if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKey{Name: "light-1"}}); err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+
+	var got lumenetesv1alpha1.Light
+	if err := fakeClient.Get(context.Background(), client.ObjectKey{Name: "light-1"}, &got); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if len(got.Status.PendingChanges) != 0 {
+		t.Errorf("got PendingChanges = %+v, want cleared", got.Status.PendingChanges)
+	}
+	if !got.Status.LastEnactAttempt.IsZero() {
+		t.Errorf("Status.LastEnactAttempt = %v, want no enactment attempted", got.Status.LastEnactAttempt)
+	}
+}
+
 func TestReconcile_NoDiff_NoWrite(t *testing.T) {
 	synced := lumenetesv1alpha1.LightSpec{Name: "Kitchen", On: true, Brightness: 50, Color: "#ffffff", ColorTempK: 2700}
 	light := &lumenetesv1alpha1.Light{
@@ -286,7 +333,7 @@ func TestReconcile_NoDiff_ClearsStaleEnactError(t *testing.T) {
 	}
 }
 
-func TestReconcile_DryRun_NoBridgeCallNoStatusWrite(t *testing.T) {
+func TestReconcile_DryRun_NoBridgeCallRecordsPendingChanges(t *testing.T) {
 	var hit atomic.Bool
 	mux := http.NewServeMux()
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
@@ -312,11 +359,6 @@ func TestReconcile_DryRun_NoBridgeCallNoStatusWrite(t *testing.T) {
 	fakeClient := newFakeClientBuilder(t).WithObjects(light, hueBridge).WithStatusSubresource(&lumenetesv1alpha1.Light{}).Build()
 	r := &Reconciler{Client: fakeClient, Bridges: []bridges.Config{{ID: "BRIDGE1", AppKey: "key1"}}, DryRun: true}
 
-	var before lumenetesv1alpha1.Light
-	if err := fakeClient.Get(context.Background(), client.ObjectKey{Name: "light-1"}, &before); err != nil {
-		t.Fatalf("Get() error = %v", err)
-	}
-
 	if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKey{Name: "light-1"}}); err != nil {
 		t.Fatalf("Reconcile() error = %v, want nil", err)
 	}
@@ -328,14 +370,54 @@ func TestReconcile_DryRun_NoBridgeCallNoStatusWrite(t *testing.T) {
 	if got.Status.EnactError != "" || !got.Status.LastEnactAttempt.IsZero() {
 		t.Errorf("got Status = %+v, want no enactment attempted in dry-run", got.Status)
 	}
-	if got.ResourceVersion != before.ResourceVersion {
-		t.Errorf("resourceVersion changed from %s to %s, want no status write in dry-run", before.ResourceVersion, got.ResourceVersion)
+	want := []lumenetesv1alpha1.PendingChange{{Field: "on", Want: "true", Have: "false"}}
+	if !slices.Equal(got.Status.PendingChanges, want) {
+		t.Errorf("got PendingChanges = %+v, want %+v", got.Status.PendingChanges, want)
+	}
+
+	// A second pass over the same, unchanged diff must not write again -
+	// the write itself re-triggers Reconcile.
+	if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKey{Name: "light-1"}}); err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+	var again lumenetesv1alpha1.Light
+	if err := fakeClient.Get(context.Background(), client.ObjectKey{Name: "light-1"}, &again); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if again.ResourceVersion != got.ResourceVersion {
+		t.Errorf("resourceVersion changed from %s to %s, want no rewrite of unchanged pending changes", got.ResourceVersion, again.ResourceVersion)
 	}
 	if hit.Load() {
 		t.Error("bridge endpoint was called during a dry run, want no call at all")
 	}
 }
 
+func TestReconcile_NoDiff_ClearsStalePendingChanges(t *testing.T) {
+	synced := lumenetesv1alpha1.LightSpec{Name: "Kitchen", On: true, Brightness: 50, Color: "#ffffff", ColorTempK: 2700}
+	light := &lumenetesv1alpha1.Light{
+		ObjectMeta: metav1.ObjectMeta{Name: "light-1"},
+		Spec:       synced,
+		Status: lumenetesv1alpha1.LightStatus{
+			Name: "Kitchen", On: true, Brightness: 50, Color: "#ffffff", ColorTempK: 2700,
+			Reachable: true, PendingChanges: []lumenetesv1alpha1.PendingChange{{Field: "on", Want: "true", Have: "false"}},
+		},
+	}
+	fakeClient := newFakeClientBuilder(t).WithObjects(light).WithStatusSubresource(&lumenetesv1alpha1.Light{}).Build()
+	r := &Reconciler{Client: fakeClient, DryRun: true}
+
+	if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKey{Name: "light-1"}}); err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+
+	var got lumenetesv1alpha1.Light
+	if err := fakeClient.Get(context.Background(), client.ObjectKey{Name: "light-1"}, &got); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if len(got.Status.PendingChanges) != 0 {
+		t.Errorf("got PendingChanges = %+v, want cleared", got.Status.PendingChanges)
+	}
+}
+
 func TestReconcile_StateDiff_BrightnessColorColorTempK(t *testing.T) {
 	var mu sync.Mutex
 	var putBody map[string]any
@@ -39,6 +39,26 @@ func (s *Service) ListLights(ctx context.Context, req *connect.Request[v1.ListLi
 	return connect.NewResponse(resp), nil
 }
 
+// ListDrift returns every Light whose Status.PendingChanges is non-empty -
+// i.e. what the controller would push to the bridge if it weren't running
+// with --dry-run (or, if it isn't, what it's still converging on).
+func (s *Service) ListDrift(ctx context.Context, req *connect.Request[v1.ListDriftRequest]) (*connect.Response[v1.ListDriftResponse], error) {
+	var lights lumenetesv1alpha1.LightList
+	if err := s.client.List(ctx, &lights); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &v1.ListDriftResponse{Lights: []*v1.Light{}}
+	for _, light := range lights.Items {
+		if len(light.Status.PendingChanges) == 0 {
+			continue
+		}
+		resp.Lights = append(resp.Lights, toProto(light))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 func toProto(light lumenetesv1alpha1.Light) *v1.Light {
 	return &v1.Light{
 		Id:                 light.Name,
@@ -60,5 +80,14 @@ func toProto(light lumenetesv1alpha1.Light) *v1.Light {
 		LastSynced:         protoutil.Time(light.Status.LastSynced),
 		LastEnactAttempt:   protoutil.Time(light.Status.LastEnactAttempt),
 		EnactError:         light.Status.EnactError,
+		PendingChanges:     pendingChangesToProto(light.Status.PendingChanges),
+	}
+}
+
+func pendingChangesToProto(changes []lumenetesv1alpha1.PendingChange) []*v1.PendingChange {
+	out := make([]*v1.PendingChange, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, &v1.PendingChange{Field: c.Field, Want: c.Want, Have: c.Have})
 	}
+	return out
 }
@@ -32,6 +32,13 @@ var (
 		"lumenetes_light_enact_error", "Whether the last attempt to enact Spec onto the bridge failed (1) or not (0).",
 		[]string{"light", "name", "bridge_id"}, nil,
 	)
+	// lightDriftDesc is the metric view of LightStatus.PendingChanges: one
+	// series per differing field, so a dry-run deploy can be checked (and
+	// alerted on) field-by-field before DryRun is turned off.
+	lightDriftDesc = prometheus.NewDesc(
+		"lumenetes_light_drift", "Whether this field of the light's Spec differs from its observed Status (1), as of the last reconcile. Absent for fields in sync.",
+		[]string{"light", "name", "bridge_id", "field"}, nil,
+	)
 	lightLastSyncedDesc = prometheus.NewDesc(
 		"lumenetes_light_last_synced_timestamp_seconds", "Unix timestamp of the last successful status sync from the bridge.",
 		[]string{"light", "name", "bridge_id"}, nil,
@@ -62,6 +69,10 @@ func (c *Collector) collectLights(ctx context.Context, logger logr.Logger, ch ch
 			ch <- prometheus.MustNewConstMetric(lightColorTempDesc, prometheus.GaugeValue, float64(s.ColorTempK), labels...)
 		}
 		ch <- prometheus.MustNewConstMetric(lightEnactErrorDesc, prometheus.GaugeValue, boolToFloat(s.EnactError != ""), labels...)
+		for _, pc := range s.PendingChanges {
+			ch <- prometheus.MustNewConstMetric(lightDriftDesc, prometheus.GaugeValue, 1,
+				light.Name, s.Name, s.BridgeID, pc.Field)
+		}
 		if !s.LastSynced.IsZero() {
 			ch <- prometheus.MustNewConstMetric(lightLastSyncedDesc, prometheus.GaugeValue, float64(s.LastSynced.Unix()), labels...)
 		}
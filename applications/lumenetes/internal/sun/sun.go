@@ -1,5 +1,6 @@
-// Package sun computes sunrise, solar noon, sunset, and solar midnight for
-// a location and date, using the standard NOAA solar position equations
+// Package sun computes sunrise, solar noon, sunset, solar midnight and the
+// three twilights for a location and date, and the sun's elevation at any
+// instant, using the standard NOAA solar position equations
 // (equation of time + solar declination + hour angle - see
 // https://gml.noaa.gov/grad/solcalc/solareqns.PDF). It exists to anchor
 // internal/circadian's keyframe interpolation to the sun's actual position
@@ -26,19 +27,58 @@ type Coordinates struct {
 // noon can be tens of minutes away from 12:00 local clock time.
 // SolarMidnight is defined as exactly 12h after SolarNoon on the same
 // input day.
+//
+// The *Dawn/*Dusk fields are the start of morning and end of evening
+// civil (sun 6 degrees below the horizon), nautical (12) and astronomical
+// (18) twilight. They're always clamped the way ComputeClamped clamps
+// Sunrise/Sunset, even from Compute: a twilight that never ends is routine
+// well outside the polar circles (there's no astronomical night anywhere
+// north of ~48.5N around the June solstice), so treating it as an error
+// would make Compute fail every summer in most of Europe. A twilight that
+// never ends stretches out to the solar midnights either side of
+// SolarNoon; one that never begins collapses onto SolarNoon.
 type Times struct {
-	Sunrise       time.Time
-	SolarNoon     time.Time
-	Sunset        time.Time
-	SolarMidnight time.Time
+	AstronomicalDawn time.Time
+	NauticalDawn     time.Time
+	CivilDawn        time.Time
+	Sunrise          time.Time
+	SolarNoon        time.Time
+	Sunset           time.Time
+	CivilDusk        time.Time
+	NauticalDusk     time.Time
+	AstronomicalDusk time.Time
+	SolarMidnight    time.Time
+	// Polar is PolarNone unless these Times came from ComputeClamped on a
+	// day the sun never rises or never sets - see that function's doc
+	// comment for what Sunrise/Sunset mean then.
+	Polar Polar
 }
 
+// Polar says whether a day is a polar day (the sun never sets), a polar
+// night (it never rises), or neither.
+type Polar string
+
+const (
+	PolarNone  Polar = ""
+	PolarDay   Polar = "day"
+	PolarNight Polar = "night"
+)
+
 // solarNoonZenith is the sun's zenith angle (degrees) used for sunrise/
 // sunset, not 90 - it accounts for the sun's apparent radius (~16') and
 // standard atmospheric refraction at the horizon (~34'), the same
 // convention every standard sunrise/sunset calculator uses.
 const solarNoonZenith = 90.833
 
+// Zenith angles (degrees) bounding each twilight - the sun 6/12/18
+// degrees below the geometric horizon, no refraction correction, by
+// definition.
+const (
+	civilZenith        = 96.0
+	nauticalZenith     = 102.0
+	astronomicalZenith = 108.0
+)
+
 // Compute derives Times for coords on date's UTC calendar day. It operates
 // entirely in UTC - no timezone/DST handling, Coordinates.Longitude alone
 // determines how far solar noon drifts from 12:00 UTC. Returns an error
@@ -46,8 +86,92 @@ const solarNoonZenith = 90.833
 // rises or sets on date at this latitude), surfaced when the hour-angle
 // formula's acos argument falls outside [-1, 1].
 func Compute(coords Coordinates, date time.Time) (Times, error) {
+	times := ComputeClamped(coords, date)
+	if times.Polar != PolarNone {
+		day := date.UTC().Truncate(24 * time.Hour)
+		return Times{}, fmt.Errorf("sun: no sunrise/sunset at latitude %.4f on %s (polar %s)", coords.Latitude, day.Format("2006-01-02"), times.Polar)
+	}
+	return times, nil
+}
+
+// ComputeClamped is Compute without the polar day/night error: the
+// hour-angle formula's acos argument is clamped to [-1, 1] instead, which
+// is exactly the limit Sunrise/Sunset approach as a latitude nears the
+// polar circle. On a polar night they collapse onto SolarNoon (a zero-length
+// day); on a polar day they stretch out to the solar midnights either side
+// of it (a 24h day). Either way every anchor still resolves to a real,
+// correctly-ordered instant, so a keyframe curve degrades to a sensible
+// shape rather than failing outright. Times.Polar reports which case, if
+// any, was hit.
+func ComputeClamped(coords Coordinates, date time.Time) Times {
 	day := date.UTC().Truncate(24 * time.Hour)
-	t := julianCentury(day)
+	declination, eqTimeMinutes := position(julianCentury(day))
+
+	// Minutes from UTC midnight at which local apparent solar time (which
+	// runs Longitude/15h + eqTime ahead of UTC) reads 12:00.
+	solarNoonMinutes := 720 - 4*coords.Longitude - eqTimeMinutes
+
+	sunHA, polar := hourAngleMinutes(coords.Latitude, declination, solarNoonZenith)
+	civilHA, _ := hourAngleMinutes(coords.Latitude, declination, civilZenith)
+	nauticalHA, _ := hourAngleMinutes(coords.Latitude, declination, nauticalZenith)
+	astronomicalHA, _ := hourAngleMinutes(coords.Latitude, declination, astronomicalZenith)
+
+	return Times{
+		AstronomicalDawn: addMinutes(day, solarNoonMinutes-astronomicalHA),
+		NauticalDawn:     addMinutes(day, solarNoonMinutes-nauticalHA),
+		CivilDawn:        addMinutes(day, solarNoonMinutes-civilHA),
+		Sunrise:          addMinutes(day, solarNoonMinutes-sunHA),
+		SolarNoon:        addMinutes(day, solarNoonMinutes),
+		Sunset:           addMinutes(day, solarNoonMinutes+sunHA),
+		CivilDusk:        addMinutes(day, solarNoonMinutes+civilHA),
+		NauticalDusk:     addMinutes(day, solarNoonMinutes+nauticalHA),
+		AstronomicalDusk: addMinutes(day, solarNoonMinutes+astronomicalHA),
+		SolarMidnight:    addMinutes(day, solarNoonMinutes+720),
+		Polar:            polar,
+	}
+}
+
+// hourAngleMinutes returns how long before/after solar noon the sun
+// crosses zenith (degrees), in minutes, clamped (see ComputeClamped) when
+// it never does - Polar then says which way.
+func hourAngleMinutes(latitude, declination, zenith float64) (float64, Polar) {
+	latRad := degToRad(latitude)
+	cosHourAngle := math.Cos(degToRad(zenith))/(math.Cos(latRad)*math.Cos(declination)) -
+		math.Tan(latRad)*math.Tan(declination)
+	polar := PolarNone
+	switch {
+	case cosHourAngle > 1:
+		cosHourAngle, polar = 1, PolarNight
+	case cosHourAngle < -1:
+		cosHourAngle, polar = -1, PolarDay
+	}
+	return 4 * radToDeg(math.Acos(cosHourAngle)), polar
+}
+
+// Elevation returns the sun's geometric elevation above the horizon at t,
+// in degrees: positive is above, negative below, 90 directly overhead. No
+// refraction correction is applied, so the sun's upper limb visibly
+// touches the horizon (Compute's Sunrise/Sunset) at about -0.833, not 0.
+// Unlike Compute this is well-defined at every latitude and instant - a
+// polar day or night is just an elevation that never crosses 0.
+func Elevation(coords Coordinates, t time.Time) float64 {
+	declination, eqTimeMinutes := position(julianCenturyAt(t.UTC()))
+
+	// True solar time in minutes past local apparent midnight, then the
+	// hour angle: 0 at solar noon, +/-180 at solar midnight.
+	utcMinutes := float64(t.UTC().Sub(t.UTC().Truncate(24*time.Hour))) / float64(time.Minute)
+	trueSolarMinutes := utcMinutes + eqTimeMinutes + 4*coords.Longitude
+	hourAngle := degToRad(trueSolarMinutes/4 - 180)
+
+	latRad := degToRad(coords.Latitude)
+	sinElevation := math.Sin(latRad)*math.Sin(declination) +
+		math.Cos(latRad)*math.Cos(declination)*math.Cos(hourAngle)
+	return radToDeg(math.Asin(math.Max(-1, math.Min(1, sinElevation))))
+}
+
+// position returns the sun's declination (radians) and the equation of
+// time (minutes) for Julian century t.
+func position(t float64) (declination, eqTimeMinutes float64) {
 
 	l0 := normalizeDegrees(280.46646 + t*(36000.76983+t*0.0003032))
 	m := 357.52911 + t*(35999.05029-0.0001537*t)
@@ -66,36 +190,18 @@ func Compute(coords Coordinates, date time.Time) (Times, error) {
 
 	// declination is in radians already (math.Asin's output), unlike the
 	// other intermediate angles above which stay in degrees until used.
-	declination := math.Asin(math.Sin(degToRad(obliquity)) * math.Sin(degToRad(apparentLongitude)))
+	declination = math.Asin(math.Sin(degToRad(obliquity)) * math.Sin(degToRad(apparentLongitude)))
 
 	y := math.Pow(math.Tan(degToRad(obliquity)/2), 2)
 	l0Rad := degToRad(l0)
-	eqTimeMinutes := 4 * radToDeg(
+	eqTimeMinutes = 4 * radToDeg(
 		y*math.Sin(2*l0Rad)-
 			2*e*math.Sin(mRad)+
 			4*e*y*math.Sin(mRad)*math.Cos(2*l0Rad)-
 			0.5*y*y*math.Sin(4*l0Rad)-
 			1.25*e*e*math.Sin(2*mRad),
 	)
-
-	// Minutes from UTC midnight at which local apparent solar time (which
-	// runs Longitude/15h + eqTime ahead of UTC) reads 12:00.
-	solarNoonMinutes := 720 - 4*coords.Longitude - eqTimeMinutes
-
-	latRad := degToRad(coords.Latitude)
-	cosHourAngle := math.Cos(degToRad(solarNoonZenith))/(math.Cos(latRad)*math.Cos(declination)) -
-		math.Tan(latRad)*math.Tan(declination)
-	if cosHourAngle < -1 || cosHourAngle > 1 {
-		return Times{}, fmt.Errorf("sun: no sunrise/sunset at latitude %.4f on %s (polar day or night)", coords.Latitude, day.Format("2006-01-02"))
-	}
-	hourAngleMinutes := 4 * radToDeg(math.Acos(cosHourAngle))
-
-	return Times{
-		Sunrise:       addMinutes(day, solarNoonMinutes-hourAngleMinutes),
-		SolarNoon:     addMinutes(day, solarNoonMinutes),
-		Sunset:        addMinutes(day, solarNoonMinutes+hourAngleMinutes),
-		SolarMidnight: addMinutes(day, solarNoonMinutes+720),
-	}, nil
+	return declination, eqTimeMinutes
 }
 
 // julianCentury returns the number of Julian centuries since J2000.0
@@ -104,8 +210,14 @@ func Compute(coords Coordinates, date time.Time) (Times, error) {
 // beyond this doesn't materially change declination/equation-of-time for a
 // lighting schedule.
 func julianCentury(day time.Time) float64 {
-	noon := day.Add(12 * time.Hour)
-	julianDay := float64(noon.Unix())/86400.0 + 2440587.5
+	return julianCenturyAt(day.Add(12 * time.Hour))
+}
+
+// julianCenturyAt is julianCentury for an arbitrary instant, for Elevation -
+// which, unlike Compute, wants the sun's position at t itself rather than
+// one representative value for the whole day.
+func julianCenturyAt(t time.Time) float64 {
+	julianDay := float64(t.UnixNano())/float64(24*time.Hour) + 2440587.5
 	return (julianDay - 2451545.0) / 36525.0
 }
 
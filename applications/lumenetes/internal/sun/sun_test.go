@@ -126,3 +126,105 @@ func TestCompute_TemperateLatitudeNoError(t *testing.T) {
 		}
 	}
 }
+
+func TestComputeClamped_PolarNightCollapsesOntoNoon(t *testing.T) {
+	times := ComputeClamped(Coordinates{Latitude: 70, Longitude: 0}, time.Date(2026, time.December, 21, 0, 0, 0, 0, time.UTC))
+
+	if times.Polar != PolarNight {
+		t.Errorf("Polar = %q, want %q", times.Polar, PolarNight)
+	}
+	if !times.Sunrise.Equal(times.SolarNoon) || !times.Sunset.Equal(times.SolarNoon) {
+		t.Errorf("Sunrise/Sunset = %s/%s, want both at SolarNoon %s", times.Sunrise, times.Sunset, times.SolarNoon)
+	}
+}
+
+func TestComputeClamped_PolarDaySpansSolarMidnights(t *testing.T) {
+	times := ComputeClamped(Coordinates{Latitude: 70, Longitude: 0}, time.Date(2026, time.June, 21, 0, 0, 0, 0, time.UTC))
+
+	if times.Polar != PolarDay {
+		t.Errorf("Polar = %q, want %q", times.Polar, PolarDay)
+	}
+	if diff := times.Sunset.Sub(times.Sunrise); diff != 24*time.Hour {
+		t.Errorf("Sunset - Sunrise = %s, want 24h", diff)
+	}
+	if !times.Sunset.Equal(times.SolarMidnight) {
+		t.Errorf("Sunset = %s, want SolarMidnight %s", times.Sunset, times.SolarMidnight)
+	}
+}
+
+func TestComputeClamped_MatchesComputeOutsidePolarCircle(t *testing.T) {
+	coords := Coordinates{Latitude: 51.5, Longitude: -0.1}
+	date := time.Date(2026, time.June, 21, 0, 0, 0, 0, time.UTC)
+	if got, want := ComputeClamped(coords, date), mustCompute(t, coords, date); got != want {
+		t.Errorf("ComputeClamped = %+v, want Compute's %+v", got, want)
+	}
+}
+
+func TestElevation_MatchesComputeAnchors(t *testing.T) {
+	// Sunrise/sunset are defined at solarNoonZenith, i.e. ~-0.833 degrees
+	// of geometric elevation; solar noon is the day's peak.
+	coords := Coordinates{Latitude: 51.5, Longitude: -0.1}
+	times := mustCompute(t, coords, time.Date(2026, time.March, 20, 0, 0, 0, 0, time.UTC))
+
+	for name, at := range map[string]time.Time{"sunrise": times.Sunrise, "sunset": times.Sunset} {
+		if e := Elevation(coords, at); e < -1.5 || e > 0 {
+			t.Errorf("Elevation at %s = %.3f, want ~-0.833", name, e)
+		}
+	}
+	noon := Elevation(coords, times.SolarNoon)
+	if noon < 37 || noon > 40 {
+		// 90 - latitude + declination, declination ~0 at the equinox.
+		t.Errorf("Elevation at equinox solar noon at 51.5N = %.3f, want ~38.5", noon)
+	}
+	for _, offset := range []time.Duration{-time.Hour, time.Hour} {
+		if e := Elevation(coords, times.SolarNoon.Add(offset)); e >= noon {
+			t.Errorf("Elevation %s from solar noon = %.3f, want below noon's %.3f", offset, e, noon)
+		}
+	}
+	if e := Elevation(coords, times.SolarMidnight); e > -30 {
+		t.Errorf("Elevation at solar midnight = %.3f, want well below the horizon", e)
+	}
+}
+
+func TestElevation_PolarNightNeverAboveHorizon(t *testing.T) {
+	coords := Coordinates{Latitude: 75, Longitude: 0}
+	day := time.Date(2026, time.December, 21, 0, 0, 0, 0, time.UTC)
+	for h := 0; h < 24; h++ {
+		if e := Elevation(coords, day.Add(time.Duration(h)*time.Hour)); e >= 0 {
+			t.Errorf("Elevation at 75N on the December solstice, %02d:00 UTC = %.3f, want below the horizon", h, e)
+		}
+	}
+}
+
+func TestCompute_TwilightOrdering(t *testing.T) {
+	times := mustCompute(t, Coordinates{Latitude: 40, Longitude: -74}, time.Date(2026, time.March, 20, 0, 0, 0, 0, time.UTC))
+
+	ordered := []time.Time{
+		times.AstronomicalDawn, times.NauticalDawn, times.CivilDawn, times.Sunrise,
+		times.SolarNoon,
+		times.Sunset, times.CivilDusk, times.NauticalDusk, times.AstronomicalDusk,
+	}
+	for i := 1; i < len(ordered); i++ {
+		if !ordered[i].After(ordered[i-1]) {
+			t.Errorf("twilight/solar instant %d (%s) not after instant %d (%s)", i, ordered[i], i-1, ordered[i-1])
+		}
+	}
+	// Civil twilight at a mid latitude near the equinox lasts ~25-35m.
+	if d := times.Sunrise.Sub(times.CivilDawn); d < 20*time.Minute || d > 40*time.Minute {
+		t.Errorf("civil dawn -> sunrise = %s, want ~30m", d)
+	}
+}
+
+func TestCompute_WhiteNightClampsTwilightWithoutError(t *testing.T) {
+	// 55N at the June solstice: the sun rises and sets, so Compute
+	// succeeds, but never gets 18 degrees below the horizon - astronomical
+	// twilight stretches out to solar midnight instead of erroring.
+	times := mustCompute(t, Coordinates{Latitude: 55, Longitude: 0}, time.Date(2026, time.June, 21, 0, 0, 0, 0, time.UTC))
+
+	if !times.AstronomicalDusk.Equal(times.SolarMidnight) {
+		t.Errorf("AstronomicalDusk = %s, want clamped to SolarMidnight %s", times.AstronomicalDusk, times.SolarMidnight)
+	}
+	if !times.CivilDusk.Before(times.SolarMidnight) {
+		t.Errorf("CivilDusk = %s, want a real instant before SolarMidnight %s", times.CivilDusk, times.SolarMidnight)
+	}
+}
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file lumenetes/v1/circadian_schedule.proto.
  */
 export const file_lumenetes_v1_circadian_schedule: GenFile = /*@__PURE__*/
-  fileDesc("CiVsdW1lbmV0ZXMvdjEvY2lyY2FkaWFuX3NjaGVkdWxlLnByb3RvEgxsdW1lbmV0ZXMudjEisAEKEUNpcmNhZGlhbktleWZyYW1lEi0KBmFuY2hvchgBIAEoDjIdLmx1bWVuZXRlcy52MS5DaXJjYWRpYW5BbmNob3ISFgoOb2Zmc2V0X21pbnV0ZXMYAiABKAUSEgoKYnJpZ2h0bmVzcxgDIAEoBRIUCgxjb2xvcl90ZW1wX2sYBCABKAUSKgoCb24YBSABKA4yHi5sdW1lbmV0ZXMudjEuQ2lyY2FkaWFuT25TdGF0ZSLGAgoRQ2lyY2FkaWFuU2NoZWR1bGUSCgoCaWQYASABKAkSDQoFZ3JvdXAYAiABKAkSEAoIbGF0aXR1ZGUYAyABKAESEQoJbG9uZ2l0dWRlGAQgASgBEjIKCWtleWZyYW1lcxgFIAMoCzIfLmx1bWVuZXRlcy52MS5DaXJjYWRpYW5LZXlmcmFtZRIfChJjdXJyZW50X2JyaWdodG5lc3MYBiABKAVIAIgBARIhChRjdXJyZW50X2NvbG9yX3RlbXBfaxgHIAEoBUgBiAEBEhgKEHZhbGlkYXRpb25fZXJyb3IYCCABKAkSLwoLbGFzdF9zeW5jZWQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQhUKE19jdXJyZW50X2JyaWdodG5lc3NCFwoVX2N1cnJlbnRfY29sb3JfdGVtcF9rIh8KHUxpc3RDaXJjYWRpYW5TY2hlZHVsZXNSZXF1ZXN0Il4KHkxpc3RDaXJjYWRpYW5TY2hlZHVsZXNSZXNwb25zZRI8ChNjaXJjYWRpYW5fc2NoZWR1bGVzGAEgAygLMh8ubHVtZW5ldGVzLnYxLkNpcmNhZGlhblNjaGVkdWxlKrQBCg9DaXJjYWRpYW5BbmNob3ISIAocQ0lSQ0FESUFOX0FOQ0hPUl9VTlNQRUNJRklFRBAAEhwKGENJUkNBRElBTl9BTkNIT1JfU1VOUklTRRABEh8KG0NJUkNBRElBTl9BTkNIT1JfU09MQVJfTk9PThACEhsKF0NJUkNBRElBTl9BTkNIT1JfU1VOU0VUEAMSIwofQ0lSQ0FESUFOX0FOQ0hPUl9TT0xBUl9NSUROSUdIVBAEKmsKEENpcmNhZGlhbk9uU3RhdGUSIAocQ0lSQ0FESUFOX09OX1NUQVRFX1VOQ0hBTkdFRBAAEhkKFUNJUkNBRElBTl9PTl9TVEFURV9PThABEhoKFkNJUkNBRElBTl9PTl9TVEFURV9PRkYQAjKPAQoYQ2lyY2FkaWFuU2NoZWR1bGVTZXJ2aWNlEnMKFkxpc3RDaXJjYWRpYW5TY2hlZHVsZXMSKy5sdW1lbmV0ZXMudjEuTGlzdENpcmNhZGlhblNjaGVkdWxlc1JlcXVlc3QaLC5sdW1lbmV0ZXMudjEuTGlzdENpcmNhZGlhblNjaGVkdWxlc1Jlc3BvbnNlQj5aPGdpdGh1Yi5jb20vbGlhbWF3aGl0ZS9sdW1lbmV0ZXMvZ2VuL2x1bWVuZXRlcy92MTtsdW1lbmV0ZXN2MWIGcHJvdG8z", [file_google_protobuf_timestamp]);
+  fileDesc("CiVsdW1lbmV0ZXMvdjEvY2lyY2FkaWFuX3NjaGVkdWxlLnByb3RvEgxsdW1lbmV0ZXMudjEivgEKEUNpcmNhZGlhbktleWZyYW1lEi0KBmFuY2hvchgBIAEoDjIdLmx1bWVuZXRlcy52MS5DaXJjYWRpYW5BbmNob3ISFgoOb2Zmc2V0X21pbnV0ZXMYAiABKAUSEgoKYnJpZ2h0bmVzcxgDIAEoBRIUCgxjb2xvcl90ZW1wX2sYBCABKAUSKgoCb24YBSABKA4yHi5sdW1lbmV0ZXMudjEuQ2lyY2FkaWFuT25TdGF0ZRIMCgR0aW1lGAYgASgJInQKEENpcmNhZGlhblZhcmlhbnQSLAoEZGF5cxgBIAMoDjIeLmx1bWVuZXRlcy52MS5DaXJjYWRpYW5XZWVrZGF5EjIKCWtleWZyYW1lcxgCIAMoCzIfLmx1bWVuZXRlcy52MS5DaXJjYWRpYW5LZXlmcmFtZSJUChdDaXJjYWRpYW5FbGV2YXRpb25Qb2ludBIPCgdkZWdyZWVzGAEgASgBEhIKCmJyaWdodG5lc3MYAiABKAUSFAoMY29sb3JfdGVtcF9rGAMgASgFIvADChFDaXJjYWRpYW5TY2hlZHVsZRIKCgJpZBgBIAEoCRINCgVncm91cBgCIAEoCRIQCghsYXRpdHVkZRgDIAEoARIRCglsb25naXR1ZGUYBCABKAESMgoJa2V5ZnJhbWVzGAUgAygLMh8ubHVtZW5ldGVzLnYxLkNpcmNhZGlhbktleWZyYW1lEh8KEmN1cnJlbnRfYnJpZ2h0bmVzcxgGIAEoBUgAiAEBEiEKFGN1cnJlbnRfY29sb3JfdGVtcF9rGAcgASgFSAGIAQESGAoQdmFsaWRhdGlvbl9lcnJvchgIIAEoCRIvCgtsYXN0X3N5bmNlZBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKQoEbW9kZRgKIAEoDjIbLmx1bWVuZXRlcy52MS5DaXJjYWRpYW5Nb2RlEjgKCWVsZXZhdGlvbhgLIAMoCzIlLmx1bWVuZXRlcy52MS5DaXJjYWRpYW5FbGV2YXRpb25Qb2ludBIRCgl0aW1lX3pvbmUYDCABKAkSMAoIdmFyaWFudHMYDSADKAsyHi5sdW1lbmV0ZXMudjEuQ2lyY2FkaWFuVmFyaWFudEIVChNfY3VycmVudF9icmlnaHRuZXNzQhcKFV9jdXJyZW50X2NvbG9yX3RlbXBfayIfCh1MaXN0Q2lyY2FkaWFuU2NoZWR1bGVzUmVxdWVzdCJeCh5MaXN0Q2lyY2FkaWFuU2NoZWR1bGVzUmVzcG9uc2USPAoTY2lyY2FkaWFuX3NjaGVkdWxlcxgBIAMoCzIfLmx1bWVuZXRlcy52MS5DaXJjYWRpYW5TY2hlZHVsZSqvAwoPQ2lyY2FkaWFuQW5jaG9yEiAKHENJUkNBRElBTl9BTkNIT1JfVU5TUEVDSUZJRUQQABIcChhDSVJDQURJQU5fQU5DSE9SX1NVTlJJU0UQARIfChtDSVJDQURJQU5fQU5DSE9SX1NPTEFSX05PT04QAhIbChdDSVJDQURJQU5fQU5DSE9SX1NVTlNFVBADEiMKH0NJUkNBRElBTl9BTkNIT1JfU09MQVJfTUlETklHSFQQBBIfChtDSVJDQURJQU5fQU5DSE9SX0NJVklMX0RBV04QBRIfChtDSVJDQURJQU5fQU5DSE9SX0NJVklMX0RVU0sQBhIiCh5DSVJDQURJQU5fQU5DSE9SX05BVVRJQ0FMX0RBV04QBxIiCh5DSVJDQURJQU5fQU5DSE9SX05BVVRJQ0FMX0RVU0sQCBImCiJDSVJDQURJQU5fQU5DSE9SX0FTVFJPTk9NSUNBTF9EQVdOEAkSJgoiQ0lSQ0FESUFOX0FOQ0hPUl9BU1RST05PTUlDQUxfRFVTSxAKEh8KG0NJUkNBRElBTl9BTkNIT1JfQ0xPQ0tfVElNRRALKmsKEENpcmNhZGlhbk9uU3RhdGUSIAocQ0lSQ0FESUFOX09OX1NUQVRFX1VOQ0hBTkdFRBAAEhkKFUNJUkNBRElBTl9PTl9TVEFURV9PThABEhoKFkNJUkNBRElBTl9PTl9TVEFURV9PRkYQAiprCg1DaXJjYWRpYW5Nb2RlEh4KGkNJUkNBRElBTl9NT0RFX1VOU1BFQ0lGSUVEEAASHAoYQ0lSQ0FESUFOX01PREVfS0VZRlJBTUVTEAESHAoYQ0lSQ0FESUFOX01PREVfRUxFVkFUSU9OEAIqjwIKEENpcmNhZGlhbldlZWtkYXkSIQodQ0lSQ0FESUFOX1dFRUtEQVlfVU5TUEVDSUZJRUQQABIcChhDSVJDQURJQU5fV0VFS0RBWV9NT05EQVkQARIdChlDSVJDQURJQU5fV0VFS0RBWV9UVUVTREFZEAISHwobQ0lSQ0FESUFOX1dFRUtEQVlfV0VETkVTREFZEAMSHgoaQ0lSQ0FESUFOX1dFRUtEQVlfVEhVUlNEQVkQBBIcChhDSVJDQURJQU5fV0VFS0RBWV9GUklEQVkQBRIeChpDSVJDQURJQU5fV0VFS0RBWV9TQVRVUkRBWRAGEhwKGENJUkNBRElBTl9XRUVLREFZX1NVTkRBWRAHMo8BChhDaXJjYWRpYW5TY2hlZHVsZVNlcnZpY2UScwoWTGlzdENpcmNhZGlhblNjaGVkdWxlcxIrLmx1bWVuZXRlcy52MS5MaXN0Q2lyY2FkaWFuU2NoZWR1bGVzUmVxdWVzdBosLmx1bWVuZXRlcy52MS5MaXN0Q2lyY2FkaWFuU2NoZWR1bGVzUmVzcG9uc2VCPlo8Z2l0aHViLmNvbS9saWFtYXdoaXRlL2x1bWVuZXRlcy9nZW4vbHVtZW5ldGVzL3YxO2x1bWVuZXRlc3YxYgZwcm90bzM", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message lumenetes.v1.CircadianKeyframe
@@ -42,6 +42,13 @@ export type CircadianKeyframe = Message<"lumenetes.v1.CircadianKeyframe"> & {
    * @generated from field: lumenetes.v1.CircadianOnState on = 5;
    */
   on: CircadianOnState;
+
+  /**
+   * "HH:MM" in the schedule's time_zone, only set for CLOCK_TIME.
+   *
+   * @generated from field: string time = 6;
+   */
+  time: string;
 };
 
 /**
@@ -51,6 +58,55 @@ export type CircadianKeyframe = Message<"lumenetes.v1.CircadianKeyframe"> & {
 export const CircadianKeyframeSchema: GenMessage<CircadianKeyframe> = /*@__PURE__*/
   messageDesc(file_lumenetes_v1_circadian_schedule, 0);
 
+/**
+ * @generated from message lumenetes.v1.CircadianVariant
+ */
+export type CircadianVariant = Message<"lumenetes.v1.CircadianVariant"> & {
+  /**
+   * @generated from field: repeated lumenetes.v1.CircadianWeekday days = 1;
+   */
+  days: CircadianWeekday[];
+
+  /**
+   * @generated from field: repeated lumenetes.v1.CircadianKeyframe keyframes = 2;
+   */
+  keyframes: CircadianKeyframe[];
+};
+
+/**
+ * Describes the message lumenetes.v1.CircadianVariant.
+ * Use `create(CircadianVariantSchema)` to create a new message.
+ */
+export const CircadianVariantSchema: GenMessage<CircadianVariant> = /*@__PURE__*/
+  messageDesc(file_lumenetes_v1_circadian_schedule, 1);
+
+/**
+ * @generated from message lumenetes.v1.CircadianElevationPoint
+ */
+export type CircadianElevationPoint = Message<"lumenetes.v1.CircadianElevationPoint"> & {
+  /**
+   * @generated from field: double degrees = 1;
+   */
+  degrees: number;
+
+  /**
+   * @generated from field: int32 brightness = 2;
+   */
+  brightness: number;
+
+  /**
+   * @generated from field: int32 color_temp_k = 3;
+   */
+  colorTempK: number;
+};
+
+/**
+ * Describes the message lumenetes.v1.CircadianElevationPoint.
+ * Use `create(CircadianElevationPointSchema)` to create a new message.
+ */
+export const CircadianElevationPointSchema: GenMessage<CircadianElevationPoint> = /*@__PURE__*/
+  messageDesc(file_lumenetes_v1_circadian_schedule, 2);
+
 /**
  * @generated from message lumenetes.v1.CircadianSchedule
  */
@@ -99,6 +155,26 @@ export type CircadianSchedule = Message<"lumenetes.v1.CircadianSchedule"> & {
    * @generated from field: google.protobuf.Timestamp last_synced = 9;
    */
   lastSynced?: Timestamp | undefined;
+
+  /**
+   * @generated from field: lumenetes.v1.CircadianMode mode = 10;
+   */
+  mode: CircadianMode;
+
+  /**
+   * @generated from field: repeated lumenetes.v1.CircadianElevationPoint elevation = 11;
+   */
+  elevation: CircadianElevationPoint[];
+
+  /**
+   * @generated from field: string time_zone = 12;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: repeated lumenetes.v1.CircadianVariant variants = 13;
+   */
+  variants: CircadianVariant[];
 };
 
 /**
@@ -106,7 +182,7 @@ export type CircadianSchedule = Message<"lumenetes.v1.CircadianSchedule"> & {
  * Use `create(CircadianScheduleSchema)` to create a new message.
  */
 export const CircadianScheduleSchema: GenMessage<CircadianSchedule> = /*@__PURE__*/
-  messageDesc(file_lumenetes_v1_circadian_schedule, 1);
+  messageDesc(file_lumenetes_v1_circadian_schedule, 3);
 
 /**
  * @generated from message lumenetes.v1.ListCircadianSchedulesRequest
@@ -119,7 +195,7 @@ export type ListCircadianSchedulesRequest = Message<"lumenetes.v1.ListCircadianS
  * Use `create(ListCircadianSchedulesRequestSchema)` to create a new message.
  */
 export const ListCircadianSchedulesRequestSchema: GenMessage<ListCircadianSchedulesRequest> = /*@__PURE__*/
-  messageDesc(file_lumenetes_v1_circadian_schedule, 2);
+  messageDesc(file_lumenetes_v1_circadian_schedule, 4);
 
 /**
  * @generated from message lumenetes.v1.ListCircadianSchedulesResponse
@@ -136,7 +212,7 @@ export type ListCircadianSchedulesResponse = Message<"lumenetes.v1.ListCircadian
  * Use `create(ListCircadianSchedulesResponseSchema)` to create a new message.
  */
 export const ListCircadianSchedulesResponseSchema: GenMessage<ListCircadianSchedulesResponse> = /*@__PURE__*/
-  messageDesc(file_lumenetes_v1_circadian_schedule, 3);
+  messageDesc(file_lumenetes_v1_circadian_schedule, 5);
 
 /**
  * @generated from enum lumenetes.v1.CircadianAnchor
@@ -166,6 +242,41 @@ export enum CircadianAnchor {
    * @generated from enum value: CIRCADIAN_ANCHOR_SOLAR_MIDNIGHT = 4;
    */
   SOLAR_MIDNIGHT = 4,
+
+  /**
+   * @generated from enum value: CIRCADIAN_ANCHOR_CIVIL_DAWN = 5;
+   */
+  CIVIL_DAWN = 5,
+
+  /**
+   * @generated from enum value: CIRCADIAN_ANCHOR_CIVIL_DUSK = 6;
+   */
+  CIVIL_DUSK = 6,
+
+  /**
+   * @generated from enum value: CIRCADIAN_ANCHOR_NAUTICAL_DAWN = 7;
+   */
+  NAUTICAL_DAWN = 7,
+
+  /**
+   * @generated from enum value: CIRCADIAN_ANCHOR_NAUTICAL_DUSK = 8;
+   */
+  NAUTICAL_DUSK = 8,
+
+  /**
+   * @generated from enum value: CIRCADIAN_ANCHOR_ASTRONOMICAL_DAWN = 9;
+   */
+  ASTRONOMICAL_DAWN = 9,
+
+  /**
+   * @generated from enum value: CIRCADIAN_ANCHOR_ASTRONOMICAL_DUSK = 10;
+   */
+  ASTRONOMICAL_DUSK = 10,
+
+  /**
+   * @generated from enum value: CIRCADIAN_ANCHOR_CLOCK_TIME = 11;
+   */
+  CLOCK_TIME = 11,
 }
 
 /**
@@ -200,6 +311,83 @@ export enum CircadianOnState {
 export const CircadianOnStateSchema: GenEnum<CircadianOnState> = /*@__PURE__*/
   enumDesc(file_lumenetes_v1_circadian_schedule, 1);
 
+/**
+ * @generated from enum lumenetes.v1.CircadianMode
+ */
+export enum CircadianMode {
+  /**
+   * @generated from enum value: CIRCADIAN_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CIRCADIAN_MODE_KEYFRAMES = 1;
+   */
+  KEYFRAMES = 1,
+
+  /**
+   * @generated from enum value: CIRCADIAN_MODE_ELEVATION = 2;
+   */
+  ELEVATION = 2,
+}
+
+/**
+ * Describes the enum lumenetes.v1.CircadianMode.
+ */
+export const CircadianModeSchema: GenEnum<CircadianMode> = /*@__PURE__*/
+  enumDesc(file_lumenetes_v1_circadian_schedule, 2);
+
+/**
+ * @generated from enum lumenetes.v1.CircadianWeekday
+ */
+export enum CircadianWeekday {
+  /**
+   * @generated from enum value: CIRCADIAN_WEEKDAY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CIRCADIAN_WEEKDAY_MONDAY = 1;
+   */
+  MONDAY = 1,
+
+  /**
+   * @generated from enum value: CIRCADIAN_WEEKDAY_TUESDAY = 2;
+   */
+  TUESDAY = 2,
+
+  /**
+   * @generated from enum value: CIRCADIAN_WEEKDAY_WEDNESDAY = 3;
+   */
+  WEDNESDAY = 3,
+
+  /**
+   * @generated from enum value: CIRCADIAN_WEEKDAY_THURSDAY = 4;
+   */
+  THURSDAY = 4,
+
+  /**
+   * @generated from enum value: CIRCADIAN_WEEKDAY_FRIDAY = 5;
+   */
+  FRIDAY = 5,
+
+  /**
+   * @generated from enum value: CIRCADIAN_WEEKDAY_SATURDAY = 6;
+   */
+  SATURDAY = 6,
+
+  /**
+   * @generated from enum value: CIRCADIAN_WEEKDAY_SUNDAY = 7;
+   */
+  SUNDAY = 7,
+}
+
+/**
+ * Describes the enum lumenetes.v1.CircadianWeekday.
+ */
+export const CircadianWeekdaySchema: GenEnum<CircadianWeekday> = /*@__PURE__*/
+  enumDesc(file_lumenetes_v1_circadian_schedule, 3);
+
 /**
  * @generated from service lumenetes.v1.CircadianScheduleService
  */
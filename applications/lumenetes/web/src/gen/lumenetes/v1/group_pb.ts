@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file lumenetes/v1/group.proto.
  */
 export const file_lumenetes_v1_group: GenFile = /*@__PURE__*/
-  fileDesc("ChhsdW1lbmV0ZXMvdjEvZ3JvdXAucHJvdG8SDGx1bWVuZXRlcy52MSJLCg5BY3RpdmVTY2VuZVJlZhIrCgRraW5kGAEgASgOMh0ubHVtZW5ldGVzLnYxLkFjdGl2ZVNjZW5lS2luZBIMCgRuYW1lGAIgASgJItEBCgVHcm91cBIKCgJpZBgBIAEoCRIOCgZsaWdodHMYAiADKAkSMgoMYWN0aXZlX3NjZW5lGAMgASgLMhwubHVtZW5ldGVzLnYxLkFjdGl2ZVNjZW5lUmVmEhYKDm1pc3NpbmdfbGlnaHRzGAQgAygJEhMKC2xpZ2h0X2NvdW50GAUgASgFEhoKEmFjdGl2ZV9zY2VuZV9lcnJvchgGIAEoCRIvCgtsYXN0X3N5bmNlZBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiEwoRTGlzdEdyb3Vwc1JlcXVlc3QiOQoSTGlzdEdyb3Vwc1Jlc3BvbnNlEiMKBmdyb3VwcxgBIAMoCzITLmx1bWVuZXRlcy52MS5Hcm91cCq2AQoPQWN0aXZlU2NlbmVLaW5kEiEKHUFDVElWRV9TQ0VORV9LSU5EX1VOU1BFQ0lGSUVEEAASGwoXQUNUSVZFX1NDRU5FX0tJTkRfU0NFTkUQARIoCiRBQ1RJVkVfU0NFTkVfS0lORF9DSVJDQURJQU5fU0NIRURVTEUQAhIZChVBQ1RJVkVfU0NFTkVfS0lORF9PRkYQAxIeChpBQ1RJVkVfU0NFTkVfS0lORF9SRUFDVElWRRAEMl8KDEdyb3VwU2VydmljZRJPCgpMaXN0R3JvdXBzEh8ubHVtZW5ldGVzLnYxLkxpc3RHcm91cHNSZXF1ZXN0GiAubHVtZW5ldGVzLnYxLkxpc3RHcm91cHNSZXNwb25zZUI+WjxnaXRodWIuY29tL2xpYW1hd2hpdGUvbHVtZW5ldGVzL2dlbi9sdW1lbmV0ZXMvdjE7bHVtZW5ldGVzdjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+  fileDesc("ChhsdW1lbmV0ZXMvdjEvZ3JvdXAucHJvdG8SDGx1bWVuZXRlcy52MSJLCg5BY3RpdmVTY2VuZVJlZhIrCgRraW5kGAEgASgOMh0ubHVtZW5ldGVzLnYxLkFjdGl2ZVNjZW5lS2luZBIMCgRuYW1lGAIgASgJIjMKCEF3YXlNb2RlEg8KB2VuYWJsZWQYASABKAgSFgoOaml0dGVyX21pbnV0ZXMYAiABKAUi9wEKBUdyb3VwEgoKAmlkGAEgASgJEg4KBmxpZ2h0cxgCIAMoCRIyCgxhY3RpdmVfc2NlbmUYAyABKAsyHC5sdW1lbmV0ZXMudjEuQWN0aXZlU2NlbmVSZWYSFgoObWlzc2luZ19saWdodHMYBCADKAkSEwoLbGlnaHRfY291bnQYBSABKAUSGgoSYWN0aXZlX3NjZW5lX2Vycm9yGAYgASgJEi8KC2xhc3Rfc3luY2VkGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIkCgRhd2F5GAggASgLMhYubHVtZW5ldGVzLnYxLkF3YXlNb2RlIhMKEUxpc3RHcm91cHNSZXF1ZXN0IjkKEkxpc3RHcm91cHNSZXNwb25zZRIjCgZncm91cHMYASADKAsyEy5sdW1lbmV0ZXMudjEuR3JvdXAiMwoOU2V0QXdheVJlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAkSDwoHZW5hYmxlZBgCIAEoCCI1Cg9TZXRBd2F5UmVzcG9uc2USIgoFZ3JvdXAYASABKAsyEy5sdW1lbmV0ZXMudjEuR3JvdXAqtgEKD0FjdGl2ZVNjZW5lS2luZBIhCh1BQ1RJVkVfU0NFTkVfS0lORF9VTlNQRUNJRklFRBAAEhsKF0FDVElWRV9TQ0VORV9LSU5EX1NDRU5FEAESKAokQUNUSVZFX1NDRU5FX0tJTkRfQ0lSQ0FESUFOX1NDSEVEVUxFEAISGQoVQUNUSVZFX1NDRU5FX0tJTkRfT0ZGEAMSHgoaQUNUSVZFX1NDRU5FX0tJTkRfUkVBQ1RJVkUQBDKnAQoMR3JvdXBTZXJ2aWNlEk8KCkxpc3RHcm91cHMSHy5sdW1lbmV0ZXMudjEuTGlzdEdyb3Vwc1JlcXVlc3QaIC5sdW1lbmV0ZXMudjEuTGlzdEdyb3Vwc1Jlc3BvbnNlEkYKB1NldEF3YXkSHC5sdW1lbmV0ZXMudjEuU2V0QXdheVJlcXVlc3QaHS5sdW1lbmV0ZXMudjEuU2V0QXdheVJlc3BvbnNlQj5aPGdpdGh1Yi5jb20vbGlhbWF3aGl0ZS9sdW1lbmV0ZXMvZ2VuL2x1bWVuZXRlcy92MTtsdW1lbmV0ZXN2MWIGcHJvdG8z", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message lumenetes.v1.ActiveSceneRef
@@ -36,6 +36,28 @@ export type ActiveSceneRef = Message<"lumenetes.v1.ActiveSceneRef"> & {
 export const ActiveSceneRefSchema: GenMessage<ActiveSceneRef> = /*@__PURE__*/
   messageDesc(file_lumenetes_v1_group, 0);
 
+/**
+ * @generated from message lumenetes.v1.AwayMode
+ */
+export type AwayMode = Message<"lumenetes.v1.AwayMode"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: int32 jitter_minutes = 2;
+   */
+  jitterMinutes: number;
+};
+
+/**
+ * Describes the message lumenetes.v1.AwayMode.
+ * Use `create(AwayModeSchema)` to create a new message.
+ */
+export const AwayModeSchema: GenMessage<AwayMode> = /*@__PURE__*/
+  messageDesc(file_lumenetes_v1_group, 1);
+
 /**
  * @generated from message lumenetes.v1.Group
  */
@@ -74,6 +96,11 @@ export type Group = Message<"lumenetes.v1.Group"> & {
    * @generated from field: google.protobuf.Timestamp last_synced = 7;
    */
   lastSynced?: Timestamp | undefined;
+
+  /**
+   * @generated from field: lumenetes.v1.AwayMode away = 8;
+   */
+  away?: AwayMode | undefined;
 };
 
 /**
@@ -81,7 +108,7 @@ export type Group = Message<"lumenetes.v1.Group"> & {
  * Use `create(GroupSchema)` to create a new message.
  */
 export const GroupSchema: GenMessage<Group> = /*@__PURE__*/
-  messageDesc(file_lumenetes_v1_group, 1);
+  messageDesc(file_lumenetes_v1_group, 2);
 
 /**
  * @generated from message lumenetes.v1.ListGroupsRequest
@@ -94,7 +121,7 @@ export type ListGroupsRequest = Message<"lumenetes.v1.ListGroupsRequest"> & {
  * Use `create(ListGroupsRequestSchema)` to create a new message.
  */
 export const ListGroupsRequestSchema: GenMessage<ListGroupsRequest> = /*@__PURE__*/
-  messageDesc(file_lumenetes_v1_group, 2);
+  messageDesc(file_lumenetes_v1_group, 3);
 
 /**
  * @generated from message lumenetes.v1.ListGroupsResponse
@@ -111,7 +138,46 @@ export type ListGroupsResponse = Message<"lumenetes.v1.ListGroupsResponse"> & {
  * Use `create(ListGroupsResponseSchema)` to create a new message.
  */
 export const ListGroupsResponseSchema: GenMessage<ListGroupsResponse> = /*@__PURE__*/
-  messageDesc(file_lumenetes_v1_group, 3);
+  messageDesc(file_lumenetes_v1_group, 4);
+
+/**
+ * @generated from message lumenetes.v1.SetAwayRequest
+ */
+export type SetAwayRequest = Message<"lumenetes.v1.SetAwayRequest"> & {
+  /**
+   * @generated from field: string group_id = 1;
+   */
+  groupId: string;
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+};
+
+/**
+ * Describes the message lumenetes.v1.SetAwayRequest.
+ * Use `create(SetAwayRequestSchema)` to create a new message.
+ */
+export const SetAwayRequestSchema: GenMessage<SetAwayRequest> = /*@__PURE__*/
+  messageDesc(file_lumenetes_v1_group, 5);
+
+/**
+ * @generated from message lumenetes.v1.SetAwayResponse
+ */
+export type SetAwayResponse = Message<"lumenetes.v1.SetAwayResponse"> & {
+  /**
+   * @generated from field: lumenetes.v1.Group group = 1;
+   */
+  group?: Group | undefined;
+};
+
+/**
+ * Describes the message lumenetes.v1.SetAwayResponse.
+ * Use `create(SetAwayResponseSchema)` to create a new message.
+ */
+export const SetAwayResponseSchema: GenMessage<SetAwayResponse> = /*@__PURE__*/
+  messageDesc(file_lumenetes_v1_group, 6);
 
 /**
  * @generated from enum lumenetes.v1.ActiveSceneKind
@@ -161,6 +227,18 @@ export const GroupService: GenService<{
     input: typeof ListGroupsRequestSchema;
     output: typeof ListGroupsResponseSchema;
   },
+  /**
+   * SetAway turns a Group's presence simulation on or off - the one
+   * write this API exposes, limited to a single toggle rather than a
+   * general Group update.
+   *
+   * @generated from rpc lumenetes.v1.GroupService.SetAway
+   */
+  setAway: {
+    methodKind: "unary";
+    input: typeof SetAwayRequestSchema;
+    output: typeof SetAwayResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_lumenetes_v1_group, 0);
 
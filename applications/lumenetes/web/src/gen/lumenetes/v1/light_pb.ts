@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file lumenetes/v1/light.proto.
  */
 export const file_lumenetes_v1_light: GenFile = /*@__PURE__*/
-  fileDesc("ChhsdW1lbmV0ZXMvdjEvbGlnaHQucHJvdG8SDGx1bWVuZXRlcy52MSLbAwoFTGlnaHQSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIRCglicmlkZ2VfaWQYAyABKAkSEwoLb2JzZXJ2ZWRfb24YBCABKAgSGwoTb2JzZXJ2ZWRfYnJpZ2h0bmVzcxgFIAEoBRIWCg5vYnNlcnZlZF9jb2xvchgGIAEoCRIdChVvYnNlcnZlZF9jb2xvcl90ZW1wX2sYByABKAUSEgoKZGVzaXJlZF9vbhgIIAEoCBIaChJkZXNpcmVkX2JyaWdodG5lc3MYCSABKAUSFQoNZGVzaXJlZF9jb2xvchgKIAEoCRIcChRkZXNpcmVkX2NvbG9yX3RlbXBfaxgLIAEoBRIQCghyZWFjdGl2ZRgMIAEoCBIUCgxmaXh0dXJlX3R5cGUYDSABKAkSDwoHcHJvZHVjdBgOIAEoCRINCgVtb2RlbBgPIAEoCRIRCglyZWFjaGFibGUYECABKAgSLwoLbGFzdF9zeW5jZWQYESABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjYKEmxhc3RfZW5hY3RfYXR0ZW1wdBgSIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEwoLZW5hY3RfZXJyb3IYEyABKAkiEwoRTGlzdExpZ2h0c1JlcXVlc3QiOQoSTGlzdExpZ2h0c1Jlc3BvbnNlEiMKBmxpZ2h0cxgBIAMoCzITLmx1bWVuZXRlcy52MS5MaWdodDJfCgxMaWdodFNlcnZpY2USTwoKTGlzdExpZ2h0cxIfLmx1bWVuZXRlcy52MS5MaXN0TGlnaHRzUmVxdWVzdBogLmx1bWVuZXRlcy52MS5MaXN0TGlnaHRzUmVzcG9uc2VCPlo8Z2l0aHViLmNvbS9saWFtYXdoaXRlL2x1bWVuZXRlcy9nZW4vbHVtZW5ldGVzL3YxO2x1bWVuZXRlc3YxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("ChhsdW1lbmV0ZXMvdjEvbGlnaHQucHJvdG8SDGx1bWVuZXRlcy52MSKRBAoFTGlnaHQSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIRCglicmlkZ2VfaWQYAyABKAkSEwoLb2JzZXJ2ZWRfb24YBCABKAgSGwoTb2JzZXJ2ZWRfYnJpZ2h0bmVzcxgFIAEoBRIWCg5vYnNlcnZlZF9jb2xvchgGIAEoCRIdChVvYnNlcnZlZF9jb2xvcl90ZW1wX2sYByABKAUSEgoKZGVzaXJlZF9vbhgIIAEoCBIaChJkZXNpcmVkX2JyaWdodG5lc3MYCSABKAUSFQoNZGVzaXJlZF9jb2xvchgKIAEoCRIcChRkZXNpcmVkX2NvbG9yX3RlbXBfaxgLIAEoBRIQCghyZWFjdGl2ZRgMIAEoCBIUCgxmaXh0dXJlX3R5cGUYDSABKAkSDwoHcHJvZHVjdBgOIAEoCRINCgVtb2RlbBgPIAEoCRIRCglyZWFjaGFibGUYECABKAgSLwoLbGFzdF9zeW5jZWQYESABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjYKEmxhc3RfZW5hY3RfYXR0ZW1wdBgSIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEwoLZW5hY3RfZXJyb3IYEyABKAkSNAoPcGVuZGluZ19jaGFuZ2VzGBQgAygLMhsubHVtZW5ldGVzLnYxLlBlbmRpbmdDaGFuZ2UiOgoNUGVuZGluZ0NoYW5nZRINCgVmaWVsZBgBIAEoCRIMCgR3YW50GAIgASgJEgwKBGhhdmUYAyABKAkiEwoRTGlzdExpZ2h0c1JlcXVlc3QiOQoSTGlzdExpZ2h0c1Jlc3BvbnNlEiMKBmxpZ2h0cxgBIAMoCzITLmx1bWVuZXRlcy52MS5MaWdodCISChBMaXN0RHJpZnRSZXF1ZXN0IjgKEUxpc3REcmlmdFJlc3BvbnNlEiMKBmxpZ2h0cxgBIAMoCzITLmx1bWVuZXRlcy52MS5MaWdodDKtAQoMTGlnaHRTZXJ2aWNlEk8KCkxpc3RMaWdodHMSHy5sdW1lbmV0ZXMudjEuTGlzdExpZ2h0c1JlcXVlc3QaIC5sdW1lbmV0ZXMudjEuTGlzdExpZ2h0c1Jlc3BvbnNlEkwKCUxpc3REcmlmdBIeLmx1bWVuZXRlcy52MS5MaXN0RHJpZnRSZXF1ZXN0Gh8ubHVtZW5ldGVzLnYxLkxpc3REcmlmdFJlc3BvbnNlQj5aPGdpdGh1Yi5jb20vbGlhbWF3aGl0ZS9sdW1lbmV0ZXMvZ2VuL2x1bWVuZXRlcy92MTtsdW1lbmV0ZXN2MWIGcHJvdG8z", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message lumenetes.v1.Light
@@ -120,6 +120,14 @@ export type Light = Message<"lumenetes.v1.Light"> & {
    * @generated from field: string enact_error = 19;
    */
   enactError: string;
+
+  /**
+   * Fields where desired differs from observed as of the controller's last
+   * reconcile - recorded even in dry-run, where nothing is enacted.
+   *
+   * @generated from field: repeated lumenetes.v1.PendingChange pending_changes = 20;
+   */
+  pendingChanges: PendingChange[];
 };
 
 /**
@@ -129,6 +137,33 @@ export type Light = Message<"lumenetes.v1.Light"> & {
 export const LightSchema: GenMessage<Light> = /*@__PURE__*/
   messageDesc(file_lumenetes_v1_light, 0);
 
+/**
+ * @generated from message lumenetes.v1.PendingChange
+ */
+export type PendingChange = Message<"lumenetes.v1.PendingChange"> & {
+  /**
+   * @generated from field: string field = 1;
+   */
+  field: string;
+
+  /**
+   * @generated from field: string want = 2;
+   */
+  want: string;
+
+  /**
+   * @generated from field: string have = 3;
+   */
+  have: string;
+};
+
+/**
+ * Describes the message lumenetes.v1.PendingChange.
+ * Use `create(PendingChangeSchema)` to create a new message.
+ */
+export const PendingChangeSchema: GenMessage<PendingChange> = /*@__PURE__*/
+  messageDesc(file_lumenetes_v1_light, 1);
+
 /**
  * @generated from message lumenetes.v1.ListLightsRequest
  */
@@ -140,7 +175,7 @@ export type ListLightsRequest = Message<"lumenetes.v1.ListLightsRequest"> & {
  * Use `create(ListLightsRequestSchema)` to create a new message.
  */
 export const ListLightsRequestSchema: GenMessage<ListLightsRequest> = /*@__PURE__*/
-  messageDesc(file_lumenetes_v1_light, 1);
+  messageDesc(file_lumenetes_v1_light, 2);
 
 /**
  * @generated from message lumenetes.v1.ListLightsResponse
@@ -157,7 +192,39 @@ export type ListLightsResponse = Message<"lumenetes.v1.ListLightsResponse"> & {
  * Use `create(ListLightsResponseSchema)` to create a new message.
  */
 export const ListLightsResponseSchema: GenMessage<ListLightsResponse> = /*@__PURE__*/
-  messageDesc(file_lumenetes_v1_light, 2);
+  messageDesc(file_lumenetes_v1_light, 3);
+
+/**
+ * @generated from message lumenetes.v1.ListDriftRequest
+ */
+export type ListDriftRequest = Message<"lumenetes.v1.ListDriftRequest"> & {
+};
+
+/**
+ * Describes the message lumenetes.v1.ListDriftRequest.
+ * Use `create(ListDriftRequestSchema)` to create a new message.
+ */
+export const ListDriftRequestSchema: GenMessage<ListDriftRequest> = /*@__PURE__*/
+  messageDesc(file_lumenetes_v1_light, 4);
+
+/**
+ * @generated from message lumenetes.v1.ListDriftResponse
+ */
+export type ListDriftResponse = Message<"lumenetes.v1.ListDriftResponse"> & {
+  /**
+   * Only lights with at least one pending change.
+   *
+   * @generated from field: repeated lumenetes.v1.Light lights = 1;
+   */
+  lights: Light[];
+};
+
+/**
+ * Describes the message lumenetes.v1.ListDriftResponse.
+ * Use `create(ListDriftResponseSchema)` to create a new message.
+ */
+export const ListDriftResponseSchema: GenMessage<ListDriftResponse> = /*@__PURE__*/
+  messageDesc(file_lumenetes_v1_light, 5);
 
 /**
  * @generated from service lumenetes.v1.LightService
@@ -171,6 +238,17 @@ export const LightService: GenService<{
     input: typeof ListLightsRequestSchema;
     output: typeof ListLightsResponseSchema;
   },
+  /**
+   * ListDrift returns what the controller would change if it weren't (or
+   * isn't) in dry-run mode.
+   *
+   * @generated from rpc lumenetes.v1.LightService.ListDrift
+   */
+  listDrift: {
+    methodKind: "unary";
+    input: typeof ListDriftRequestSchema;
+    output: typeof ListDriftResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_lumenetes_v1_light, 0);
 
@@ -1,4 +1,4 @@
-import { useQuery } from "@tanstack/react-query";
+import { useMutation, useQuery, useQueryClient } from "@tanstack/react-query";
 
 import { groupClient } from "./client";
 import type { Group } from "@/gen/lumenetes/v1/group_pb";
@@ -15,3 +15,14 @@ export function useGroups() {
     refetchInterval: 15_000,
   });
 }
+
+// setAway is the UI's only write - refetching "groups" straight after
+// picks up the new Spec.Away rather than waiting out refetchInterval.
+export function useSetAway() {
+  const queryClient = useQueryClient();
+  return useMutation({
+    mutationFn: async ({ groupId, enabled }: { groupId: string; enabled: boolean }) =>
+      (await groupClient.setAway({ groupId, enabled })).group,
+    onSuccess: () => queryClient.invalidateQueries({ queryKey: ["groups"] }),
+  });
+}
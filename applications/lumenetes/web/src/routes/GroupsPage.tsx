@@ -1,10 +1,12 @@
-import { useGroups } from "@/lib/groups";
+import { useGroups, useSetAway } from "@/lib/groups";
 import { activeSceneKindLabel } from "@/lib/format";
 import { Badge } from "@/components/ui/badge";
+import { Button } from "@/components/ui/button";
 import { Card, CardHeader, CardTitle, CardContent } from "@/components/ui/card";
 
 export function GroupsPage() {
   const { data: groups, isLoading, isError, error } = useGroups();
+  const setAway = useSetAway();
 
   return (
     <div className="mx-auto flex w-full max-w-5xl flex-1 flex-col gap-4 p-4">
@@ -12,6 +14,7 @@ export function GroupsPage() {
 
       {isLoading && <p className="text-sm text-muted-foreground">Loading groups…</p>}
       {isError && <p className="text-sm text-destructive">{error.message}</p>}
+      {setAway.isError && <p className="text-sm text-destructive">{setAway.error.message}</p>}
 
       <div className="grid grid-cols-1 gap-4 sm:grid-cols-2">
         {groups?.map((group) => (
@@ -19,9 +22,13 @@ export function GroupsPage() {
             <CardHeader>
               <CardTitle className="flex items-center justify-between">
                 <span>{group.id}</span>
-                <Badge variant={group.activeScene ? "default" : "outline"}>
-                  {group.activeScene ? activeSceneKindLabel(group.activeScene.kind) : "Unmanaged"}
-                </Badge>
+                {group.away?.enabled ? (
+                  <Badge variant="secondary">Away</Badge>
+                ) : (
+                  <Badge variant={group.activeScene ? "default" : "outline"}>
+                    {group.activeScene ? activeSceneKindLabel(group.activeScene.kind) : "Unmanaged"}
+                  </Badge>
+                )}
               </CardTitle>
             </CardHeader>
             <CardContent className="flex flex-col gap-2 text-sm">
@@ -35,6 +42,15 @@ export function GroupsPage() {
                 </Badge>
               )}
               {group.activeSceneError && <Badge variant="destructive">{group.activeSceneError}</Badge>}
+              <Button
+                variant={group.away?.enabled ? "default" : "outline"}
+                size="sm"
+                className="self-start"
+                disabled={setAway.isPending}
+                onClick={() => setAway.mutate({ groupId: group.id, enabled: !group.away?.enabled })}
+              >
+                {group.away?.enabled ? "Disable away mode" : "Enable away mode"}
+              </Button>
             </CardContent>
           </Card>
         ))}
@@ -8,14 +8,10 @@ import { ColorSwatch } from "@/components/ColorSwatch";
 import { Table, TableBody, TableCell, TableHead, TableHeader, TableRow } from "@/components/ui/table";
 import type { Light } from "@/gen/lumenetes/v1/light_pb";
 
-function hasDrift(light: Light): boolean {
-  if (light.reactive) return false;
-  return (
-    light.desiredOn !== light.observedOn ||
-    light.desiredBrightness !== light.observedBrightness ||
-    light.desiredColor !== light.observedColor ||
-    light.desiredColorTempK !== light.observedColorTempK
-  );
+// The controller's own diff (with its color/colorTempK tolerances) rather
+// than a naive desired-vs-observed comparison here.
+function describeDrift(light: Light): string {
+  return light.pendingChanges.map((c) => `${c.field}: ${c.have} → ${c.want}`).join("\n");
 }
 
 export function LightsPage() {
@@ -67,7 +63,11 @@ export function LightsPage() {
                           Enact error
                         </Badge>
                       ) : (
-                        hasDrift(light) && <Badge variant="outline">Pending</Badge>
+                        light.pendingChanges.length > 0 && (
+                          <Badge variant="outline" title={describeDrift(light)}>
+                            Pending
+                          </Badge>
+                        )
                       )}
                     </div>
                   </TableCell>
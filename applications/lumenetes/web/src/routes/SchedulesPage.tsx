@@ -1,11 +1,14 @@
 import { useSchedules } from "@/lib/schedules";
 import { formatBrightness, formatColorTempK } from "@/lib/format";
-import { CircadianAnchor, CircadianOnState } from "@/gen/lumenetes/v1/circadian_schedule_pb";
+import { CircadianAnchor, CircadianMode, CircadianOnState, CircadianWeekday } from "@/gen/lumenetes/v1/circadian_schedule_pb";
+import type { CircadianKeyframe } from "@/gen/lumenetes/v1/circadian_schedule_pb";
 import { Badge } from "@/components/ui/badge";
 import { Card, CardHeader, CardTitle, CardContent } from "@/components/ui/card";
 
-function anchorLabel(anchor: CircadianAnchor): string {
-  switch (anchor) {
+function anchorLabel(kf: CircadianKeyframe): string {
+  switch (kf.anchor) {
+    case CircadianAnchor.CLOCK_TIME:
+      return kf.time;
     case CircadianAnchor.SUNRISE:
       return "sunrise";
     case CircadianAnchor.SOLAR_NOON:
@@ -14,6 +17,18 @@ function anchorLabel(anchor: CircadianAnchor): string {
       return "sunset";
     case CircadianAnchor.SOLAR_MIDNIGHT:
       return "solar midnight";
+    case CircadianAnchor.CIVIL_DAWN:
+      return "civil dawn";
+    case CircadianAnchor.CIVIL_DUSK:
+      return "civil dusk";
+    case CircadianAnchor.NAUTICAL_DAWN:
+      return "nautical dawn";
+    case CircadianAnchor.NAUTICAL_DUSK:
+      return "nautical dusk";
+    case CircadianAnchor.ASTRONOMICAL_DAWN:
+      return "astronomical dawn";
+    case CircadianAnchor.ASTRONOMICAL_DUSK:
+      return "astronomical dusk";
     default:
       return "unknown";
   }
@@ -36,6 +51,35 @@ function onLabel(on: CircadianOnState): string {
   }
 }
 
+const weekdayLabels: Record<CircadianWeekday, string> = {
+  [CircadianWeekday.UNSPECIFIED]: "?",
+  [CircadianWeekday.MONDAY]: "Mon",
+  [CircadianWeekday.TUESDAY]: "Tue",
+  [CircadianWeekday.WEDNESDAY]: "Wed",
+  [CircadianWeekday.THURSDAY]: "Thu",
+  [CircadianWeekday.FRIDAY]: "Fri",
+  [CircadianWeekday.SATURDAY]: "Sat",
+  [CircadianWeekday.SUNDAY]: "Sun",
+};
+
+function KeyframeList({ keyframes }: { keyframes: CircadianKeyframe[] }) {
+  return (
+    <ul className="list-inside list-disc text-muted-foreground">
+      {keyframes.map((kf, i) => (
+        <li key={i}>
+          {anchorLabel(kf)}
+          {offsetLabel(kf.offsetMinutes)}: {formatBrightness(kf.brightness)}, {formatColorTempK(kf.colorTempK)}
+          {onLabel(kf.on)}
+        </li>
+      ))}
+    </ul>
+  );
+}
+
+function elevationLabel(degrees: number): string {
+  return `${degrees > 0 ? "+" : ""}${degrees}°`;
+}
+
 export function SchedulesPage() {
   const { data: schedules, isLoading, isError, error } = useSchedules();
 
@@ -63,16 +107,33 @@ export function SchedulesPage() {
               </CardTitle>
             </CardHeader>
             <CardContent className="flex flex-col gap-2 text-sm">
-              <p className="text-muted-foreground">Group: {schedule.group}</p>
-              <ul className="list-inside list-disc text-muted-foreground">
-                {schedule.keyframes.map((kf, i) => (
-                  <li key={i}>
-                    {anchorLabel(kf.anchor)}
-                    {offsetLabel(kf.offsetMinutes)}: {formatBrightness(kf.brightness)}, {formatColorTempK(kf.colorTempK)}
-                    {onLabel(kf.on)}
-                  </li>
-                ))}
-              </ul>
+              <p className="text-muted-foreground">
+                Group: {schedule.group}
+                {schedule.timeZone && ` · ${schedule.timeZone}`}
+              </p>
+              {schedule.mode === CircadianMode.ELEVATION ? (
+                <ul className="list-inside list-disc text-muted-foreground">
+                  {schedule.elevation
+                    .slice()
+                    .sort((a, b) => a.degrees - b.degrees)
+                    .map((point, i) => (
+                      <li key={i}>
+                        sun at {elevationLabel(point.degrees)}: {formatBrightness(point.brightness)},{" "}
+                        {formatColorTempK(point.colorTempK)}
+                      </li>
+                    ))}
+                </ul>
+              ) : (
+                <>
+                  {schedule.keyframes.length > 0 && <KeyframeList keyframes={schedule.keyframes} />}
+                  {schedule.variants.map((variant, i) => (
+                    <div key={i} className="flex flex-col gap-1">
+                      <p className="font-medium">{variant.days.map((day) => weekdayLabels[day]).join(", ")}</p>
+                      <KeyframeList keyframes={variant.keyframes} />
+                    </div>
+                  ))}
+                </>
+              )}
             </CardContent>
           </Card>
         ))}
@@ -13,9 +13,29 @@ import (
 var bootstrapCmd = &cobra.Command{
 	Use:   "bootstrap",
 	Short: "Provision a Raspberry Pi node",
-	Long: `Provisions a Raspberry Pi node with required boot configuration and packages.
+	Long: `Provisions a Raspberry Pi node with its hostname (the node's infra.yaml
+name), required boot configuration and packages.
 
 This prepares the Pi for K3s installation but does not install K3s.
+
+The boot files written are the base config.txt/eeprom.conf plus the node's
+own boot overrides from its infra.yaml entry, e.g.:
+
+  boot:
+    configTxt: ["arm_freq=2800"]
+    eeprom: {PSU_MAX_CURRENT: "5000"}
+    cmdline: ["usbcore.autosuspend=-1"]
+
+A node with a network section also gets its address configured statically
+through NetworkManager, rather than relying on a DHCP reservation:
+
+  network:
+    prefix: 24
+    gateway: 192.168.1.1
+    dns: [192.168.1.1]
+
+The change is reverted automatically on the node if a fresh SSH login
+can't confirm it within a few minutes.
 After bootstrapping, use the 'k3s' command to install K3s.
 
 Configuration can be provided via:
@@ -44,20 +64,12 @@ func runBootstrap(cmd *cobra.Command, args []string) error {
 	slog.Info("Starting Raspberry Pi provisioning", "node", cfg.Node)
 
 	// Create SSH client
-	var authMethod string
-	if cfg.SSHPassword != "" {
-		authMethod = "password"
-	} else {
-		authMethod = "key"
-	}
-
-	slog.Info("Creating SSH connection", "node", cfg.Node, "user", cfg.SSHUser, "auth_method", authMethod, "password_provided", cfg.SSHPassword != "")
+	slog.Info("Creating SSH connection", "node", cfg.Node, "user", cfg.SSH.User, "auth_methods", cfg.SSH.Credentials().Methods())
 
-	var client *ssh.Client
-	client = ssh.NewClientWithPassword(cfg.Node, cfg.SSHUser, cfg.SSHPassword)
+	client := ssh.NewClient(cfg.Node, cfg.SSH.Credentials())
 
 	if err := client.Connect(ctx); err != nil {
-		slog.Error("SSH connection failed", "node", cfg.Node, "user", cfg.SSHUser, "auth_method", authMethod, "error", err)
+		slog.Error("SSH connection failed", "node", cfg.Node, "user", cfg.SSH.User, "error", err)
 		return err
 	}
 	defer client.Close()
@@ -66,7 +78,7 @@ func runBootstrap(cmd *cobra.Command, args []string) error {
 
 	// Provision Raspberry Pi
 	slog.Info("Provisioning Raspberry Pi")
-	provisioner := raspberry.NewProvisioner(client)
+	provisioner := raspberry.NewProvisioner(client, cfg.Entry)
 	if err := provisioner.Provision(ctx); err != nil {
 		slog.Error("Failed to provision Raspberry Pi", "error", err)
 		return err
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var clusterCmd = &cobra.Command{
+	Use:   "cluster",
+	Short: "Manage the cluster as a whole",
+}
+
+func init() {
+	clusterCmd.AddCommand(clusterCreateCmd)
+	clusterCmd.AddCommand(clusterShutdownCmd)
+	clusterCmd.AddCommand(clusterStartCmd)
+}
+
+// waitUntil polls check every interval until it reports done, giving up
+// once timeout has passed. A check error isn't fatal - the cluster is
+// expected to be unreachable for a while - it's just logged, and returned
+// if it's still failing at the deadline. what describes the wait in logs
+// and errors.
+func waitUntil(what string, timeout, interval time.Duration, check func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		done, err := check()
+		if err == nil && done {
+			slog.Info("Done waiting", "for", what)
+			return nil
+		}
+		if time.Now().After(deadline) {
+			if err != nil {
+				return fmt.Errorf("timed out after %s waiting for %s: %w", timeout, what, err)
+			}
+			return fmt.Errorf("timed out after %s waiting for %s", timeout, what)
+		}
+		if err != nil {
+			slog.Info("Waiting", "for", what, "error", err)
+		} else {
+			slog.Info("Waiting", "for", what)
+		}
+		time.Sleep(interval)
+	}
+}
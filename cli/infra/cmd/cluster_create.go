@@ -0,0 +1,264 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+
+	pulumicmd "github.com/liamawhite/homelab/cli/infra/cmd/pulumi"
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/liamawhite/homelab/pkg/k3s"
+	"github.com/liamawhite/homelab/pkg/kubeconfig"
+	"github.com/liamawhite/homelab/pkg/probe"
+	"github.com/liamawhite/homelab/pkg/raspberry"
+	"github.com/liamawhite/homelab/pkg/ssh"
+	"github.com/spf13/cobra"
+)
+
+var clusterCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Bring up the whole cluster from infra.yaml",
+	Long: `Creates the cluster described by infra.yaml end to end, running the same
+building blocks as the individual commands, in order:
+
+  1. bootstrap every node
+  2. install k3s on the first server with --cluster-init, then join the
+     remaining servers and finally the agents to it
+  3. merge the cluster's kubeconfig (as "kubeconfig" does)
+  4. deploy everything else ("up")
+
+Flannel is disabled on each node before k3s first starts, so Cilium -
+installed by "up" - is the cluster's only CNI from the outset. A node that
+already runs k3s with Flannel has it disabled in place instead, and every
+pod is recreated once Cilium is up, as in the manual migration (see
+pkg/components/cilium).
+
+It's resumable: a node already bootstrapped or running k3s is skipped, and
+the cluster-wide steps that finish are recorded in .cluster-create.json
+next to infra.yaml (removed once the whole run succeeds), so after a
+failure just run it again.
+
+The first "up" installs everything at once, so it likely needs a larger
+--timeout than the default.
+
+Example:
+  homelab cluster create --timeout 20m`,
+	RunE: runClusterCreate,
+}
+
+func runClusterCreate(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	configFile, err := config.ResolveConfigPath(cmd)
+	if err != nil {
+		return err
+	}
+	infraCfg, err := config.LoadFromFile(configFile)
+	if err != nil {
+		return err
+	}
+	if infraCfg.Cluster.VIP == "" {
+		return fmt.Errorf("cluster.vip is not set in infra.yaml")
+	}
+
+	progress, err := loadCreateProgress(filepath.Join(filepath.Dir(configFile), ".cluster-create.json"))
+	if err != nil {
+		return err
+	}
+
+	// Servers first - the first of them initializes the cluster, and kube-vip
+	// (deployed by "up") isn't serving the VIP yet, so everything else joins
+	// through its address directly.
+	servers := infraCfg.Servers()
+	if len(servers) == 0 {
+		return fmt.Errorf("no server nodes defined in infra.yaml")
+	}
+	initNode := servers[0]
+	serverURL := fmt.Sprintf("https://%s:6443", initNode.Address)
+
+	var agents []config.NodeConfig
+	for _, node := range infraCfg.Nodes {
+		if !node.IsServer() {
+			agents = append(agents, node)
+		}
+	}
+
+	token := infraCfg.Cluster.Token
+	for _, node := range append(servers, agents...) {
+		clusterInit := node.Name == initNode.Name
+		if !clusterInit && token == "" {
+			token, err = fetchAndSaveClusterToken(ctx, &config.Config{InfraConfig: infraCfg, ConfigFile: configFile, ServerURL: serverURL})
+			if err != nil {
+				return fmt.Errorf("failed to fetch the cluster token: %w", err)
+			}
+		}
+		if err := createNode(ctx, infraCfg, node, clusterInit, serverURL, token, progress); err != nil {
+			return fmt.Errorf("%s: %w", node.Name, err)
+		}
+	}
+
+	if err := progress.run("kubeconfig", func() error {
+		return mergeClusterKubeconfig(ctx, infraCfg, initNode)
+	}); err != nil {
+		return err
+	}
+
+	if err := progress.run("up", func() error {
+		return pulumicmd.Up(cmd, false)
+	}); err != nil {
+		return err
+	}
+
+	if progress.RecreatePods {
+		if err := progress.run("recreate-pods", func() error {
+			api, err := clusterAPIClient(ctx, infraCfg)
+			if err != nil {
+				return err
+			}
+			return api.RecreatePodNetworkPods(ctx)
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := progress.remove(); err != nil {
+		return err
+	}
+	slog.Info("Cluster created", "nodes", len(infraCfg.Nodes), "vip", infraCfg.Cluster.VIP)
+	return nil
+}
+
+// createNode bootstraps node and installs k3s on it, skipping whichever of
+// the two is already done.
+func createNode(ctx context.Context, infraCfg *config.InfraConfig, node config.NodeConfig, clusterInit bool, serverURL, token string, progress *createProgress) error {
+	client := ssh.NewClient(node.Address, node.SSH.Credentials())
+	if err := client.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+	defer client.Close()
+
+	provisioner := raspberry.NewProvisioner(client, node)
+	if provisioner.CheckBootstrapped().Bootstrapped() {
+		slog.Info("Already bootstrapped, skipping", "node", node.Name)
+	} else {
+		slog.Info("Bootstrapping", "node", node.Name)
+		if err := provisioner.Provision(ctx); err != nil {
+			return err
+		}
+	}
+
+	if !probe.SSH(node.Address, node.SSH.Credentials()).K3sInstalled {
+		if err := k3s.DisableFlannelBeforeInstall(client); err != nil {
+			return err
+		}
+		slog.Info("Installing K3s", "node", node.Name, "role", node.Role, "cluster_init", clusterInit)
+		var joinURL string
+		if !clusterInit {
+			joinURL = serverURL
+		}
+		return k3s.NewInstaller(client, node.Role, infraCfg.Cluster.SANs).InstallK3s(ctx, clusterInit, joinURL, token)
+	}
+
+	slog.Info("K3s already installed, skipping", "node", node.Name)
+	if k3s.FlannelDisabled(client) {
+		return nil
+	}
+
+	slog.Info("K3s is running Flannel, disabling it", "node", node.Name)
+	if err := k3s.DisableFlannel(client); err != nil {
+		return err
+	}
+	progress.RecreatePods = true
+	return progress.save()
+}
+
+// mergeClusterKubeconfig merges the cluster's kubeconfig, pointed at the
+// VIP, into the default kubeconfig - as the "kubeconfig" command does, but
+// extracted from node directly, since the VIP isn't served until "up" has
+// deployed kube-vip.
+func mergeClusterKubeconfig(ctx context.Context, infraCfg *config.InfraConfig, node config.NodeConfig) error {
+	client := ssh.NewClient(node.Address, node.SSH.Credentials())
+	if err := client.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", node.Name, err)
+	}
+	defer client.Close()
+
+	extracted, err := k3s.ExtractKubeconfig(ctx, client, infraCfg.Cluster.VIP)
+	if err != nil {
+		return err
+	}
+
+	target, err := kubeconfig.DefaultPath()
+	if err != nil {
+		return fmt.Errorf("failed to determine default kubeconfig path: %w", err)
+	}
+	slog.Info("Merging kubeconfig", "path", target, "context", kubeconfig.ContextName)
+	return k3s.MergeKubeconfig(extracted, target, kubeconfig.ContextName)
+}
+
+// createProgress records which of "cluster create"'s cluster-wide steps
+// have finished, for the ones that can't just be checked on the nodes
+// themselves.
+type createProgress struct {
+	path string
+
+	Done []string `json:"done"`
+	// RecreatePods is set once Flannel has been disabled on a node that was
+	// already running it, so its pods need recreating after "up".
+	RecreatePods bool `json:"recreatePods,omitempty"`
+}
+
+// loadCreateProgress reads the progress recorded at path by an earlier,
+// unfinished run, if there is one.
+func loadCreateProgress(path string) (*createProgress, error) {
+	p := &createProgress{path: path}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	slog.Info("Resuming from an earlier run", "completed", p.Done)
+	return p, nil
+}
+
+// run runs fn as step, unless an earlier run already completed it,
+// recording it as done if it succeeds.
+func (p *createProgress) run(step string, fn func() error) error {
+	if slices.Contains(p.Done, step) {
+		slog.Info("Already done, skipping", "step", step)
+		return nil
+	}
+	slog.Info("Running step", "step", step)
+	if err := fn(); err != nil {
+		return fmt.Errorf("%s: %w", step, err)
+	}
+	p.Done = append(p.Done, step)
+	return p.save()
+}
+
+func (p *createProgress) save() error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(p.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to record progress in %s: %w", p.path, err)
+	}
+	return nil
+}
+
+func (p *createProgress) remove() error {
+	if err := os.Remove(p.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove %s: %w", p.path, err)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/liamawhite/homelab/pkg/ssh"
+	"github.com/spf13/cobra"
+)
+
+var clusterShutdownCmd = &cobra.Command{
+	Use:   "shutdown",
+	Short: "Gracefully power off the whole cluster",
+	Long: `Powers off every node, in an order that leaves Longhorn volumes and etcd
+consistent - e.g. ahead of a planned power cut:
+
+  1. scale every Deployment and StatefulSet outside --keep-namespace to zero
+     (recording their replica counts on them for "cluster start")
+  2. wait for every Longhorn volume to detach, so no replica is mid-write
+     when its node goes down
+  3. power off the agents, then the servers one at a time in reverse
+     infra.yaml order, waiting for each to go down - so the first server,
+     the one "cluster create" initialized the cluster on, is the last etcd
+     member standing and holds its latest state
+
+Bring it back with "homelab cluster start" once the nodes are powered on.
+
+Example:
+  homelab cluster shutdown --yes`,
+	RunE: runClusterShutdown,
+}
+
+func init() {
+	clusterShutdownCmd.Flags().StringSlice("keep-namespace", []string{"kube-system", "longhorn-system", "istio-system"}, "Namespaces whose workloads are left running until the nodes power off")
+	clusterShutdownCmd.Flags().Duration("detach-timeout", 10*time.Minute, "How long to wait for Longhorn volumes to detach")
+	clusterShutdownCmd.Flags().Duration("poweroff-timeout", 3*time.Minute, "How long to wait for each node to go down")
+	clusterShutdownCmd.Flags().Bool("yes", false, "Confirm that every node will be powered off")
+}
+
+func runClusterShutdown(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if yes, _ := cmd.Flags().GetBool("yes"); !yes {
+		return fmt.Errorf("this powers off every node in the cluster - re-run with --yes to confirm")
+	}
+	keep, _ := cmd.Flags().GetStringSlice("keep-namespace")
+	detachTimeout, _ := cmd.Flags().GetDuration("detach-timeout")
+	poweroffTimeout, _ := cmd.Flags().GetDuration("poweroff-timeout")
+
+	infraCfg, err := config.LoadInfra(cmd)
+	if err != nil {
+		return err
+	}
+	if infraCfg.Cluster.VIP == "" {
+		return fmt.Errorf("cluster.vip is not set in infra.yaml")
+	}
+
+	api, err := clusterAPIClient(ctx, infraCfg)
+	if err != nil {
+		return err
+	}
+
+	n, err := api.ScaleDownWorkloads(ctx, keep)
+	if err != nil {
+		return err
+	}
+	slog.Info("Workloads scaled down", "count", n, "kept_namespaces", keep)
+
+	var attached []string
+	err = waitUntil("Longhorn volumes to detach", detachTimeout, 10*time.Second, func() (bool, error) {
+		attached, err = api.AttachedLonghornVolumes(ctx)
+		return len(attached) == 0, err
+	})
+	if err != nil {
+		return fmt.Errorf("%w (still attached: %s)", err, strings.Join(attached, ", "))
+	}
+
+	for _, node := range shutdownOrder(infraCfg) {
+		if err := powerOffNode(ctx, node, poweroffTimeout); err != nil {
+			return fmt.Errorf("%s: %w", node.Name, err)
+		}
+	}
+
+	slog.Info("Cluster shut down", "nodes", len(infraCfg.Nodes))
+	return nil
+}
+
+// shutdownOrder returns infra.yaml's nodes in the order to power them
+// off: agents first, then servers in reverse, ending with the first.
+func shutdownOrder(infraCfg *config.InfraConfig) []config.NodeConfig {
+	var order []config.NodeConfig
+	for _, node := range infraCfg.Nodes {
+		if !node.IsServer() {
+			order = append(order, node)
+		}
+	}
+	servers := infraCfg.Servers()
+	slices.Reverse(servers)
+	return append(order, servers...)
+}
+
+// powerOffNode powers node off over SSH and waits for its SSH port to
+// stop answering.
+func powerOffNode(ctx context.Context, node config.NodeConfig, timeout time.Duration) error {
+	slog.Info("Powering off", "node", node.Name, "role", node.Role)
+
+	client := ssh.NewClient(node.Address, node.SSH.Credentials())
+	if err := client.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+	err := client.PowerOff()
+	client.Close()
+	if err != nil {
+		return fmt.Errorf("failed to power off: %w", err)
+	}
+
+	return waitUntil(node.Name+" to power off", timeout, 5*time.Second, func() (bool, error) {
+		conn, err := net.DialTimeout("tcp", net.JoinHostPort(node.Address, "22"), 2*time.Second)
+		if err != nil {
+			return true, nil
+		}
+		conn.Close()
+		return false, nil
+	})
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/liamawhite/homelab/pkg/k3s"
+	"github.com/liamawhite/homelab/pkg/probe"
+	"github.com/spf13/cobra"
+)
+
+var clusterStartCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Bring the cluster back after a shutdown",
+	Long: `Brings the cluster back after "homelab cluster shutdown", once the nodes
+have been powered on:
+
+  1. wait for every node to answer over SSH
+  2. wait for etcd to have quorum again
+  3. wait for every Node to be Ready, and every Longhorn node's manager to
+     be up, so volumes can attach with all their replicas
+  4. scale the workloads "cluster shutdown" scaled down back to their
+     recorded replica counts
+
+Example:
+  homelab cluster start`,
+	RunE: runClusterStart,
+}
+
+func init() {
+	clusterStartCmd.Flags().Duration("ready-timeout", 15*time.Minute, "How long to wait for each stage of the cluster to come back")
+}
+
+func runClusterStart(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	readyTimeout, _ := cmd.Flags().GetDuration("ready-timeout")
+
+	infraCfg, err := config.LoadInfra(cmd)
+	if err != nil {
+		return err
+	}
+	if infraCfg.Cluster.VIP == "" {
+		return fmt.Errorf("cluster.vip is not set in infra.yaml")
+	}
+
+	err = waitUntil("every node to answer over SSH", readyTimeout, 10*time.Second, func() (bool, error) {
+		var down []string
+		for _, node := range infraCfg.Nodes {
+			if !probe.SSH(node.Address, node.SSH.Credentials()).Authenticated {
+				down = append(down, node.Name)
+			}
+		}
+		if len(down) > 0 {
+			return false, fmt.Errorf("not up yet: %s", strings.Join(down, ", "))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var api *k3s.APIClient
+	err = waitUntil("etcd quorum", readyTimeout, 10*time.Second, func() (bool, error) {
+		if api == nil {
+			if api, err = clusterAPIClient(ctx, infraCfg); err != nil {
+				return false, err
+			}
+		}
+		return k3s.EtcdReady(ctx, api), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = waitUntil("every node to be Ready", readyTimeout, 10*time.Second, func() (bool, error) {
+		var notReady []string
+		for _, node := range infraCfg.Nodes {
+			current, err := api.GetNode(ctx, node.Name)
+			if err != nil {
+				return false, err
+			}
+			if !current.Ready {
+				notReady = append(notReady, node.Name)
+			}
+		}
+		if len(notReady) > 0 {
+			return false, fmt.Errorf("not Ready yet: %s", strings.Join(notReady, ", "))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = waitUntil("Longhorn to be healthy", readyTimeout, 10*time.Second, func() (bool, error) {
+		unready, err := api.UnreadyLonghornNodes(ctx)
+		if err != nil {
+			return false, err
+		}
+		if len(unready) > 0 {
+			return false, fmt.Errorf("Longhorn not ready on: %s", strings.Join(unready, ", "))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	n, err := api.ScaleUpWorkloads(ctx)
+	if err != nil {
+		return err
+	}
+	slog.Info("Cluster started", "workloads_scaled_up", n)
+	return nil
+}
@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Read and edit infra.yaml",
+	Long: `Reads and edits infra.yaml by path, keeping its comments.
+
+Paths are dotted keys, with list items picked by index or by the value of
+one of their keys:
+
+  cluster.vip
+  cluster.sans[0]
+  nodes[name=pi-0].ssh.user
+  lumenetes.hue.bridges[id=001788fffe123456].appKey
+
+Edits are checked the same way infra.yaml is when it's loaded, and only
+written if the result is valid.`,
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check infra.yaml, reporting every problem",
+	Long: `Checks infra.yaml against its JSON Schema (unknown keys, wrong types, value
+formats like cloudflare.access.teamDomain, tailscale.magicDnsSuffix and Hue
+bridge IDs), the checks loading it always runs, and stricter cross-field
+checks loading it doesn't:
+
+  - no two nodes share an address
+  - no Hue bridge is paired twice
+  - ghcr.username and ghcr.token are set if "up" will build images
+
+Every problem is reported, with its line number, rather than just the first.
+Exits non-zero if there are any.
+
+Example:
+  homelab config validate
+  homelab config validate --config ./infra.yaml`,
+	Args: cobra.NoArgs,
+	RunE: runConfigValidate,
+}
+
+var configSchemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Print the JSON Schema for infra.yaml",
+	Long: `Prints the JSON Schema for infra.yaml, generated from the config structs.
+infra.schema.json at the repo root is this (regenerated by "make gen"); point
+your editor at it for autocompletion, e.g. with yaml-language-server:
+
+  # yaml-language-server: $schema=./infra.schema.json
+
+Example:
+  homelab config schema > infra.schema.json`,
+	Args: cobra.NoArgs,
+	RunE: runConfigSchema,
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get <path>",
+	Short: "Print the value at a path",
+	Long: `Prints the value at a path: a plain value as-is, anything else as YAML.
+
+Example:
+  homelab config get cluster.vip
+  homelab config get 'nodes[name=pi-0]'`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfigGet,
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <path> <value>",
+	Short: "Set the value at a path",
+	Long: `Sets the value at a path, creating any missing parents along the way. A
+list item picked by key that doesn't exist yet is added, holding that key;
+one picked by index can only be added one past the end.
+
+The value is parsed as YAML, so 22 is a number, true a bool and [a, b] a
+list. Use --string to store it as a string regardless.
+
+Example:
+  homelab config set cluster.vip 192.168.1.100
+  homelab config set 'nodes[name=pi-3].role' agent
+  homelab config set 'nodes[name=pi-0].ssh.port' 2222
+  homelab config set cluster.token --string 0123`,
+	Args: cobra.ExactArgs(2),
+	RunE: runConfigSet,
+}
+
+var configUnsetCmd = &cobra.Command{
+	Use:   "unset <path>",
+	Short: "Remove the value at a path",
+	Long: `Removes the key or list item at a path.
+
+Example:
+  homelab config unset 'nodes[name=pi-0].mac'
+  homelab config unset 'nodes[name=pi-3]'`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfigUnset,
+}
+
+func init() {
+	configSetCmd.Flags().Bool("string", false, "Store the value as a string rather than parsing it as YAML")
+
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configUnsetCmd)
+	configCmd.AddCommand(configValidateCmd)
+	configCmd.AddCommand(configSchemaCmd)
+}
+
+// openConfigEditor opens the resolved infra.yaml for editing.
+func openConfigEditor(cmd *cobra.Command) (*config.Editor, error) {
+	configFile, err := config.ResolveConfigPath(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return config.OpenEditor(configFile)
+}
+
+func runConfigGet(cmd *cobra.Command, args []string) error {
+	e, err := openConfigEditor(cmd)
+	if err != nil {
+		return err
+	}
+	node, err := e.Get(args[0])
+	if err != nil {
+		return err
+	}
+	if node == nil {
+		return fmt.Errorf("%s not found", args[0])
+	}
+
+	if node.Kind == yaml.ScalarNode {
+		fmt.Println(node.Value)
+		return nil
+	}
+	out, err := yaml.Marshal(node)
+	if err != nil {
+		return err
+	}
+	fmt.Print(string(out))
+	return nil
+}
+
+func runConfigSet(cmd *cobra.Command, args []string) error {
+	e, err := openConfigEditor(cmd)
+	if err != nil {
+		return err
+	}
+
+	asString, _ := cmd.Flags().GetBool("string")
+	if asString {
+		err = e.SetString(args[0], args[1])
+	} else {
+		value, parseErr := config.ParseValue(args[1])
+		if parseErr != nil {
+			return parseErr
+		}
+		err = e.Set(args[0], value)
+	}
+	if err != nil {
+		return err
+	}
+	return e.Save()
+}
+
+func runConfigUnset(cmd *cobra.Command, args []string) error {
+	e, err := openConfigEditor(cmd)
+	if err != nil {
+		return err
+	}
+	if err := e.Unset(args[0]); err != nil {
+		return err
+	}
+	return e.Save()
+}
+
+func runConfigValidate(cmd *cobra.Command, args []string) error {
+	configFile, err := config.ResolveConfigPath(cmd)
+	if err != nil {
+		return err
+	}
+	problems, err := config.ValidateFile(configFile)
+	if err != nil {
+		return err
+	}
+	if len(problems) == 0 {
+		fmt.Printf("%s is valid\n", configFile)
+		return nil
+	}
+
+	for _, p := range problems {
+		if p.Line > 0 {
+			fmt.Printf("%s:%d: %s\n", configFile, p.Line, p.Message)
+		} else {
+			fmt.Printf("%s: %s\n", configFile, p.Message)
+		}
+	}
+	return fmt.Errorf("%d problems in %s", len(problems), configFile)
+}
+
+func runConfigSchema(cmd *cobra.Command, args []string) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(config.InfraSchema())
+}
@@ -0,0 +1,298 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/liamawhite/homelab/pkg/k3s"
+	"github.com/liamawhite/homelab/pkg/ssh"
+	"github.com/spf13/cobra"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Diagnose known node and cluster problems, optionally fixing them",
+	Long: `Checks every node (or just --node) over SSH, and the cluster through the
+Kubernetes API, for failure signatures we've hit before:
+
+  Node checks:
+    k3s-service  the k3s/k3s-agent unit has failed
+                 fix: reset and restart it
+    cni-ipam     stale host-local IPAM allocations under /var/lib/cni/networks,
+                 which end in pods failing with "no IP addresses available
+                 in range set"
+                 fix: delete just the stale allocation files
+    certs        a k3s certificate expires within --cert-warn (or already has)
+                 fix: restart k3s, which renews certificates nearing expiry
+
+  Cluster checks:
+    cilium       Cilium agent pods that aren't Ready
+                 fix: delete them, for their DaemonSet to replace
+    longhorn     attached Longhorn volumes that are degraded or faulted
+                 (no automatic fix - Longhorn rebuilds replicas itself once
+                 it has somewhere to put them)
+    tailscale    tailscale Ingresses with no ready proxy
+                 fix: restart the tailscale-operator so it reconciles them
+
+Every check reports ok, warn, fail or skip (it couldn't run, e.g. k3s isn't
+installed). With --fix, each failing check that has a safe fix gets it
+applied, one at a time. Exits non-zero if any check is still failing.
+
+Example:
+  homelab doctor
+  homelab doctor --node pi-1 --fix`,
+	RunE: runDoctor,
+}
+
+func init() {
+	doctorCmd.Flags().String("node", "", "Only check this node from infra.yaml (cluster checks still run)")
+	doctorCmd.Flags().Bool("fix", false, "Apply the safe fix for each failing check")
+	doctorCmd.Flags().Duration("cert-warn", 30*24*time.Hour, "Warn about k3s certificates expiring within this long")
+}
+
+// Doctor check outcomes.
+const (
+	doctorOK   = "ok"
+	doctorWarn = "warn"
+	doctorFail = "fail"
+	doctorSkip = "skip"
+)
+
+// doctorResult is one check's outcome against one target (a node, or the
+// cluster).
+type doctorResult struct {
+	Check  string
+	Target string
+	Status string
+	Detail string
+	// fix applies the check's safe remediation, or is nil if it has none.
+	fix   func() error
+	fixed bool
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	// cli/pkg/ssh logs progress via slog straight to stdout, which would
+	// otherwise interleave with this command's table output.
+	slog.SetDefault(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := context.Background()
+
+	fix, _ := cmd.Flags().GetBool("fix")
+	certWarn, _ := cmd.Flags().GetDuration("cert-warn")
+
+	infraCfg, err := config.LoadInfra(cmd)
+	if err != nil {
+		return err
+	}
+	nodes, err := selectNodes(cmd, infraCfg)
+	if err != nil {
+		return err
+	}
+
+	nodeResults := make([][]doctorResult, len(nodes))
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		// Fixes reuse the check's connection, so it stays open until the
+		// end of the run.
+		client := ssh.NewClient(node.Address, node.SSH.Credentials())
+		defer client.Close()
+
+		wg.Add(1)
+		go func(i int, node config.NodeConfig) {
+			defer wg.Done()
+			nodeResults[i] = doctorNode(ctx, client, node, certWarn)
+		}(i, node)
+	}
+
+	var clusterResults []doctorResult
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		clusterResults = doctorCluster(ctx, infraCfg)
+	}()
+	wg.Wait()
+
+	var results []doctorResult
+	for _, r := range nodeResults {
+		results = append(results, r...)
+	}
+	results = append(results, clusterResults...)
+
+	if fix {
+		for i := range results {
+			r := &results[i]
+			if r.Status == doctorOK || r.Status == doctorSkip || r.fix == nil {
+				continue
+			}
+			fmt.Printf("Fixing %s on %s...\n", r.Check, r.Target)
+			if err := r.fix(); err != nil {
+				r.Detail += fmt.Sprintf(" (fix failed: %v)", err)
+				continue
+			}
+			r.fixed = true
+		}
+		fmt.Println()
+	}
+
+	if err := printDoctorResults(results, fix); err != nil {
+		return err
+	}
+
+	failing := 0
+	for _, r := range results {
+		if r.Status == doctorFail && !r.fixed {
+			failing++
+		}
+	}
+	if failing > 0 {
+		return fmt.Errorf("%d checks failing", failing)
+	}
+	return nil
+}
+
+// doctorNode runs the node checks against node over client.
+func doctorNode(ctx context.Context, client *ssh.Client, node config.NodeConfig, certWarn time.Duration) []doctorResult {
+	result := func(check, status, detail string) doctorResult {
+		return doctorResult{Check: check, Target: node.Name, Status: status, Detail: detail}
+	}
+
+	if err := client.Connect(ctx); err != nil {
+		return []doctorResult{result("ssh", doctorFail, err.Error())}
+	}
+
+	service, err := k3s.ServiceName(client)
+	if err != nil {
+		return []doctorResult{result("k3s-service", doctorSkip, "k3s isn't installed")}
+	}
+
+	serviceResult := result("k3s-service", doctorOK, service+" is running")
+	if k3s.ServiceFailed(client, service) {
+		serviceResult.Status = doctorFail
+		serviceResult.Detail = fmt.Sprintf("%s has failed - see journalctl -xeu %s.service", service, service)
+		serviceResult.fix = func() error { return k3s.RestartService(client, service) }
+	}
+
+	ipamResult := result("cni-ipam", doctorOK, "no stale allocations")
+	if stale, err := k3s.StaleIPAMAllocations(client); err != nil {
+		ipamResult.Status = doctorSkip
+		ipamResult.Detail = err.Error()
+	} else if len(stale) > 0 {
+		ipamResult.Status = doctorFail
+		ipamResult.Detail = fmt.Sprintf("%d stale allocations, e.g. %s", len(stale), stale[0])
+		ipamResult.fix = func() error { return k3s.RemoveIPAMAllocations(client, stale) }
+	}
+
+	certResult := result("certs", doctorOK, "")
+	if expiries, err := k3s.CertExpiries(client); err != nil {
+		certResult.Status = doctorSkip
+		certResult.Detail = err.Error()
+	} else if len(expiries) == 0 {
+		certResult.Status = doctorSkip
+		certResult.Detail = "no k3s certificates found"
+	} else {
+		soonest := expiries[0]
+		left := time.Until(soonest.NotAfter)
+		certResult.Detail = fmt.Sprintf("soonest expiry %s (%s)", soonest.NotAfter.Format(time.DateOnly), soonest.Path)
+		switch {
+		case left <= 0:
+			certResult.Status = doctorFail
+			certResult.Detail = fmt.Sprintf("%s expired %s", soonest.Path, soonest.NotAfter.Format(time.DateOnly))
+		case left < certWarn:
+			certResult.Status = doctorWarn
+		}
+		// A failed unit's own fix restarts k3s anyway.
+		if certResult.Status != doctorOK && serviceResult.fix == nil {
+			certResult.fix = func() error { return k3s.RestartService(client, service) }
+		}
+	}
+
+	return []doctorResult{serviceResult, ipamResult, certResult}
+}
+
+// doctorCluster runs the cluster checks through the Kubernetes API.
+func doctorCluster(ctx context.Context, infraCfg *config.InfraConfig) []doctorResult {
+	const target = "cluster"
+
+	if infraCfg.Cluster.VIP == "" {
+		return []doctorResult{{Check: "kube-api", Target: target, Status: doctorSkip, Detail: "cluster.vip is not set in infra.yaml"}}
+	}
+	api, err := clusterAPIClient(ctx, infraCfg)
+	if err != nil {
+		return []doctorResult{{Check: "kube-api", Target: target, Status: doctorFail, Detail: err.Error()}}
+	}
+
+	cilium := doctorResult{Check: "cilium", Target: target, Status: doctorOK, Detail: "all agents ready"}
+	if unready, err := api.UnreadyPods(ctx, "kube-system", "k8s-app=cilium"); err != nil {
+		cilium.Status, cilium.Detail = doctorSkip, err.Error()
+	} else if len(unready) > 0 {
+		cilium.Status = doctorFail
+		cilium.Detail = "agents not ready: " + strings.Join(unready, ", ")
+		cilium.fix = func() error {
+			for _, pod := range unready {
+				if err := api.DeletePod(ctx, "kube-system", pod); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	longhorn := doctorResult{Check: "longhorn", Target: target, Status: doctorOK, Detail: "all attached volumes healthy"}
+	if unhealthy, err := api.UnhealthyLonghornVolumes(ctx); err != nil {
+		longhorn.Status, longhorn.Detail = doctorSkip, err.Error()
+	} else if unhealthy == nil {
+		longhorn.Status, longhorn.Detail = doctorSkip, "Longhorn isn't installed"
+	} else if len(unhealthy) > 0 {
+		longhorn.Status = doctorWarn
+		var volumes []string
+		for name, robustness := range unhealthy {
+			if robustness == "faulted" {
+				longhorn.Status = doctorFail
+			}
+			volumes = append(volumes, fmt.Sprintf("%s (%s)", name, robustness))
+		}
+		sort.Strings(volumes)
+		longhorn.Detail = strings.Join(volumes, ", ")
+	}
+
+	tailscale := doctorResult{Check: "tailscale", Target: target, Status: doctorOK, Detail: "every tailscale ingress has a ready proxy"}
+	if missing, err := api.MissingTailscaleProxies(ctx); err != nil {
+		tailscale.Status, tailscale.Detail = doctorSkip, err.Error()
+	} else if len(missing) > 0 {
+		tailscale.Status = doctorFail
+		tailscale.Detail = "no ready proxy for: " + strings.Join(missing, ", ")
+		tailscale.fix = func() error {
+			return api.RestartDeployment(ctx, k3s.TailscaleNamespace, k3s.TailscaleOperatorDeployment)
+		}
+	}
+
+	return []doctorResult{cilium, longhorn, tailscale}
+}
+
+// printDoctorResults writes results as a table to stdout. The FIX column
+// says whether a fix is available, or (with --fix) whether it was applied.
+func printDoctorResults(results []doctorResult, fix bool) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "CHECK\tTARGET\tSTATUS\tFIX\tDETAIL")
+	for _, r := range results {
+		fixCol := "-"
+		switch {
+		case r.fixed:
+			fixCol = "applied"
+		case r.fix != nil && fix:
+			fixCol = "failed"
+		case r.fix != nil:
+			fixCol = "--fix"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.Check, r.Target, r.Status, fixCol, r.Detail)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/liamawhite/homelab/pkg/k3s"
+	"github.com/spf13/cobra"
+)
+
+var etcdCmd = &cobra.Command{
+	Use:   "etcd",
+	Short: "Back up and restore the cluster's embedded etcd",
+}
+
+func init() {
+	etcdCmd.PersistentFlags().String("dir", "", "Local snapshot directory (default: .etcd-snapshots next to infra.yaml)")
+	etcdCmd.AddCommand(etcdSnapshotCmd)
+	etcdCmd.AddCommand(etcdListCmd)
+	etcdCmd.AddCommand(etcdRestoreCmd)
+}
+
+// snapshotDir returns the local snapshot directory: --dir, or
+// .etcd-snapshots alongside infra.yaml - git-crypt'd like .pulumi-state,
+// since a snapshot holds every Secret in the cluster.
+func snapshotDir(cmd *cobra.Command) (string, error) {
+	if dir, _ := cmd.Flags().GetString("dir"); dir != "" {
+		return dir, nil
+	}
+	configFile, err := config.ResolveConfigPath(cmd)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(configFile), ".etcd-snapshots"), nil
+}
+
+// localSnapshots returns the SaveSnapshot snapshots in dir, newest first.
+// k3s names them <prefix>-<node>-<unix time>, so name order within a node
+// is age order; modification time orders them across nodes.
+func localSnapshots(dir string) ([]os.FileInfo, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+
+	var snapshots []os.FileInfo
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasPrefix(e.Name(), k3s.SnapshotPrefix+"-") {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, info)
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].ModTime().After(snapshots[j].ModTime())
+	})
+	return snapshots, nil
+}
@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/liamawhite/homelab/pkg/k3s"
+	"github.com/liamawhite/homelab/pkg/ssh"
+	"github.com/spf13/cobra"
+)
+
+var etcdRestoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore the cluster's etcd from a snapshot",
+	Long: `Restores the whole cluster to a snapshot taken by 'homelab etcd snapshot',
+following k3s's cluster-reset procedure for embedded etcd:
+
+  1. upload the snapshot to the restore node (--node)
+  2. stop k3s on every server
+  3. run 'k3s server --cluster-reset' from the snapshot on the restore node,
+     then start it as a single-member cluster and wait for its API
+  4. one at a time, wipe each other server's etcd data and start it, so it
+     rejoins and resyncs from the restored node
+
+Agents aren't touched; they reconnect once the servers are back. Everything
+written to the cluster since the snapshot is lost, so this requires --yes.
+
+--node defaults to the server originally installed with --cluster-init. Any
+other server installed that way can't be wiped and rejoined (it would start
+a fresh, empty cluster instead), so the restore refuses to start - restore
+on that node instead.
+
+Example:
+  homelab etcd restore --snapshot .etcd-snapshots/homelab-pi-0-1760000000 --yes`,
+	RunE: runEtcdRestore,
+}
+
+func init() {
+	etcdRestoreCmd.Flags().String("snapshot", "", "Local snapshot file to restore (required)")
+	etcdRestoreCmd.Flags().String("node", "", "Server node name from infra.yaml to restore on (default: the --cluster-init server)")
+	etcdRestoreCmd.Flags().Duration("ready-timeout", 5*time.Minute, "How long to wait for each server's API after starting it")
+	etcdRestoreCmd.Flags().Bool("yes", false, "Confirm that all cluster state since the snapshot will be lost")
+	_ = etcdRestoreCmd.MarkFlagRequired("snapshot")
+}
+
+// restoreServer is an infra.yaml server with an open SSH connection.
+type restoreServer struct {
+	node   config.NodeConfig
+	client *ssh.Client
+}
+
+func runEtcdRestore(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if yes, _ := cmd.Flags().GetBool("yes"); !yes {
+		return fmt.Errorf("restoring discards everything written to the cluster since the snapshot - re-run with --yes to confirm")
+	}
+
+	snapshot, _ := cmd.Flags().GetString("snapshot")
+	if _, err := os.Stat(snapshot); err != nil {
+		return fmt.Errorf("snapshot %s: %w", snapshot, err)
+	}
+	readyTimeout, _ := cmd.Flags().GetDuration("ready-timeout")
+	nodeName, _ := cmd.Flags().GetString("node")
+
+	infraCfg, err := config.LoadInfra(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Connect to every server up front: a restore that can't reach one
+	// shouldn't get as far as stopping the others.
+	var servers []restoreServer
+	for _, node := range infraCfg.Servers() {
+		client := ssh.NewClient(node.Address, node.SSH.Credentials())
+		if err := client.Connect(ctx); err != nil {
+			return fmt.Errorf("failed to connect to %s: %w", node.Name, err)
+		}
+		defer client.Close()
+		servers = append(servers, restoreServer{node: node, client: client})
+	}
+
+	target, others, err := splitRestoreTarget(servers, nodeName)
+	if err != nil {
+		return err
+	}
+
+	remote := k3s.SnapshotPath(filepath.Base(snapshot))
+	slog.Info("Uploading snapshot", "node", target.node.Name, "path", remote)
+	f, err := os.Open(snapshot)
+	if err != nil {
+		return err
+	}
+	// The snapshot holds every secret in the cluster, so only root may
+	// read it, and it doesn't outlive the restore - unless it's one of
+	// k3s's own, taken on this node, which it came back to.
+	_, statErr := target.client.Stat(remote, true)
+	err = target.client.Upload(f, remote, 0600, "root:root", true)
+	f.Close()
+	if errors.Is(statErr, os.ErrNotExist) {
+		defer func() {
+			if _, _, err := target.client.ExecuteSudo("rm -f " + ssh.ShellQuote(remote)); err != nil {
+				slog.Warn("Failed to remove uploaded snapshot", "node", target.node.Name, "path", remote, "error", err)
+			}
+		}()
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, s := range servers {
+		slog.Info("Stopping k3s", "node", s.node.Name)
+		if err := k3s.StopServer(s.client); err != nil {
+			return fmt.Errorf("%s: %w", s.node.Name, err)
+		}
+	}
+
+	if err := k3s.ResetFromSnapshot(target.client, remote); err != nil {
+		return fmt.Errorf("%s: %w", target.node.Name, err)
+	}
+	if err := startAndWait(ctx, target, readyTimeout); err != nil {
+		return err
+	}
+
+	for _, s := range others {
+		slog.Info("Wiping etcd data and rejoining", "node", s.node.Name)
+		if err := k3s.WipeEtcd(s.client); err != nil {
+			return fmt.Errorf("%s: %w", s.node.Name, err)
+		}
+		if err := startAndWait(ctx, s, readyTimeout); err != nil {
+			return err
+		}
+	}
+
+	slog.Info("etcd restore complete", "snapshot", snapshot, "restored_on", target.node.Name)
+	return nil
+}
+
+// splitRestoreTarget picks the server to restore on - nodeName, or else
+// the one installed with --cluster-init, or else the first - and checks
+// none of the rest were installed with --cluster-init.
+func splitRestoreTarget(servers []restoreServer, nodeName string) (target restoreServer, others []restoreServer, err error) {
+	if len(servers) == 0 {
+		return restoreServer{}, nil, fmt.Errorf("no server nodes defined in infra.yaml")
+	}
+
+	initNodes := map[string]bool{}
+	for _, s := range servers {
+		initNodes[s.node.Name] = k3s.InstalledWithClusterInit(s.client)
+	}
+
+	idx := -1
+	for i, s := range servers {
+		if (nodeName != "" && s.node.Name == nodeName) || (nodeName == "" && initNodes[s.node.Name]) {
+			idx = i
+			break
+		}
+	}
+	if nodeName != "" && idx < 0 {
+		return restoreServer{}, nil, fmt.Errorf("no server node named %q in infra.yaml", nodeName)
+	}
+	if idx < 0 {
+		idx = 0
+	}
+
+	for i, s := range servers {
+		if i == idx {
+			continue
+		}
+		if initNodes[s.node.Name] {
+			return restoreServer{}, nil, fmt.Errorf("%s was installed with --cluster-init, so it can't rejoin after a wipe - restore on %s instead (--node %s)", s.node.Name, s.node.Name, s.node.Name)
+		}
+		others = append(others, s)
+	}
+	return servers[idx], others, nil
+}
+
+// startAndWait starts k3s on s and waits until its own API server answers
+// /livez.
+func startAndWait(ctx context.Context, s restoreServer, timeout time.Duration) error {
+	slog.Info("Starting k3s", "node", s.node.Name)
+	if err := k3s.StartServer(s.client); err != nil {
+		return fmt.Errorf("%s: %w", s.node.Name, err)
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		if kubeconfig, err := k3s.ExtractKubeconfig(ctx, s.client, s.node.Address); err == nil && k3s.CheckAPIHealth(kubeconfig) {
+			slog.Info("API server healthy", "node", s.node.Name)
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%s: API server not healthy within %s", s.node.Name, timeout)
+		}
+		time.Sleep(5 * time.Second)
+	}
+}
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/liamawhite/homelab/pkg/k3s"
+	"github.com/liamawhite/homelab/pkg/ssh"
+	"github.com/spf13/cobra"
+)
+
+var etcdSnapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Take an etcd snapshot and download it",
+	Long: `Runs 'k3s etcd-snapshot save' on a server node over SSH, downloads the
+snapshot into the local snapshot directory, then prunes both the node's and
+the local copies down to the newest --keep, and lists what's left locally.
+
+Only snapshots this command takes are pruned - k3s's own scheduled
+snapshots on the node are left to its --etcd-snapshot-retention.
+
+Example:
+  homelab etcd snapshot
+  homelab etcd snapshot --node pi-1 --keep 14`,
+	RunE: runEtcdSnapshot,
+}
+
+var etcdListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List downloaded etcd snapshots",
+	Long: `Lists the snapshots in the local snapshot directory, newest first - the
+files 'homelab etcd restore --snapshot' accepts.
+
+Example:
+  homelab etcd list`,
+	RunE: runEtcdList,
+}
+
+func init() {
+	etcdSnapshotCmd.Flags().String("node", "", "Server node name from infra.yaml (default: the first server)")
+	etcdSnapshotCmd.Flags().Int("keep", 7, "How many snapshots to keep, both on the node and locally")
+}
+
+func runEtcdSnapshot(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	infraCfg, err := config.LoadInfra(cmd)
+	if err != nil {
+		return err
+	}
+	dir, err := snapshotDir(cmd)
+	if err != nil {
+		return err
+	}
+	keep, _ := cmd.Flags().GetInt("keep")
+	if keep < 1 {
+		return fmt.Errorf("--keep must be at least 1")
+	}
+
+	node, err := selectServer(cmd, infraCfg)
+	if err != nil {
+		return err
+	}
+
+	client := ssh.NewClient(node.Address, node.SSH.Credentials())
+	if err := client.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", node.Name, err)
+	}
+	defer client.Close()
+
+	remote, err := k3s.SaveSnapshot(client)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+	local := filepath.Join(dir, path.Base(remote))
+	if err := downloadFile(client, remote, local); err != nil {
+		return err
+	}
+	slog.Info("Snapshot downloaded", "path", local)
+
+	if err := k3s.PruneSnapshots(client, keep); err != nil {
+		return err
+	}
+	if err := pruneLocalSnapshots(dir, keep); err != nil {
+		return err
+	}
+
+	return printSnapshots(dir)
+}
+
+func runEtcdList(cmd *cobra.Command, args []string) error {
+	dir, err := snapshotDir(cmd)
+	if err != nil {
+		return err
+	}
+	return printSnapshots(dir)
+}
+
+// selectServer returns the server node named by --node, or the first
+// server in infra.yaml if --node is omitted.
+func selectServer(cmd *cobra.Command, infraCfg *config.InfraConfig) (config.NodeConfig, error) {
+	nodeName, _ := cmd.Flags().GetString("node")
+	if nodeName == "" {
+		servers := infraCfg.Servers()
+		if len(servers) == 0 {
+			return config.NodeConfig{}, fmt.Errorf("no server nodes defined in infra.yaml")
+		}
+		return servers[0], nil
+	}
+
+	node := config.FindNodeByName(infraCfg, nodeName)
+	if node == nil {
+		return config.NodeConfig{}, fmt.Errorf("no node named %q in infra.yaml", nodeName)
+	}
+	if !node.IsServer() {
+		return config.NodeConfig{}, fmt.Errorf("node %q is an agent - etcd only runs on servers", nodeName)
+	}
+	return *node, nil
+}
+
+// downloadFile copies remote (read with sudo) to local, removing the
+// partial file if the copy fails.
+func downloadFile(client *ssh.Client, remote, local string) error {
+	f, err := os.OpenFile(local, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", local, err)
+	}
+
+	err = client.Download(remote, f, true)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(local)
+		return err
+	}
+	return nil
+}
+
+// pruneLocalSnapshots deletes all but the newest keep snapshots in dir.
+func pruneLocalSnapshots(dir string, keep int) error {
+	snapshots, err := localSnapshots(dir)
+	if err != nil {
+		return err
+	}
+	for i := keep; i < len(snapshots); i++ {
+		p := filepath.Join(dir, snapshots[i].Name())
+		if err := os.Remove(p); err != nil {
+			return fmt.Errorf("failed to prune %s: %w", p, err)
+		}
+		slog.Info("Pruned local snapshot", "path", p)
+	}
+	return nil
+}
+
+// printSnapshots writes a table of dir's snapshots to stdout.
+func printSnapshots(dir string) error {
+	snapshots, err := localSnapshots(dir)
+	if err != nil {
+		return err
+	}
+	if len(snapshots) == 0 {
+		fmt.Printf("No snapshots in %s\n", dir)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "SNAPSHOT\tSIZE\tTAKEN")
+	for _, s := range snapshots {
+		fmt.Fprintf(w, "%s\t%.1f MiB\t%s\n", filepath.Join(dir, s.Name()), float64(s.Size())/(1<<20), s.ModTime().Format("2006-01-02 15:04:05"))
+	}
+	return w.Flush()
+}
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/liamawhite/homelab/pkg/ssh"
+	"github.com/spf13/cobra"
+)
+
+var execCmd = &cobra.Command{
+	Use:   "exec [--node X|--all] -- <command>",
+	Short: "Run a shell command on nodes over SSH",
+	Long: `Runs a shell command on one or more nodes in parallel, using each node's
+infra.yaml SSH credentials, and reports every node's stdout, stderr and
+exit status.
+
+The command's args are passed through as they are, not re-parsed by the
+node's shell; for pipes, redirects and the like, run a shell:
+  homelab exec --all -- sh -c 'dmesg | tail -n 5'
+
+The table output prints each node's output in turn, followed by a summary
+of exit statuses; --output json prints all of it as a single JSON array
+instead. Exits non-zero if the command failed (or couldn't be run) on any
+node.
+
+Example:
+  homelab exec --all -- uptime
+  homelab exec --node pi-0 --node pi-1 --sudo -- journalctl -u k3s -n 20
+  homelab exec --all --output json -- df -h /`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runExec,
+}
+
+func init() {
+	execCmd.Flags().StringSlice("node", nil, "Node name from infra.yaml (repeatable)")
+	execCmd.Flags().Bool("all", false, "Run on every node in infra.yaml")
+	execCmd.Flags().Bool("sudo", false, "Run the command as root")
+	execCmd.Flags().StringP("output", "o", "table", "Output format: table or json")
+	execCmd.MarkFlagsOneRequired("node", "all")
+	execCmd.MarkFlagsMutuallyExclusive("node", "all")
+}
+
+// execResult is one node's outcome of an exec.
+type execResult struct {
+	Node     string `json:"node"`
+	ExitCode int    `json:"exitCode"`
+	Stdout   string `json:"stdout"`
+	Stderr   string `json:"stderr"`
+	// Error is set if the command couldn't be run at all, e.g. the node
+	// was unreachable - ExitCode is then -1.
+	Error string `json:"error,omitempty"`
+}
+
+func runExec(cmd *cobra.Command, args []string) error {
+	// cli/pkg/ssh logs progress via slog straight to stdout, which would
+	// otherwise interleave with the nodes' output.
+	slog.SetDefault(slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	output, _ := cmd.Flags().GetString("output")
+	if output != "table" && output != "json" {
+		return fmt.Errorf("invalid --output %q (must be table or json)", output)
+	}
+	sudo, _ := cmd.Flags().GetBool("sudo")
+
+	infraCfg, err := config.LoadInfra(cmd)
+	if err != nil {
+		return err
+	}
+
+	nodes := infraCfg.Nodes
+	if names, _ := cmd.Flags().GetStringSlice("node"); len(names) > 0 {
+		nodes = nil
+		for _, name := range names {
+			node := config.FindNodeByName(infraCfg, name)
+			if node == nil {
+				return fmt.Errorf("no node named %q in infra.yaml", name)
+			}
+			nodes = append(nodes, *node)
+		}
+	}
+
+	// Quote each arg, so the remote shell sees the same words we were given.
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = ssh.ShellQuote(arg)
+	}
+	command := strings.Join(quoted, " ")
+	if sudo {
+		command = "sudo sh -c " + ssh.ShellQuote(command)
+	}
+
+	results := make([]execResult, len(nodes))
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		wg.Add(1)
+		go func(i int, node config.NodeConfig) {
+			defer wg.Done()
+			results[i] = execOnNode(node, command)
+		}(i, node)
+	}
+	wg.Wait()
+
+	if output == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(results); err != nil {
+			return err
+		}
+	} else if err := printExecResults(results); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.ExitCode != 0 {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("command failed on %d of %d nodes", failed, len(results))
+	}
+	return nil
+}
+
+// execOnNode runs command on node over a fresh SSH connection.
+func execOnNode(node config.NodeConfig, command string) execResult {
+	result := execResult{Node: node.Name}
+
+	client := ssh.NewClient(node.Address, node.SSH.Credentials())
+	if err := client.Connect(context.Background()); err != nil {
+		result.ExitCode = -1
+		result.Error = err.Error()
+		return result
+	}
+	defer client.Close()
+
+	stdout, stderr, err := client.Execute(command)
+	result.Stdout = stdout
+	result.Stderr = stderr
+	result.ExitCode = ssh.ExitCode(err)
+	if err != nil && result.ExitCode == -1 {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// printExecResults writes each node's output, then a summary table of exit
+// statuses, to stdout.
+func printExecResults(results []execResult) error {
+	for _, r := range results {
+		fmt.Printf("==> %s <==\n", r.Node)
+		if r.Stdout != "" {
+			fmt.Print(withTrailingNewline(r.Stdout))
+		}
+		if r.Stderr != "" {
+			fmt.Println("--- stderr ---")
+			fmt.Print(withTrailingNewline(r.Stderr))
+		}
+		fmt.Println()
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "NODE\tEXIT\tERROR")
+	for _, r := range results {
+		errMsg := r.Error
+		if errMsg == "" {
+			errMsg = "-"
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\n", r.Node, r.ExitCode, errMsg)
+	}
+	return w.Flush()
+}
+
+func withTrailingNewline(s string) string {
+	if strings.HasSuffix(s, "\n") {
+		return s
+	}
+	return s + "\n"
+}
@@ -19,6 +19,10 @@ var k3sCmd = &cobra.Command{
 
 The node should be provisioned first using the 'bootstrap' command.
 
+Nodes are installed as servers (control plane plus an etcd member) unless
+their infra.yaml entry sets role: agent, in which case they're installed as
+workload-only agents - these must join an existing cluster.
+
 When joining (--server without --cluster-init), --token can be omitted: if
 cluster.token isn't already set in infra.yaml, it's fetched automatically
 by connecting to the node matching --server's host and saved back to
@@ -33,7 +37,10 @@ Examples:
   homelab k3s --node pi-0 --cluster-init
 
   # Additional nodes (join cluster, token fetched automatically)
-  homelab k3s --node pi-1 --server https://192.168.1.51:6443`,
+  homelab k3s --node pi-1 --server https://192.168.1.51:6443
+
+  # Agent (worker) nodes - role: agent in infra.yaml - join the same way
+  homelab k3s --node pi-5 --server https://192.168.1.51:6443`,
 	RunE: runK3s,
 }
 
@@ -59,20 +66,12 @@ func runK3s(cmd *cobra.Command, args []string) error {
 	slog.Info("Starting K3s installation", "node", cfg.Node)
 
 	// Create SSH client
-	var authMethod string
-	if cfg.SSHPassword != "" {
-		authMethod = "password"
-	} else {
-		authMethod = "key"
-	}
-
-	slog.Info("Creating SSH connection", "node", cfg.Node, "user", cfg.SSHUser, "auth_method", authMethod, "password_provided", cfg.SSHPassword != "")
+	slog.Info("Creating SSH connection", "node", cfg.Node, "user", cfg.SSH.User, "auth_methods", cfg.SSH.Credentials().Methods())
 
-	var client *ssh.Client
-	client = ssh.NewClientWithPassword(cfg.Node, cfg.SSHUser, cfg.SSHPassword)
+	client := ssh.NewClient(cfg.Node, cfg.SSH.Credentials())
 
 	if err := client.Connect(ctx); err != nil {
-		slog.Error("SSH connection failed", "node", cfg.Node, "user", cfg.SSHUser, "auth_method", authMethod, "error", err)
+		slog.Error("SSH connection failed", "node", cfg.Node, "user", cfg.SSH.User, "error", err)
 		return err
 	}
 	defer client.Close()
@@ -90,8 +89,8 @@ func runK3s(cmd *cobra.Command, args []string) error {
 	}
 
 	// Install K3s
-	slog.Info("Installing K3s", "cluster_init", cfg.ClusterInit)
-	installer := k3s.NewInstaller(client, cfg.K3SSANS)
+	slog.Info("Installing K3s", "role", cfg.Role, "cluster_init", cfg.ClusterInit)
+	installer := k3s.NewInstaller(client, cfg.Role, cfg.K3SSANS)
 	if err := installer.InstallK3s(ctx, cfg.ClusterInit, cfg.ServerURL, cfg.Token); err != nil {
 		slog.Error("Failed to install K3s", "error", err)
 		return err
@@ -141,13 +140,13 @@ func fetchAndSaveClusterToken(ctx context.Context, cfg *config.Config) (string,
 
 	slog.Info("Fetching cluster token from join server", "node", serverNode.Name, "address", serverNode.Address)
 
-	client := ssh.NewClientWithPassword(serverNode.Address, serverNode.SSH.User, serverNode.SSH.Password)
+	client := ssh.NewClient(serverNode.Address, serverNode.SSH.Credentials())
 	if err := client.Connect(ctx); err != nil {
 		return "", fmt.Errorf("failed to connect to %s: %w", serverNode.Address, err)
 	}
 	defer client.Close()
 
-	token, err := k3s.NewInstaller(client, nil).GetClusterToken(ctx)
+	token, err := k3s.NewInstaller(client, config.RoleServer, nil).GetClusterToken(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to extract cluster token: %w", err)
 	}
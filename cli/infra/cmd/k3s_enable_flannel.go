@@ -50,7 +50,7 @@ func runK3sEnableFlannel(cmd *cobra.Command, args []string) error {
 	for _, node := range nodes {
 		slog.Info("Re-enabling Flannel", "node", node.Name, "address", node.Address)
 
-		client := ssh.NewClientWithPassword(node.Address, node.SSH.User, node.SSH.Password)
+		client := ssh.NewClient(node.Address, node.SSH.Credentials())
 		if err := client.Connect(ctx); err != nil {
 			return fmt.Errorf("failed to connect to %s: %w", node.Name, err)
 		}
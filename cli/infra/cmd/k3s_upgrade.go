@@ -0,0 +1,258 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/liamawhite/homelab/pkg/k3s"
+	"github.com/liamawhite/homelab/pkg/ssh"
+	"github.com/liamawhite/homelab/pkg/versions"
+	"github.com/spf13/cobra"
+)
+
+var k3sUpgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Rolling upgrade of K3s to the pinned version",
+	Long: `Upgrades K3s to the version pinned in pkg/versions, one node at a time
+(--node to upgrade just one), so the cluster keeps etcd quorum and its API
+throughout.
+
+Servers go first, then agents (k3s doesn't support agents running ahead of
+the control plane). For each node, in infra.yaml order within its role:
+  1. cordon it and drain its pods through the Kubernetes API (DaemonSet and
+     static pods are left alone; PodDisruptionBudgets are respected)
+  2. re-run the K3s installer over SSH with the pinned version
+  3. wait for the node to report Ready on the new version, and (servers
+     only) for its own API server to answer /livez
+  4. uncordon it
+
+Nodes already on the pinned version are skipped. The rollout stops at the
+first failure, leaving that node cordoned for inspection - re-run once it's
+fixed, and the nodes already upgraded will be skipped. Kubernetes node names
+are expected to match infra.yaml's node names.
+
+Example:
+  homelab k3s upgrade
+  homelab k3s upgrade --node pi-1`,
+	RunE: runK3sUpgrade,
+}
+
+func init() {
+	k3sUpgradeCmd.Flags().String("node", "", "Node name from infra.yaml (all nodes if omitted)")
+	k3sUpgradeCmd.Flags().Duration("drain-timeout", 5*time.Minute, "How long to wait for a node's pods to be evicted")
+	k3sUpgradeCmd.Flags().Duration("ready-timeout", 5*time.Minute, "How long to wait for an upgraded node to become Ready")
+	k3sCmd.AddCommand(k3sUpgradeCmd)
+}
+
+// upgradePollInterval is how often runK3sUpgrade re-checks an upgraded
+// node's readiness.
+const upgradePollInterval = 5 * time.Second
+
+func runK3sUpgrade(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	infraCfg, err := config.LoadInfra(cmd)
+	if err != nil {
+		return err
+	}
+	if infraCfg.Cluster.VIP == "" {
+		return fmt.Errorf("cluster.vip is not set in infra.yaml")
+	}
+
+	nodes, err := selectNodes(cmd, infraCfg)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(nodes, func(a, b int) bool {
+		return nodes[a].IsServer() && !nodes[b].IsServer()
+	})
+
+	drainTimeout, _ := cmd.Flags().GetDuration("drain-timeout")
+	readyTimeout, _ := cmd.Flags().GetDuration("ready-timeout")
+
+	// Cordon/drain/readiness go through the VIP rather than any one node,
+	// so they keep working while the node being upgraded is down.
+	api, err := clusterAPIClient(ctx, infraCfg)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		current, err := api.GetNode(ctx, node.Name)
+		if err != nil {
+			return err
+		}
+		if current.KubeletVersion == versions.K3s {
+			slog.Info("Node already on target version, skipping", "node", node.Name, "version", versions.K3s)
+			continue
+		}
+
+		slog.Info("Upgrading node", "node", node.Name, "role", node.Role, "from", current.KubeletVersion, "to", versions.K3s)
+		if err := upgradeNode(ctx, api, infraCfg, node, drainTimeout, readyTimeout); err != nil {
+			return fmt.Errorf("upgrade of %s failed, aborting rollout (%s is left cordoned): %w", node.Name, node.Name, err)
+		}
+		slog.Info("Node upgraded", "node", node.Name, "version", versions.K3s)
+	}
+
+	slog.Info("K3s upgrade complete", "version", versions.K3s)
+	return nil
+}
+
+// clusterAPIClient returns an APIClient for the cluster VIP, authenticated
+// with the kubeconfig from the first infra.yaml server that can be reached
+// over SSH.
+func clusterAPIClient(ctx context.Context, infraCfg *config.InfraConfig) (*k3s.APIClient, error) {
+	return serverAPIClient(ctx, infraCfg.Servers(), infraCfg.Cluster.VIP)
+}
+
+// serverAPIClient returns an APIClient authenticated with the kubeconfig
+// from the first of servers that can be reached over SSH, talking to
+// address - or, if that's empty, to that server's own address, for when the
+// VIP can't be relied on to stay put.
+func serverAPIClient(ctx context.Context, servers []config.NodeConfig, address string) (*k3s.APIClient, error) {
+	var lastErr error
+	for _, node := range servers {
+		client := ssh.NewClient(node.Address, node.SSH.Credentials())
+		if err := client.Connect(ctx); err != nil {
+			lastErr = fmt.Errorf("failed to connect to %s: %w", node.Name, err)
+			continue
+		}
+
+		host := address
+		if host == "" {
+			host = node.Address
+		}
+		kubeconfig, err := k3s.ExtractKubeconfig(ctx, client, host)
+		client.Close()
+		if err != nil {
+			lastErr = fmt.Errorf("failed to extract kubeconfig from %s: %w", node.Name, err)
+			continue
+		}
+
+		return k3s.NewAPIClient(kubeconfig)
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no server nodes in infra.yaml")
+	}
+	return nil, lastErr
+}
+
+// agentJoinArgs returns the server URL and token an agent's installer needs
+// to be re-run: the VIP, and cluster.token from infra.yaml - or, if that's
+// unset, the token read from the first reachable server.
+func agentJoinArgs(ctx context.Context, infraCfg *config.InfraConfig) (serverURL, token string, err error) {
+	serverURL = fmt.Sprintf("https://%s:6443", infraCfg.Cluster.VIP)
+	if infraCfg.Cluster.Token != "" {
+		return serverURL, infraCfg.Cluster.Token, nil
+	}
+
+	for _, node := range infraCfg.Servers() {
+		client := ssh.NewClient(node.Address, node.SSH.Credentials())
+		if err = client.Connect(ctx); err != nil {
+			continue
+		}
+		token, err = k3s.NewInstaller(client, config.RoleServer, nil).GetClusterToken(ctx)
+		client.Close()
+		if err == nil {
+			return serverURL, token, nil
+		}
+	}
+	return "", "", fmt.Errorf("cluster.token is not set in infra.yaml and no server could provide it: %w", err)
+}
+
+// upgradeNode runs one node through cordon, drain, install, wait and
+// uncordon. On error the node is deliberately left cordoned.
+func upgradeNode(ctx context.Context, api *k3s.APIClient, infraCfg *config.InfraConfig, node config.NodeConfig, drainTimeout, readyTimeout time.Duration) error {
+	if err := cordonAndDrain(ctx, api, node, drainTimeout); err != nil {
+		return err
+	}
+
+	client := ssh.NewClient(node.Address, node.SSH.Credentials())
+	if err := client.Connect(ctx); err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+	defer client.Close()
+
+	var serverURL, token string
+	var err error
+	if !node.IsServer() {
+		if serverURL, token, err = agentJoinArgs(ctx, infraCfg); err != nil {
+			return err
+		}
+	}
+
+	if err := k3s.NewInstaller(client, node.Role, infraCfg.Cluster.SANs).UpgradeK3s(ctx, serverURL, token); err != nil {
+		return err
+	}
+
+	slog.Info("Waiting for node to become Ready", "node", node.Name, "timeout", readyTimeout)
+	deadline := time.Now().Add(readyTimeout)
+	for {
+		if ready, err := nodeUpgraded(ctx, api, client, node); err == nil && ready {
+			break
+		} else if err != nil {
+			slog.Debug("Node not ready yet", "node", node.Name, "error", err)
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("node did not become Ready on %s with a healthy API within %s", versions.K3s, readyTimeout)
+		}
+		time.Sleep(upgradePollInterval)
+	}
+
+	slog.Info("Uncordoning node", "node", node.Name)
+	return api.SetUnschedulable(ctx, node.Name, false)
+}
+
+// cordonAndDrain cordons node and drains its pods through the Kubernetes
+// API, giving up on the drain after timeout.
+func cordonAndDrain(ctx context.Context, api *k3s.APIClient, node config.NodeConfig, timeout time.Duration) error {
+	slog.Info("Cordoning node", "node", node.Name)
+	if err := api.SetUnschedulable(ctx, node.Name, true); err != nil {
+		return err
+	}
+
+	drainCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := api.Drain(drainCtx, node.Name); err != nil {
+		return fmt.Errorf("drain failed: %w", err)
+	}
+	return nil
+}
+
+// nodeUpgraded reports whether node is on versions.K3s and nodeHealthy.
+func nodeUpgraded(ctx context.Context, api *k3s.APIClient, client *ssh.Client, node config.NodeConfig) (bool, error) {
+	current, err := api.GetNode(ctx, node.Name)
+	if err != nil {
+		return false, err
+	}
+	if current.KubeletVersion != versions.K3s {
+		return false, nil
+	}
+	return nodeHealthy(ctx, api, client, node)
+}
+
+// nodeHealthy reports whether node is Ready and, for a server, its own API
+// server (not just the VIP's current leader) answers /livez. The
+// kubeconfig is re-read each time, since k3s may regenerate it on restart.
+func nodeHealthy(ctx context.Context, api *k3s.APIClient, client *ssh.Client, node config.NodeConfig) (bool, error) {
+	current, err := api.GetNode(ctx, node.Name)
+	if err != nil {
+		return false, err
+	}
+	if !current.Ready {
+		return false, nil
+	}
+	if !node.IsServer() {
+		return true, nil
+	}
+
+	kubeconfig, err := k3s.ExtractKubeconfig(ctx, client, node.Address)
+	if err != nil {
+		return false, err
+	}
+	return k3s.CheckAPIHealth(kubeconfig), nil
+}
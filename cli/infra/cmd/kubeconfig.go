@@ -21,8 +21,8 @@ merges it into your default kubeconfig (respecting $KUBECONFIG, else
 of that name, and switches to it as the active context.
 
 If --node is omitted, connects via the cluster VIP instead of a specific
-node (using the first node's infra.yaml SSH credentials) - kube-vip routes
-this to whichever node currently holds the VIP.
+node (using the first server's infra.yaml SSH credentials) - kube-vip
+routes this to whichever server currently holds the VIP.
 
 Use --output to instead write a standalone kubeconfig file (use "-" for
 stdout).
@@ -56,30 +56,33 @@ func runKubeconfig(cmd *cobra.Command, args []string) error {
 
 	nodeName, _ := cmd.Flags().GetString("node")
 
-	var targetAddr, sshUser, sshPassword string
+	var targetAddr string
+	var creds ssh.Credentials
 	if nodeName == "" {
-		if len(infraCfg.Nodes) == 0 {
-			return fmt.Errorf("no nodes defined in infra.yaml")
+		servers := infraCfg.Servers()
+		if len(servers) == 0 {
+			return fmt.Errorf("no server nodes defined in infra.yaml")
 		}
 		slog.Info("No --node given, connecting via the cluster VIP", "vip", infraCfg.Cluster.VIP)
 		targetAddr = infraCfg.Cluster.VIP
-		sshUser = infraCfg.Nodes[0].SSH.User
-		sshPassword = infraCfg.Nodes[0].SSH.Password
+		creds = servers[0].SSH.Credentials()
 	} else {
 		node := config.FindNodeByName(infraCfg, nodeName)
 		if node == nil {
 			return fmt.Errorf("node '%s' not found in config file", nodeName)
 		}
+		if !node.IsServer() {
+			return fmt.Errorf("node '%s' is an agent - kubeconfig can only be extracted from a server", nodeName)
+		}
 		targetAddr = node.Address
-		sshUser = node.SSH.User
-		sshPassword = node.SSH.Password
+		creds = node.SSH.Credentials()
 	}
 
 	output, _ := cmd.Flags().GetString("output")
 
-	slog.Info("Creating SSH connection", "target", targetAddr, "user", sshUser)
+	slog.Info("Creating SSH connection", "target", targetAddr, "user", creds.User)
 
-	client := ssh.NewClientWithPassword(targetAddr, sshUser, sshPassword)
+	client := ssh.NewClient(targetAddr, creds)
 	if err := client.Connect(ctx); err != nil {
 		slog.Error("SSH connection failed", "target", targetAddr, "error", err)
 		return err
@@ -0,0 +1,14 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var netCmd = &cobra.Command{
+	Use:   "net",
+	Short: "Inspect the local network",
+}
+
+func init() {
+	netCmd.AddCommand(netScanCmd)
+}
@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/netip"
+	"os"
+	"sort"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/liamawhite/homelab/pkg/oui"
+	"github.com/liamawhite/homelab/pkg/probe"
+	"github.com/spf13/cobra"
+)
+
+var netScanCmd = &cobra.Command{
+	Use:   "scan",
+	Short: "List the devices on a LAN subnet",
+	Long: `Sweeps every address in --cidr, forcing ARP resolution, and lists each
+device that answered with its MAC address and the vendor that MAC was
+assigned to - if pkg/oui's table has it, which as committed only covers
+the homelab's own hardware (Raspberry Pi, Hue, network gear) until "make
+gen" fetches the full IEEE registry. Each device is marked as:
+  node        an infra.yaml node, matched by address or recorded MAC (a
+              node found at a different address from its infra.yaml one
+              is flagged)
+  hue-bridge  a Philips Hue bridge, confirmed via its /api/config endpoint
+  unknown     anything else
+
+Handy for finding a freshly flashed Pi's DHCP address: look for an unknown
+device with a Raspberry Pi vendor. --cidr must be a directly attached
+network (at most a /20), as ARP doesn't cross routers; the machine running
+the scan doesn't list itself.
+
+Example:
+  homelab net scan --cidr 192.168.1.0/24
+  homelab net scan --cidr 192.168.1.0/24 --output json`,
+	RunE: runNetScan,
+}
+
+func init() {
+	netScanCmd.Flags().String("cidr", "", "IPv4 subnet to scan, e.g. 192.168.1.0/24")
+	netScanCmd.Flags().StringP("output", "o", "table", "Output format: table or json")
+	netScanCmd.MarkFlagRequired("cidr")
+}
+
+// scanned device kinds.
+const (
+	deviceNode      = "node"
+	deviceHueBridge = "hue-bridge"
+	deviceUnknown   = "unknown"
+)
+
+// scannedDevice is one device found by a net scan.
+type scannedDevice struct {
+	IP     string `json:"ip"`
+	MAC    string `json:"mac"`
+	Vendor string `json:"vendor,omitempty"`
+	Kind   string `json:"kind"`
+	// Name is the infra.yaml node name or the Hue bridge's own name.
+	Name string `json:"name,omitempty"`
+	// BridgeID is set for Hue bridges, as infra.yaml's lumenetes.hue.bridges
+	// identifies them.
+	BridgeID string `json:"bridgeId,omitempty"`
+	// ExpectedAddress is set for a node matched by MAC at an address other
+	// than its infra.yaml one.
+	ExpectedAddress string `json:"expectedAddress,omitempty"`
+}
+
+func runNetScan(cmd *cobra.Command, args []string) error {
+	// As for node status, keep any slog output out of the table.
+	slog.SetDefault(slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	output, _ := cmd.Flags().GetString("output")
+	if output != "table" && output != "json" {
+		return fmt.Errorf("invalid --output %q (must be table or json)", output)
+	}
+	cidr, _ := cmd.Flags().GetString("cidr")
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return fmt.Errorf("invalid --cidr %q: %w", cidr, err)
+	}
+
+	infraCfg, err := config.LoadInfra(cmd)
+	if err != nil {
+		return err
+	}
+
+	if output == "table" {
+		fmt.Fprintf(os.Stderr, "Scanning %s...\n", prefix.Masked())
+	}
+	found, err := probe.Sweep(prefix)
+	if err != nil {
+		return err
+	}
+
+	devices := make([]scannedDevice, 0, len(found))
+	for ip, mac := range found {
+		devices = append(devices, scannedDevice{IP: ip, MAC: mac, Vendor: oui.Vendor(mac), Kind: deviceUnknown})
+	}
+	sort.Slice(devices, func(i, j int) bool {
+		return netip.MustParseAddr(devices[i].IP).Less(netip.MustParseAddr(devices[j].IP))
+	})
+
+	var wg sync.WaitGroup
+	for i := range devices {
+		d := &devices[i]
+		if node := matchNode(infraCfg, d.IP, d.MAC); node != nil {
+			d.Kind = deviceNode
+			d.Name = node.Name
+			if node.Address != d.IP {
+				d.ExpectedAddress = node.Address
+			}
+			continue
+		}
+		// Anything else could be a Hue bridge - not every bridge's MAC is
+		// one the vendor lookup knows - so ask them all.
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if bridge, ok := probe.Hue(context.Background(), d.IP); ok {
+				d.Kind = deviceHueBridge
+				d.Name = bridge.Name
+				d.BridgeID = bridge.ID
+			}
+		}()
+	}
+	wg.Wait()
+
+	if output == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(devices)
+	}
+	return printScannedDevices(devices)
+}
+
+// matchNode returns the infra.yaml node at ip, or failing that the one whose
+// recorded MAC is mac.
+func matchNode(infraCfg *config.InfraConfig, ip, mac string) *config.NodeConfig {
+	if node := config.FindNodeByAddress(infraCfg, ip); node != nil {
+		return node
+	}
+	for i, node := range infraCfg.Nodes {
+		if node.MAC != "" && config.SameMAC(node.MAC, mac) {
+			return &infraCfg.Nodes[i]
+		}
+	}
+	return nil
+}
+
+// printScannedDevices writes devices as a table to stdout.
+func printScannedDevices(devices []scannedDevice) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "IP\tMAC\tVENDOR\tKIND\tNAME")
+	for _, d := range devices {
+		name := d.Name
+		if d.ExpectedAddress != "" {
+			name += fmt.Sprintf(" (infra.yaml address %s)", d.ExpectedAddress)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", d.IP, d.MAC, dashIfEmpty(d.Vendor), d.Kind, dashIfEmpty(name))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("\n%d devices found\n", len(devices))
+	return nil
+}
+
+func dashIfEmpty(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/cobra"
+)
+
+var nodeExporterCmd = &cobra.Command{
+	Use:   "node-exporter",
+	Short: "Export node health as Prometheus metrics",
+}
+
+var nodeExporterServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve node status checks as Prometheus metrics",
+	Long: `Runs the same checks as "homelab node status" against every node in
+infra.yaml every --interval, and serves the latest results on /metrics:
+
+  homelab_node_check{node,role,check}  1 if the check passed, else 0, for
+      check = ping, ssh, host_key, bootstrapped, k3s_installed and (servers
+      only) api
+  homelab_node_healthy{node,role}      1 if every check passed
+  homelab_node_pending_upgrades{node}  OS package upgrades waiting (absent
+      if unknown)
+  homelab_node_info{node,role,address,mac}
+  homelab_node_checks_last_run_timestamp_seconds
+  homelab_node_checks_duration_seconds
+
+It's meant to run off-cluster - on a laptop or any always-on machine on the
+LAN - so it keeps reporting on nodes whose in-cluster exporters (or the
+whole cluster) are down. Unlike node status, it never writes to infra.yaml.
+
+Example:
+  homelab node-exporter serve
+  homelab node-exporter serve --listen :9120 --interval 1m`,
+	RunE: runNodeExporterServe,
+}
+
+func init() {
+	nodeExporterServeCmd.Flags().String("listen", ":9120", "Address to serve /metrics on")
+	nodeExporterServeCmd.Flags().Duration("interval", 30*time.Second, "How often to re-run the checks")
+	nodeExporterCmd.AddCommand(nodeExporterServeCmd)
+}
+
+func runNodeExporterServe(cmd *cobra.Command, args []string) error {
+	listen, _ := cmd.Flags().GetString("listen")
+	interval, _ := cmd.Flags().GetDuration("interval")
+
+	infraCfg, err := config.LoadInfra(cmd)
+	if err != nil {
+		return err
+	}
+
+	// cli/pkg/ssh logs every connection at info, which every round would
+	// flood the log with; keep those to warnings and above.
+	logger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelWarn})))
+
+	collector := &nodeStatusCollector{}
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	httpServer := &http.Server{Addr: listen, Handler: mux}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		for {
+			start := time.Now()
+			statuses := collectNodeStatuses(infraCfg)
+			collector.update(statuses, start, time.Since(start))
+			logger.Info("Checked nodes", "nodes", len(statuses), "duration", time.Since(start).Round(time.Millisecond))
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+		}
+	}()
+
+	errCh := make(chan error, 1)
+	go func() {
+		logger.Info("Serving node metrics", "address", listen, "interval", interval)
+		errCh <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	nodeCheckDesc = prometheus.NewDesc(
+		"homelab_node_check", "Whether a node status check passed.",
+		[]string{"node", "role", "check"}, nil,
+	)
+	nodeHealthyDesc = prometheus.NewDesc(
+		"homelab_node_healthy", "Whether every node status check passed.",
+		[]string{"node", "role"}, nil,
+	)
+	nodePendingUpgradesDesc = prometheus.NewDesc(
+		"homelab_node_pending_upgrades", "OS package upgrades waiting for homelab node patch.",
+		[]string{"node"}, nil,
+	)
+	nodeInfoDesc = prometheus.NewDesc(
+		"homelab_node_info", "Node identity from infra.yaml and the neighbor table, constant value 1.",
+		[]string{"node", "role", "address", "mac"}, nil,
+	)
+	nodeChecksLastRunDesc = prometheus.NewDesc(
+		"homelab_node_checks_last_run_timestamp_seconds", "Unix timestamp the latest round of checks started.",
+		nil, nil,
+	)
+	nodeChecksDurationDesc = prometheus.NewDesc(
+		"homelab_node_checks_duration_seconds", "How long the latest round of checks took.",
+		nil, nil,
+	)
+)
+
+// nodeStatusCollector serves the latest round of node status checks. The
+// checks are far too slow (SSH logins, API calls) to run per scrape, so
+// they run on their own interval and a scrape just reads the last result.
+type nodeStatusCollector struct {
+	mu       sync.Mutex
+	statuses []nodeStatus
+	lastRun  time.Time
+	duration time.Duration
+}
+
+var _ prometheus.Collector = (*nodeStatusCollector)(nil)
+
+func (c *nodeStatusCollector) update(statuses []nodeStatus, lastRun time.Time, duration time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.statuses, c.lastRun, c.duration = statuses, lastRun, duration
+}
+
+func (c *nodeStatusCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- nodeCheckDesc
+	ch <- nodeHealthyDesc
+	ch <- nodePendingUpgradesDesc
+	ch <- nodeInfoDesc
+	ch <- nodeChecksLastRunDesc
+	ch <- nodeChecksDurationDesc
+}
+
+// Collect emits nothing until the first round of checks has finished.
+func (c *nodeStatusCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.lastRun.IsZero() {
+		return
+	}
+
+	for _, s := range c.statuses {
+		role := string(s.Role)
+		type check struct {
+			name   string
+			passed bool
+		}
+		checks := []check{
+			{"ping", s.Ping},
+			{"ssh", s.SSH},
+			{"host_key", !s.HostKeyBad},
+			{"bootstrapped", s.Bootstrapped},
+			{"k3s_installed", s.K3sInstalled},
+		}
+		if s.Role != config.RoleAgent {
+			checks = append(checks, check{"api", s.APIHealthy})
+		}
+		for _, check := range checks {
+			ch <- prometheus.MustNewConstMetric(nodeCheckDesc, prometheus.GaugeValue, boolToFloat(check.passed), s.Name, role, check.name)
+		}
+
+		ch <- prometheus.MustNewConstMetric(nodeHealthyDesc, prometheus.GaugeValue, boolToFloat(s.Status == "healthy"), s.Name, role)
+		if s.Pending >= 0 {
+			ch <- prometheus.MustNewConstMetric(nodePendingUpgradesDesc, prometheus.GaugeValue, float64(s.Pending), s.Name)
+		}
+		ch <- prometheus.MustNewConstMetric(nodeInfoDesc, prometheus.GaugeValue, 1, s.Name, role, s.Address, s.MAC)
+	}
+
+	ch <- prometheus.MustNewConstMetric(nodeChecksLastRunDesc, prometheus.GaugeValue, float64(c.lastRun.Unix()))
+	ch <- prometheus.MustNewConstMetric(nodeChecksDurationDesc, prometheus.GaugeValue, c.duration.Seconds())
+}
+
+// boolToFloat converts a check result to the 0/1 a Prometheus gauge needs.
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/liamawhite/homelab/pkg/k3s"
+	"github.com/liamawhite/homelab/pkg/raspberry"
+	"github.com/liamawhite/homelab/pkg/ssh"
+	"github.com/spf13/cobra"
+)
+
+var nodePatchCmd = &cobra.Command{
+	Use:   "patch",
+	Short: "Upgrade OS packages on nodes, with rolling reboots",
+	Long: `Upgrades the OS packages on one node (--node) or every node (--all), one
+node at a time, in infra.yaml order:
+  1. apt-get update and full-upgrade over SSH
+  2. if the upgrade needs a reboot (a package asked for one, or a newer
+     kernel was installed), cordon the node and drain its pods through the
+     Kubernetes API, reboot it, wait for it to be Ready - and, for servers,
+     for its own API server to answer /livez - then uncordon it
+
+Only one node is ever down at a time, so the cluster keeps etcd quorum and
+its API throughout. Nodes that aren't apt-based are skipped. The rollout
+stops at the first failure, leaving that node cordoned for inspection -
+re-run once it's fixed.
+
+"homelab node status" shows how many package upgrades each node has
+pending.
+
+Example:
+  homelab node patch --all
+  homelab node patch --node pi-1`,
+	RunE: runNodePatch,
+}
+
+func init() {
+	nodePatchCmd.Flags().String("node", "", "Node name from infra.yaml")
+	nodePatchCmd.Flags().Bool("all", false, "Patch every node in infra.yaml")
+	nodePatchCmd.Flags().Duration("drain-timeout", 5*time.Minute, "How long to wait for a node's pods to be evicted")
+	nodePatchCmd.Flags().Duration("ready-timeout", 10*time.Minute, "How long to wait for a rebooted node to become Ready")
+	nodePatchCmd.MarkFlagsOneRequired("node", "all")
+	nodePatchCmd.MarkFlagsMutuallyExclusive("node", "all")
+}
+
+func runNodePatch(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	infraCfg, err := config.LoadInfra(cmd)
+	if err != nil {
+		return err
+	}
+	if infraCfg.Cluster.VIP == "" {
+		return fmt.Errorf("cluster.vip is not set in infra.yaml")
+	}
+
+	nodes, err := selectNodes(cmd, infraCfg)
+	if err != nil {
+		return err
+	}
+	drainTimeout, _ := cmd.Flags().GetDuration("drain-timeout")
+	readyTimeout, _ := cmd.Flags().GetDuration("ready-timeout")
+
+	// As for k3s upgrade, cordon/drain/readiness go through the VIP so they
+	// keep working while the node being rebooted is down.
+	api, err := clusterAPIClient(ctx, infraCfg)
+	if err != nil {
+		return err
+	}
+
+	rebooted := 0
+	for _, node := range nodes {
+		didReboot, err := patchNode(ctx, api, node, drainTimeout, readyTimeout)
+		if err != nil {
+			return fmt.Errorf("patching %s failed, aborting rollout: %w", node.Name, err)
+		}
+		if didReboot {
+			rebooted++
+		}
+	}
+
+	slog.Info("Patching complete", "nodes", len(nodes), "rebooted", rebooted)
+	return nil
+}
+
+// patchNode upgrades node's packages and, if that needs a reboot, runs it
+// through cordon, drain, reboot, wait and uncordon. On error after the
+// cordon, the node is deliberately left cordoned.
+func patchNode(ctx context.Context, api *k3s.APIClient, node config.NodeConfig, drainTimeout, readyTimeout time.Duration) (bool, error) {
+	client := ssh.NewClient(node.Address, node.SSH.Credentials())
+	if err := client.Connect(ctx); err != nil {
+		return false, fmt.Errorf("failed to connect: %w", err)
+	}
+	defer client.Close()
+
+	if !raspberry.HasApt(client) {
+		slog.Info("Node isn't apt-based, skipping", "node", node.Name)
+		return false, nil
+	}
+
+	pending, err := raspberry.PendingUpgrades(client)
+	if err != nil {
+		return false, err
+	}
+	slog.Info("Upgrading packages", "node", node.Name, "pending_before_refresh", pending)
+	if err := raspberry.Upgrade(client); err != nil {
+		return false, err
+	}
+
+	needsReboot, err := raspberry.RebootRequired(client)
+	if err != nil {
+		return false, err
+	}
+	if !needsReboot {
+		slog.Info("Packages upgraded, no reboot needed", "node", node.Name)
+		return false, nil
+	}
+
+	if err := cordonAndDrain(ctx, api, node, drainTimeout); err != nil {
+		return false, err
+	}
+
+	// Waiting for a new boot ID, rather than just for SSH to answer, stops
+	// a node that hasn't gone down yet from passing the Ready check below
+	// as it was before the reboot - and the rollout moving on to reboot
+	// the next node while this one is still going down.
+	bootID, err := client.BootID()
+	if err != nil {
+		return false, err
+	}
+	slog.Info("Rebooting node", "node", node.Name)
+	if err := client.Reboot(); err != nil {
+		return false, fmt.Errorf("failed to reboot: %w", err)
+	}
+	if err := client.WaitForNewBoot(bootID, readyTimeout); err != nil {
+		return false, fmt.Errorf("failed to wait for reboot: %w", err)
+	}
+
+	err = waitUntil(node.Name+" to be Ready", readyTimeout, upgradePollInterval, func() (bool, error) {
+		return nodeHealthy(ctx, api, client, node)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	slog.Info("Uncordoning node", "node", node.Name)
+	return true, api.SetUnschedulable(ctx, node.Name, false)
+}
@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/liamawhite/homelab/pkg/k3s"
+	"github.com/liamawhite/homelab/pkg/ssh"
+	"github.com/spf13/cobra"
+)
+
+var nodeRemoveCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Take a node out of the cluster",
+	Long: `Removes a node from the cluster - e.g. a dead Pi being replaced - in the
+order that keeps data and quorum safe:
+
+  1. cordon it, and drain its pods (force-deleting them if the node is
+     already NotReady, since no kubelet is left to finish an eviction)
+  2. ask Longhorn to evict the node's replicas, and wait for them to be
+     rebuilt elsewhere (skipped if the node is down - its replicas are
+     rebuilt from the healthy copies instead)
+  3. for a server, have the remaining servers remove its etcd member
+  4. delete the Node object (and Longhorn's record of it)
+  5. with --uninstall, run the k3s uninstall script on it over SSH
+
+Before each destructive step it prints what it's about to do and the
+state it's acting on, and asks for confirmation (--yes skips the prompts).
+
+The node stays in infra.yaml; remove it there once you're done.
+
+Example:
+  homelab node remove --node pi-2
+  homelab node remove --node pi-2 --uninstall --yes`,
+	RunE: runNodeRemove,
+}
+
+func init() {
+	nodeRemoveCmd.Flags().String("node", "", "Node name from infra.yaml (required)")
+	nodeRemoveCmd.Flags().Bool("uninstall", false, "Also uninstall k3s from the node over SSH")
+	nodeRemoveCmd.Flags().Bool("yes", false, "Don't prompt before each destructive step")
+	nodeRemoveCmd.Flags().Duration("drain-timeout", 5*time.Minute, "How long to wait for the node's pods to be evicted")
+	nodeRemoveCmd.Flags().Duration("replica-timeout", 30*time.Minute, "How long to wait for Longhorn to move the node's replicas")
+	_ = nodeRemoveCmd.MarkFlagRequired("node")
+}
+
+func runNodeRemove(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	infraCfg, err := config.LoadInfra(cmd)
+	if err != nil {
+		return err
+	}
+	nodeName, _ := cmd.Flags().GetString("node")
+	node := config.FindNodeByName(infraCfg, nodeName)
+	if node == nil {
+		return fmt.Errorf("no node named %q in infra.yaml", nodeName)
+	}
+
+	yes, _ := cmd.Flags().GetBool("yes")
+	uninstall, _ := cmd.Flags().GetBool("uninstall")
+	drainTimeout, _ := cmd.Flags().GetDuration("drain-timeout")
+	replicaTimeout, _ := cmd.Flags().GetDuration("replica-timeout")
+	confirm := confirmer(yes)
+
+	// Everything goes to one of the remaining servers directly, not the
+	// VIP: kube-vip may be serving that from the node being removed, and
+	// it moves (dropping connections) once that node goes away.
+	var remaining []config.NodeConfig
+	for _, s := range infraCfg.Servers() {
+		if s.Name != node.Name {
+			remaining = append(remaining, s)
+		}
+	}
+	if len(remaining) == 0 {
+		return fmt.Errorf("%s is the only server - removing it would remove the cluster", node.Name)
+	}
+	api, err := serverAPIClient(ctx, remaining, "")
+	if err != nil {
+		return err
+	}
+
+	current, err := api.GetNode(ctx, node.Name)
+	if err != nil {
+		return err
+	}
+	pods, err := api.CountEvictablePods(ctx, node.Name)
+	if err != nil {
+		return err
+	}
+
+	// 1. Cordon and drain.
+	if err := confirm(fmt.Sprintf("Cordon and drain %s (role %s, ready %t, %d pods to move)", node.Name, node.Role, current.Ready, pods)); err != nil {
+		return err
+	}
+	if err := api.SetUnschedulable(ctx, node.Name, true); err != nil {
+		return err
+	}
+	if current.Ready {
+		drainCtx, cancel := context.WithTimeout(ctx, drainTimeout)
+		err = api.Drain(drainCtx, node.Name)
+		cancel()
+	} else {
+		err = api.ForceDeletePods(ctx, node.Name)
+	}
+	if err != nil {
+		return fmt.Errorf("drain failed: %w", err)
+	}
+
+	// 2. Longhorn replicas.
+	replicas, err := api.LonghornReplicasOnNode(ctx, node.Name)
+	if err != nil {
+		return err
+	}
+	if replicas > 0 {
+		if err := confirm(fmt.Sprintf("Evict %d Longhorn replicas from %s", replicas, node.Name)); err != nil {
+			return err
+		}
+		if _, err := api.RequestLonghornEviction(ctx, node.Name); err != nil {
+			return err
+		}
+		if current.Ready {
+			if err := waitReplicasEvicted(ctx, api, node.Name, replicaTimeout); err != nil {
+				return err
+			}
+		} else {
+			slog.Warn("Node is down, not waiting for eviction - Longhorn rebuilds its replicas from healthy copies once the node is deleted", "node", node.Name, "replicas", replicas)
+		}
+	}
+
+	// 3. etcd membership.
+	if node.IsServer() {
+		if err := confirm(fmt.Sprintf("Remove %s's etcd member, leaving %d servers (%s)", node.Name, len(remaining), quorumNote(len(remaining)))); err != nil {
+			return err
+		}
+		if err := k3s.RequestEtcdMemberRemoval(ctx, api, node.Name); err != nil {
+			return err
+		}
+		if err := waitEtcdMemberRemoved(ctx, api, node.Name, 2*time.Minute); err != nil {
+			return err
+		}
+	}
+
+	// 4. The Node object itself.
+	if err := confirm(fmt.Sprintf("Delete Node %s", node.Name)); err != nil {
+		return err
+	}
+	if err := api.DeleteNode(ctx, node.Name); err != nil {
+		return err
+	}
+	if err := api.DeleteLonghornNode(ctx, node.Name); err != nil {
+		slog.Warn("Failed to remove node from Longhorn - delete it in the Longhorn UI", "node", node.Name, "error", err)
+	}
+
+	// 5. Optionally, k3s itself.
+	if uninstall {
+		if err := confirm(fmt.Sprintf("Uninstall k3s from %s (%s) over SSH", node.Name, node.Address)); err != nil {
+			return err
+		}
+		client := ssh.NewClient(node.Address, node.SSH.Credentials())
+		if err := client.Connect(ctx); err != nil {
+			return fmt.Errorf("node removed from the cluster, but failed to connect to uninstall k3s: %w", err)
+		}
+		defer client.Close()
+		if err := k3s.NewInstaller(client, node.Role, nil).UninstallK3s(ctx); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("%s removed from the cluster - remove it from infra.yaml too\n", node.Name)
+	return nil
+}
+
+// confirmer returns a func that prints a summary of the step about to run
+// and, unless yes is set, asks for confirmation on stdin - returning an
+// error (which aborts the command) if it isn't given.
+func confirmer(yes bool) func(summary string) error {
+	in := bufio.NewReader(os.Stdin)
+	return func(summary string) error {
+		if yes {
+			fmt.Printf("==> %s\n", summary)
+			return nil
+		}
+		fmt.Printf("==> %s. Proceed? [y/N] ", summary)
+		answer, _ := in.ReadString('\n')
+		if a := strings.ToLower(strings.TrimSpace(answer)); a != "y" && a != "yes" {
+			return fmt.Errorf("aborted")
+		}
+		return nil
+	}
+}
+
+// quorumNote describes how many server failures an etcd cluster of n
+// members can survive.
+func quorumNote(n int) string {
+	tolerated := (n - 1) / 2
+	if tolerated == 0 {
+		return "no server failures tolerated"
+	}
+	return fmt.Sprintf("tolerates %d server failure(s)", tolerated)
+}
+
+// waitReplicasEvicted polls until Longhorn has no replicas left on
+// nodeName.
+func waitReplicasEvicted(ctx context.Context, api *k3s.APIClient, nodeName string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		n, err := api.LonghornReplicasOnNode(ctx, nodeName)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%d Longhorn replicas still on %s after %s", n, nodeName, timeout)
+		}
+		slog.Info("Waiting for Longhorn replicas to move", "node", nodeName, "remaining", n)
+		time.Sleep(10 * time.Second)
+	}
+}
+
+// waitEtcdMemberRemoved polls until k3s confirms nodeName's etcd member
+// is gone.
+func waitEtcdMemberRemoved(ctx context.Context, api *k3s.APIClient, nodeName string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		removed, err := k3s.EtcdMemberRemoved(ctx, api, nodeName)
+		if err != nil {
+			return err
+		}
+		if removed {
+			slog.Info("etcd member removed", "node", nodeName)
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("etcd member for %s not removed within %s", nodeName, timeout)
+		}
+		time.Sleep(5 * time.Second)
+	}
+}
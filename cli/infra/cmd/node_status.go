@@ -2,14 +2,17 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log/slog"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"text/tabwriter"
+	"time"
 
 	"github.com/liamawhite/homelab/pkg/config"
 	"github.com/liamawhite/homelab/pkg/k3s"
@@ -21,32 +24,56 @@ import (
 
 var nodeStatusCmd = &cobra.Command{
 	Use:   "status",
-	Short: "Show health and MAC address for each node",
+	Short: "Show health, MAC address and pending upgrades for each node",
 	Long: `Checks each node defined in infra.yaml: whether it responds on the
-network, whether SSH login succeeds (using the ssh.user/ssh.password from
+network, whether SSH login succeeds (using the ssh credentials from
 that node's infra.yaml entry), whether the "pi bootstrap" step has been run,
-whether k3s is installed, and whether that node's own Kubernetes API server
-responds (dialed directly at its own address, not the VIP, and not another
-node's). STATUS is "healthy" if all checks pass, otherwise it lists which
+whether k3s is installed, and - for servers - whether that node's own
+Kubernetes API server responds (dialed directly at its own address, not the
+VIP, and not another node's). STATUS is "healthy" if all checks pass, otherwise it lists which
 failed.
 
-Also reports the node's MAC address and any other IPs seen advertising it.
+PENDING is how many OS package upgrades the node has waiting for
+"homelab node patch" ("-" if it couldn't be checked, or isn't apt-based).
+
+Also reports the node's MAC address and any other IPs seen advertising it,
+recording any newly seen MAC in the node's infra.yaml entry for
+"homelab node wake".
+
+--watch re-runs the checks every --interval, redrawing the table (or, with
+--output json, printing one JSON array per line). For the same checks as
+Prometheus metrics, see "homelab node-exporter serve".
 
 Example:
-  homelab node status`,
+  homelab node status
+  homelab node status --watch --interval 10s
+  homelab node status --output json`,
 	RunE: runNodeStatus,
 }
 
+func init() {
+	nodeStatusCmd.Flags().StringP("output", "o", "table", "Output format: table or json")
+	nodeStatusCmd.Flags().Bool("watch", false, "Re-run the checks every --interval until interrupted")
+	nodeStatusCmd.Flags().Duration("interval", 30*time.Second, "How often --watch re-runs the checks")
+}
+
 type nodeStatus struct {
-	Name         string
-	Address      string
-	MAC          string
-	Ping         bool
-	SSH          bool
-	Bootstrapped bool
-	K3sInstalled bool
-	APIHealthy   bool
-	OtherIPs     string
+	Name         string          `json:"name"`
+	Address      string          `json:"address"`
+	Role         config.NodeRole `json:"role"`
+	MAC          string          `json:"mac"`
+	Ping         bool            `json:"ping"`
+	SSH          bool            `json:"ssh"`
+	HostKeyBad   bool            `json:"hostKeyChanged"`
+	Bootstrapped bool            `json:"bootstrapped"`
+	K3sInstalled bool            `json:"k3sInstalled"`
+	APIHealthy   bool            `json:"apiHealthy"`
+	// Pending is the node's pending package upgrade count, or -1 if
+	// unknown.
+	Pending  int    `json:"pendingUpgrades"`
+	OtherIPs string `json:"otherIPs"`
+	// Status is status(), filled in once every check has run.
+	Status string `json:"status"`
 }
 
 // status renders a single health summary: "healthy" if every check passed,
@@ -56,13 +83,15 @@ func (s nodeStatus) status() string {
 	var problems []string
 	if !s.Ping {
 		problems = append(problems, "unreachable")
+	} else if s.HostKeyBad {
+		problems = append(problems, "ssh host key changed")
 	} else if !s.SSH {
 		problems = append(problems, "ssh failed")
 	} else if !s.Bootstrapped {
 		problems = append(problems, "not bootstrapped")
 	} else if !s.K3sInstalled {
 		problems = append(problems, "k3s not installed")
-	} else if !s.APIHealthy {
+	} else if s.Role != config.RoleAgent && !s.APIHealthy {
 		problems = append(problems, "kube api unreachable")
 	}
 
@@ -77,25 +106,36 @@ func (s nodeStatus) status() string {
 type nodeChecks struct {
 	Bootstrapped bool
 	APIHealthy   bool
+	// Pending is the node's pending package upgrade count, or -1 if
+	// unknown.
+	Pending int
 }
 
 // checkNode opens an authenticated SSH connection and runs the bootstrap
 // check (reusing the same file/package checks Provision uses, so
 // "bootstrapped" here always means the same thing it does to
-// `pi bootstrap`) and, if k3s is installed, a direct check that this
-// specific node's own Kubernetes API is responding - not just the VIP or
-// another node's.
+// `pi bootstrap`) and, if k3s is installed on a server, a direct check
+// that this specific node's own Kubernetes API is responding - not just the
+// VIP or another node's. Agents run no API server, so it's skipped there.
 func checkNode(node config.NodeConfig, k3sInstalled bool) nodeChecks {
-	client := ssh.NewClientWithPassword(node.Address, node.SSH.User, node.SSH.Password)
+	client := ssh.NewClient(node.Address, node.SSH.Credentials())
 	if err := client.Connect(context.Background()); err != nil {
-		return nodeChecks{}
+		return nodeChecks{Pending: -1}
 	}
 	defer client.Close()
+	// A failed check is reported as the check's result, and the node
+	// exporter runs them all every --interval.
+	client.QuietExits = true
 
-	var checks nodeChecks
-	checks.Bootstrapped = raspberry.NewProvisioner(client).CheckBootstrapped().Bootstrapped()
+	checks := nodeChecks{Pending: -1}
+	if raspberry.HasApt(client) {
+		if pending, err := raspberry.PendingUpgrades(client); err == nil {
+			checks.Pending = pending
+		}
+	}
+	checks.Bootstrapped = raspberry.NewProvisioner(client, node).CheckBootstrapped().Bootstrapped()
 
-	if k3sInstalled {
+	if k3sInstalled && node.IsServer() {
 		if kubeconfig, err := k3s.ExtractKubeconfig(context.Background(), client, node.Address); err == nil {
 			checks.APIHealthy = k3s.CheckAPIHealth(kubeconfig)
 		}
@@ -109,11 +149,57 @@ func runNodeStatus(cmd *cobra.Command, args []string) error {
 	// otherwise interleave with this command's table output.
 	slog.SetDefault(slog.New(slog.NewTextHandler(io.Discard, nil)))
 
-	infraCfg, err := config.LoadInfra(cmd)
+	output, _ := cmd.Flags().GetString("output")
+	if output != "table" && output != "json" {
+		return fmt.Errorf("invalid --output %q (must be table or json)", output)
+	}
+	watch, _ := cmd.Flags().GetBool("watch")
+	interval, _ := cmd.Flags().GetDuration("interval")
+
+	configFile, err := config.ResolveConfigPath(cmd)
 	if err != nil {
 		return err
 	}
+	infraCfg, err := config.LoadFromFile(configFile)
+	if err != nil {
+		return err
+	}
+
+	for {
+		statuses := collectNodeStatuses(infraCfg)
+
+		if watch && output == "table" {
+			// Clear the screen and home the cursor, as watch(1) does.
+			fmt.Print("\033[H\033[2J")
+			fmt.Printf("Every %s: %s\n\n", interval, time.Now().Format(time.DateTime))
+		}
+		if output == "json" {
+			enc := json.NewEncoder(os.Stdout)
+			// --watch emits one document per line, so the stream can be
+			// piped through jq or similar.
+			if !watch {
+				enc.SetIndent("", "  ")
+			}
+			if err := enc.Encode(statuses); err != nil {
+				return err
+			}
+		} else if err := printNodeStatuses(statuses); err != nil {
+			return err
+		}
+
+		if err := recordMACs(configFile, infraCfg, statuses, output == "table"); err != nil {
+			return err
+		}
+		if !watch {
+			return nil
+		}
+		time.Sleep(interval)
+	}
+}
 
+// collectNodeStatuses runs every check against every node in infraCfg in
+// parallel, returning the results in infra.yaml order.
+func collectNodeStatuses(infraCfg *config.InfraConfig) []nodeStatus {
 	statuses := make([]nodeStatus, len(infraCfg.Nodes))
 
 	var wg sync.WaitGroup
@@ -133,11 +219,11 @@ func runNodeStatus(cmd *cobra.Command, args []string) error {
 			}()
 			go func() {
 				defer inner.Done()
-				sshResult = probe.SSH(node.Address, node.SSH.User, node.SSH.Password)
+				sshResult = probe.SSH(node.Address, node.SSH.Credentials())
 			}()
 			inner.Wait()
 
-			var checks nodeChecks
+			checks := nodeChecks{Pending: -1}
 			if sshResult.Authenticated {
 				checks = checkNode(node, sshResult.K3sInstalled)
 			}
@@ -145,11 +231,14 @@ func runNodeStatus(cmd *cobra.Command, args []string) error {
 			statuses[i] = nodeStatus{
 				Name:         node.Name,
 				Address:      node.Address,
+				Role:         node.Role,
 				MAC:          result.MAC,
 				Ping:         result.Reachable,
 				SSH:          sshResult.Authenticated,
+				HostKeyBad:   sshResult.HostKeyMismatch,
 				Bootstrapped: checks.Bootstrapped,
 				APIHealthy:   checks.APIHealthy,
+				Pending:      checks.Pending,
 				K3sInstalled: sshResult.K3sInstalled,
 			}
 		}(i, node)
@@ -163,22 +252,68 @@ func runNodeStatus(cmd *cobra.Command, args []string) error {
 
 	for i := range statuses {
 		statuses[i].OtherIPs = otherIPs(macToIPs, statuses[i].MAC, statuses[i].Address)
+		statuses[i].Status = statuses[i].status()
 	}
+	return statuses
+}
 
+// printNodeStatuses writes statuses as a table to stdout.
+func printNodeStatuses(statuses []nodeStatus) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-	fmt.Fprintln(w, "NODE\tIP\tMAC\tOTHER IPS\tSTATUS")
+	fmt.Fprintln(w, "NODE\tROLE\tIP\tMAC\tOTHER IPS\tPENDING\tSTATUS")
 	for _, s := range statuses {
 		mac := s.MAC
 		if mac == "" {
 			mac = "-"
 		}
 
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", s.Name, s.Address, mac, s.OtherIPs, s.status())
-	}
+		pending := "-"
+		if s.Pending >= 0 {
+			pending = strconv.Itoa(s.Pending)
+		}
 
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", s.Name, s.Role, s.Address, mac, s.OtherIPs, pending, s.Status)
+	}
 	return w.Flush()
 }
 
+// recordMACs saves each node's discovered MAC to infra.yaml where it
+// differs from what's recorded there. A node that didn't resolve keeps its
+// recorded MAC - it's most likely just powered off, which is exactly when
+// "node wake" needs it.
+func recordMACs(configFile string, infraCfg *config.InfraConfig, statuses []nodeStatus, announce bool) error {
+	macs := map[string]string{}
+	for i, s := range statuses {
+		if s.MAC != "" && !config.SameMAC(s.MAC, infraCfg.Nodes[i].MAC) {
+			macs[s.Name] = s.MAC
+		}
+	}
+	if len(macs) == 0 {
+		return nil
+	}
+
+	if err := config.SetNodeMACs(configFile, macs); err != nil {
+		return err
+	}
+	// Keep what's recorded in step with the file, so --watch doesn't
+	// re-record the same MACs every round.
+	for i := range infraCfg.Nodes {
+		if mac, ok := macs[infraCfg.Nodes[i].Name]; ok {
+			infraCfg.Nodes[i].MAC = mac
+		}
+	}
+	if !announce {
+		return nil
+	}
+	names := make([]string, 0, len(macs))
+	for name := range macs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Printf("\nRecorded MAC addresses in %s for: %s\n", configFile, strings.Join(names, ", "))
+	return nil
+}
+
 // otherIPs returns the other IPs (besides self) currently seen advertising
 // mac in the local neighbor table, joined for display, or "-" if none.
 func otherIPs(macToIPs map[string][]string, mac, self string) string {
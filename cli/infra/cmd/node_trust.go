@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/liamawhite/homelab/pkg/ssh"
+	"github.com/spf13/cobra"
+)
+
+var nodeTrustCmd = &cobra.Command{
+	Use:   "trust",
+	Short: "Re-pin a node's SSH host key",
+	Long: `Fetches the SSH host key a node currently presents and pins it in the
+known_hosts file next to infra.yaml, replacing the previously pinned key.
+
+Every SSH connection pins a node's key the first time it connects, and
+fails from then on if the node presents a different one. Reflashing a
+node's SD card generates a new host key, so run this afterwards to accept
+it - after checking the printed fingerprint is the one you expect (e.g.
+against 'ssh-keygen -lf /etc/ssh/ssh_host_ed25519_key.pub' on the node's
+console).
+
+Example:
+  homelab node trust --node pi-1`,
+	RunE: runNodeTrust,
+}
+
+func init() {
+	nodeTrustCmd.Flags().String("node", "", "Node name from infra.yaml (required)")
+	_ = nodeTrustCmd.MarkFlagRequired("node")
+}
+
+func runNodeTrust(cmd *cobra.Command, args []string) error {
+	configFile, err := config.ResolveConfigPath(cmd)
+	if err != nil {
+		return err
+	}
+	infraCfg, err := config.LoadFromFile(configFile)
+	if err != nil {
+		return err
+	}
+
+	nodeName, _ := cmd.Flags().GetString("node")
+	node := config.FindNodeByName(infraCfg, nodeName)
+	if node == nil {
+		return fmt.Errorf("no node named %q in infra.yaml", nodeName)
+	}
+
+	// Port 22 is what ssh.Client always dials, so it's what gets pinned.
+	kh := &ssh.KnownHosts{Path: knownHostsPath(configFile)}
+	previous, current, err := kh.Trust(node.Address, 22)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(previous) == 0:
+		fmt.Printf("%s (%s): pinned %s\n", node.Name, node.Address, current)
+	case len(previous) == 1 && previous[0] == current:
+		fmt.Printf("%s (%s): %s already pinned, unchanged\n", node.Name, node.Address, current)
+	default:
+		fmt.Printf("%s (%s): replaced %s with %s\n", node.Name, node.Address, strings.Join(previous, ", "), current)
+	}
+	return nil
+}
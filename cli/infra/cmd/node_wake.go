@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/liamawhite/homelab/pkg/probe"
+	"github.com/liamawhite/homelab/pkg/wol"
+	"github.com/spf13/cobra"
+)
+
+var nodeWakeCmd = &cobra.Command{
+	Use:   "wake",
+	Short: "Power on nodes with Wake-on-LAN",
+	Long: `Sends a Wake-on-LAN magic packet to one node (--node) or every node
+(--all), to the MAC address recorded in its infra.yaml entry - which
+"homelab node status" fills in whenever it sees the node on the network.
+Mostly for the x86 node, which unlike the Pis doesn't power itself back on
+after an outage.
+
+With --wait, keeps resending until each node accepts an SSH login.
+
+Example:
+  homelab node wake --node nuc-0 --wait
+  homelab node wake --all`,
+	RunE: runNodeWake,
+}
+
+func init() {
+	nodeWakeCmd.Flags().String("node", "", "Node name from infra.yaml")
+	nodeWakeCmd.Flags().Bool("all", false, "Wake every node in infra.yaml")
+	nodeWakeCmd.Flags().String("broadcast", wol.DefaultBroadcast, "Address to send the magic packets to")
+	nodeWakeCmd.Flags().Bool("wait", false, "Wait until each node accepts an SSH login")
+	nodeWakeCmd.Flags().Duration("wait-timeout", 5*time.Minute, "How long --wait waits for each node")
+	nodeWakeCmd.MarkFlagsOneRequired("node", "all")
+	nodeWakeCmd.MarkFlagsMutuallyExclusive("node", "all")
+}
+
+func runNodeWake(cmd *cobra.Command, args []string) error {
+	infraCfg, err := config.LoadInfra(cmd)
+	if err != nil {
+		return err
+	}
+
+	nodes, err := selectNodes(cmd, infraCfg)
+	if err != nil {
+		return err
+	}
+	broadcast, _ := cmd.Flags().GetString("broadcast")
+	wait, _ := cmd.Flags().GetBool("wait")
+	waitTimeout, _ := cmd.Flags().GetDuration("wait-timeout")
+
+	for _, node := range nodes {
+		if node.MAC == "" {
+			return fmt.Errorf("no MAC address recorded for %s - run \"homelab node status\" while it's up to record one", node.Name)
+		}
+	}
+
+	for _, node := range nodes {
+		slog.Info("Sending magic packet", "node", node.Name, "mac", node.MAC)
+		if err := wol.Send(node.MAC, broadcast); err != nil {
+			return fmt.Errorf("%s: %w", node.Name, err)
+		}
+	}
+	if !wait {
+		return nil
+	}
+
+	for _, node := range nodes {
+		err := waitUntil(node.Name+" to accept SSH", waitTimeout, 10*time.Second, func() (bool, error) {
+			if probe.SSH(node.Address, node.SSH.Credentials()).Authenticated {
+				return true, nil
+			}
+			// Magic packets are fire-and-forget UDP; resend in case the
+			// first was dropped.
+			return false, wol.Send(node.MAC, broadcast)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -57,13 +57,13 @@ func prepareStack(cmd *cobra.Command) (context.Context, time.Duration, auto.Stac
 	}
 
 	slog.Info("Resolving a reachable cluster endpoint")
-	address, sshUser, sshPassword, err := k3s.ResolveClusterEndpoint(ctx, infraCfg)
+	address, creds, err := k3s.ResolveClusterEndpoint(ctx, infraCfg)
 	if err != nil {
 		return nil, 0, auto.Stack{}, err
 	}
 	slog.Info("Found reachable cluster endpoint", "address", address)
 
-	client := ssh.NewClientWithPassword(address, sshUser, sshPassword)
+	client := ssh.NewClient(address, creds)
 	if err := client.Connect(ctx); err != nil {
 		return nil, 0, auto.Stack{}, err
 	}
@@ -32,12 +32,17 @@ func init() {
 }
 
 func runUp(cmd *cobra.Command, args []string) error {
-	ctx, timeout, stack, err := prepareStack(cmd)
+	refresh, err := cmd.Flags().GetBool("refresh")
 	if err != nil {
 		return err
 	}
+	return Up(cmd, refresh)
+}
 
-	refresh, err := cmd.Flags().GetBool("refresh")
+// Up runs "up" on behalf of another command (e.g. "cluster create"), which
+// only needs to share the global --config and --timeout flags with it.
+func Up(cmd *cobra.Command, refresh bool) error {
+	ctx, timeout, stack, err := prepareStack(cmd)
 	if err != nil {
 		return err
 	}
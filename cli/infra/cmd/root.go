@@ -4,8 +4,11 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	"path/filepath"
 
 	pulumicmd "github.com/liamawhite/homelab/cli/infra/cmd/pulumi"
+	"github.com/liamawhite/homelab/pkg/config"
+	"github.com/liamawhite/homelab/pkg/ssh"
 	"github.com/spf13/cobra"
 )
 
@@ -29,16 +32,55 @@ func Execute() {
 }
 
 func init() {
+	rootCmd.PersistentPreRun = configureKnownHosts
+
 	// Global persistent flags
 	rootCmd.PersistentFlags().String("config", "", "Path to infra.yaml config file (auto-detected if not specified)")
 	rootCmd.PersistentFlags().String("timeout", "2m", "Total time budget for the up/preview/refresh/cancel operation itself (Go duration format, e.g. 90s, 2m, 5m) - a stuck resource (e.g. a Deployment rollout wait) aborts the whole operation once this elapses, rather than each resource getting its own allowance")
 
 	rootCmd.AddCommand(bootstrapCmd)
+	rootCmd.AddCommand(clusterCmd)
+	rootCmd.AddCommand(configCmd)
+	rootCmd.AddCommand(doctorCmd)
+	rootCmd.AddCommand(etcdCmd)
+	rootCmd.AddCommand(execCmd)
 	rootCmd.AddCommand(k3sCmd)
 	rootCmd.AddCommand(kubeconfigCmd)
+	rootCmd.AddCommand(netCmd)
 	rootCmd.AddCommand(nodeCmd)
+	rootCmd.AddCommand(nodeExporterCmd)
 	rootCmd.AddCommand(pulumicmd.UpCmd)
 	rootCmd.AddCommand(pulumicmd.PreviewCmd)
 	rootCmd.AddCommand(pulumicmd.RefreshCmd)
 	rootCmd.AddCommand(pulumicmd.CancelCmd)
 }
+
+// configureKnownHosts pins SSH host keys in a known_hosts file next to
+// infra.yaml (see ssh.KnownHosts), with the cluster VIP aliased to every
+// server's address. Without an infra.yaml nothing is configured, and any SSH
+// connection fails closed rather than accepting an unverified key.
+func configureKnownHosts(cmd *cobra.Command, args []string) {
+	configFile, err := config.ResolveConfigPath(cmd)
+	if err != nil {
+		return
+	}
+
+	kh := &ssh.KnownHosts{Path: knownHostsPath(configFile)}
+	if infraCfg, err := config.LoadFromFile(configFile); err == nil && infraCfg.Cluster.VIP != "" {
+		servers := infraCfg.Servers()
+		addresses := make([]string, len(servers))
+		for i, node := range servers {
+			addresses[i] = node.Address
+		}
+		kh.Aliases = map[string][]string{infraCfg.Cluster.VIP: addresses}
+	}
+	ssh.UseKnownHosts(kh)
+}
+
+// knownHostsPath returns the known_hosts file that sits alongside
+// configFile. Unlike infra.yaml it isn't git-crypt'd - host keys are
+// public, and committing them is what makes the pins stick across
+// machines.
+func knownHostsPath(configFile string) string {
+	return filepath.Join(filepath.Dir(configFile), "known_hosts")
+}
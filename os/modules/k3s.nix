@@ -16,12 +16,14 @@
 #
 # Pinned to nixpkgs' `k3s_1_36` (currently 1.36.2+k3s1) rather than the
 # unversioned `k3s` attribute (currently aliased to 1_35), to exactly match
-# the Pi cluster's live version (installed via get.k3s.io, unpinned - re-check
-# `kubectl get nodes -o wide` before bumping this). nixpkgs periodically
-# retires old k3s_1_NN attrs as new minors land - if `k3s_1_36` ever
-# disappears, re-check with `nix eval
-# github:NixOS/nixpkgs/nixos-unstable#k3s_1_36.version` (or whatever the Pi
-# cluster's version has become) and update the attribute name below.
+# the Pi cluster's version - pkg/versions.K3s, which pkg/k3s.Installer
+# passes to get.k3s.io and `homelab k3s upgrade` rolls out. Bump this
+# alongside that constant, after the Pis are upgraded (this node joins
+# them, so it shouldn't run ahead). nixpkgs periodically retires old
+# k3s_1_NN attrs as new minors land - if `k3s_1_36` ever disappears, or
+# pkg/versions.K3s moves to a new minor, check with `nix eval
+# github:NixOS/nixpkgs/nixos-unstable#k3s_1_NN.version` and update the
+# attribute name below.
 { pkgs, ... }:
 
 {
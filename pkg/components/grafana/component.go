@@ -223,6 +223,7 @@ datasources:
 		},
 		Data: pulumi.StringMap{
 			"node-metrics.json":                  pulumi.String(nodeMetricsDashboard),
+			"pi-health.json":                     pulumi.String(piHealthDashboard),
 			"pod-metrics.json":                   pulumi.String(podMetricsDashboard),
 			"istio-control-plane-dashboard.json": pulumi.String(istioControlPlaneDashboard),
 			"istio-mesh-dashboard.json":          pulumi.String(istioMeshDashboard),
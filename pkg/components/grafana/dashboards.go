@@ -89,3 +89,13 @@ var istioZtunnelDashboard string
 //
 //go:embed lumenetes-dashboard.json
 var lumenetesDashboard string
+
+// piHealthDashboard is hand-authored like lumenetesDashboard (generated from
+// a one-off script, same hardcoded datasource convention), over
+// pkg/components/prometheus's pi-exporter metrics - SoC temperature,
+// get_throttled flags, NVMe/eMMC wear and firmware versions - the Pi
+// hardware counterpart to nodeMetricsDashboard. Its alerting rules live
+// with the exporter, in pi-exporter's own PrometheusRule.
+//
+//go:embed pi-health.json
+var piHealthDashboard string
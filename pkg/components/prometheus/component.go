@@ -1,7 +1,8 @@
 // Package prometheus deploys the cluster's metrics-collection plane:
 // prometheus-operator, the Prometheus custom resource it reconciles, and
-// the exporters that feed it (node-exporter, kube-state-metrics, and a
-// ServiceMonitor for the kubelet's built-in cAdvisor) - all hand-rolled Go
+// the exporters that feed it (node-exporter, kube-state-metrics,
+// pi-exporter for Raspberry Pi hardware health, and a ServiceMonitor for the
+// kubelet's built-in cAdvisor) - all hand-rolled Go
 // resources (not a Helm chart), following pkg/components/longhorn's
 // conventions: the namespace is created centrally by
 // pkg/deploy/namespaces.go and passed in, not created here.
@@ -9,7 +10,7 @@
 // This is ported from the old TypeScript stack under
 // _migrateme/components/kubernetes/{prometheus-operator,prometheus,
 // node-exporter,kube-state-metrics,cadvisor}, with one deliberate
-// improvement and two deliberate gaps carried forward as-is:
+// improvement and a deliberate gap carried forward as-is:
 //
 //   - Data retention strategy (the improvement): legacy set only a
 //     time-based `retention: 30d` with no size cap at all - a real gap,
@@ -17,10 +18,11 @@
 //     100% between GC cycles and crash-loop Prometheus. See instance.go's
 //     doc comment for the dual time+size retention this version sets
 //     instead.
-//   - No PrometheusRule exists anywhere in this stack (no alerting rules),
-//     matching legacy, which never created one either.
-//   - No self-scrape ServiceMonitor for Prometheus's own /metrics exists
-//     either, also matching legacy.
+//   - No self-scrape ServiceMonitor for Prometheus's own /metrics exists,
+//     matching legacy.
+//
+// The only alerting rules are pi-exporter's own PrometheusRule (see
+// piexporter.go) - legacy had none at all.
 //
 // Grafana (pkg/components/grafana) is a separate package/component, wired
 // to this one's Service by hostname alone (prometheus-<name>.<namespace>) -
@@ -89,15 +91,17 @@ type PrometheusArgs struct {
 	GrafanaServiceAccountName string
 
 	// OperatorVersion, Version (Prometheus itself), NodeExporterVersion,
-	// KubeStateMetricsVersion, AlertmanagerVersion, and KubeRBACProxyVersion
-	// are the pkg/versions/versions.go constants for each image this
-	// package deploys.
+	// KubeStateMetricsVersion, AlertmanagerVersion, KubeRBACProxyVersion,
+	// and BusyboxVersion (pi-exporter's image) are the
+	// pkg/versions/versions.go constants for each image this package
+	// deploys.
 	OperatorVersion         string
 	Version                 string
 	NodeExporterVersion     string
 	KubeStateMetricsVersion string
 	AlertmanagerVersion     string
 	KubeRBACProxyVersion    string
+	BusyboxVersion          string
 
 	// StorageClassName is the cluster's default StorageClass (Longhorn's
 	// DefaultStorageClass output) - Prometheus's PVC is provisioned against
@@ -207,10 +211,10 @@ func NewPrometheus(ctx *pulumi.Context, name string, args *PrometheusArgs, opts
 		return nil, err
 	}
 
-	// node-exporter/kube-state-metrics/cadvisor's ServiceMonitor all depend
-	// only on the operator (its CRD-reconciling controller has to exist to
-	// accept a ServiceMonitor at all) - not on the Prometheus CR itself,
-	// mirroring legacy's own stated dependency ordering.
+	// node-exporter/kube-state-metrics/pi-exporter/cadvisor's ServiceMonitor
+	// all depend only on the operator (its CRD-reconciling controller has to
+	// exist to accept a ServiceMonitor at all) - not on the Prometheus CR
+	// itself, mirroring legacy's own stated dependency ordering.
 	if err := newNodeExporter(ctx, name+"-node-exporter", args.Namespace, args.NodeExporterVersion, args.KubeRBACProxyVersion, instOpts...); err != nil {
 		return nil, err
 	}
@@ -219,6 +223,10 @@ func NewPrometheus(ctx *pulumi.Context, name string, args *PrometheusArgs, opts
 		return nil, err
 	}
 
+	if err := newPiExporter(ctx, name+"-pi-exporter", args.Namespace, args.BusyboxVersion, instOpts...); err != nil {
+		return nil, err
+	}
+
 	if err := newCadvisorServiceMonitor(ctx, name+"-cadvisor", instOpts...); err != nil {
 		return nil, err
 	}
@@ -53,10 +53,11 @@ func prometheusPodSelector(namespace pulumi.StringInput) *ciliumv2.CiliumCluster
 //     identities instead of "world". Unverified against a live cluster as
 //     of writing - check `cilium monitor --type drop` on first deploy in
 //     case node/host traffic instead needs a "world"-shaped rule here.
-//  2. Prometheus <-> kube-state-metrics and Prometheus <-> Alertmanager:
-//     all in the same ambient-enrolled "monitoring" namespace, so - per
-//     ambientHBONEPort's reasoning above - these are port-15008 CCNP pairs,
-//     not 8080/8081 (KSM) or 9093 (Alertmanager) ones.
+//  2. Prometheus <-> kube-state-metrics, Prometheus <-> pi-exporter and
+//     Prometheus <-> Alertmanager: all in the same ambient-enrolled
+//     "monitoring" namespace, so - per ambientHBONEPort's reasoning above -
+//     these are port-15008 CCNP pairs, not 8080/8081 (KSM), 9110
+//     (pi-exporter) or 9093 (Alertmanager) ones.
 //
 // Requires the Cilium CiliumClusterwideNetworkPolicy CRD to already exist -
 // callers must pass pulumi.DependsOn on the Cilium installation.
@@ -204,6 +205,63 @@ func newNetworkPolicy(ctx *pulumi.Context, name string, namespace pulumi.StringI
 		return err
 	}
 
+	piExporterSelector := pulumi.StringMap{
+		cilium.K8sNamespaceLabel: namespace,
+		"app.kubernetes.io/name": pulumi.String(PiExporterPodLabel),
+	}
+
+	_, err = ciliumv2.NewCiliumClusterwideNetworkPolicy(ctx, fmt.Sprintf("%s-allow-egress-pi-exporter-hbone", name), &ciliumv2.CiliumClusterwideNetworkPolicyArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Name: pulumi.String("allow-egress-prometheus-to-pi-exporter-hbone"),
+		},
+		Spec: &ciliumv2.CiliumClusterwideNetworkPolicySpecArgs{
+			EndpointSelector: promSelector,
+			Egress: ciliumv2.CiliumClusterwideNetworkPolicySpecEgressArray{
+				&ciliumv2.CiliumClusterwideNetworkPolicySpecEgressArgs{
+					ToEndpoints: ciliumv2.CiliumClusterwideNetworkPolicySpecEgressToEndpointsArray{
+						&ciliumv2.CiliumClusterwideNetworkPolicySpecEgressToEndpointsArgs{MatchLabels: piExporterSelector},
+					},
+					ToPorts: ciliumv2.CiliumClusterwideNetworkPolicySpecEgressToPortsArray{
+						&ciliumv2.CiliumClusterwideNetworkPolicySpecEgressToPortsArgs{
+							Ports: ciliumv2.CiliumClusterwideNetworkPolicySpecEgressToPortsPortsArray{
+								&ciliumv2.CiliumClusterwideNetworkPolicySpecEgressToPortsPortsArgs{Port: pulumi.String(ambientHBONEPort), Protocol: pulumi.String("TCP")},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, opts...)
+	if err != nil {
+		return err
+	}
+
+	_, err = ciliumv2.NewCiliumClusterwideNetworkPolicy(ctx, fmt.Sprintf("%s-allow-ingress-pi-exporter-hbone", name), &ciliumv2.CiliumClusterwideNetworkPolicyArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Name: pulumi.String("allow-ingress-pi-exporter-from-prometheus-hbone"),
+		},
+		Spec: &ciliumv2.CiliumClusterwideNetworkPolicySpecArgs{
+			EndpointSelector: &ciliumv2.CiliumClusterwideNetworkPolicySpecEndpointSelectorArgs{MatchLabels: piExporterSelector},
+			Ingress: ciliumv2.CiliumClusterwideNetworkPolicySpecIngressArray{
+				&ciliumv2.CiliumClusterwideNetworkPolicySpecIngressArgs{
+					FromEndpoints: ciliumv2.CiliumClusterwideNetworkPolicySpecIngressFromEndpointsArray{
+						&ciliumv2.CiliumClusterwideNetworkPolicySpecIngressFromEndpointsArgs{MatchLabels: promSelector.MatchLabels},
+					},
+					ToPorts: ciliumv2.CiliumClusterwideNetworkPolicySpecIngressToPortsArray{
+						&ciliumv2.CiliumClusterwideNetworkPolicySpecIngressToPortsArgs{
+							Ports: ciliumv2.CiliumClusterwideNetworkPolicySpecIngressToPortsPortsArray{
+								&ciliumv2.CiliumClusterwideNetworkPolicySpecIngressToPortsPortsArgs{Port: pulumi.String(ambientHBONEPort), Protocol: pulumi.String("TCP")},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, opts...)
+	if err != nil {
+		return err
+	}
+
 	amSelector := pulumi.StringMap{
 		cilium.K8sNamespaceLabel: namespace,
 		"app.kubernetes.io/name": pulumi.String(AlertmanagerPodLabel),
@@ -0,0 +1,261 @@
+package prometheus
+
+import (
+	_ "embed"
+	"fmt"
+
+	monitoringv1 "github.com/liamawhite/homelab/pkg/crds/prometheus/crds/kubernetes/monitoring/v1"
+	appsv1 "github.com/pulumi/pulumi-kubernetes/sdk/v4/go/kubernetes/apps/v1"
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v4/go/kubernetes/core/v1"
+	metav1 "github.com/pulumi/pulumi-kubernetes/sdk/v4/go/kubernetes/meta/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const busyboxImage = "docker.io/library/busybox"
+
+// PiExporterPodLabel is the "app.kubernetes.io/name" value on pi-exporter's
+// pods - network.go's Prometheus<->pi-exporter HBONE CCNP pair matches it.
+const PiExporterPodLabel = "pi-exporter"
+
+// piExporterPort is where busybox httpd serves piexporter.sh as a CGI
+// script.
+const piExporterPort = 9110
+
+// piExporterScript is the CGI script that actually gathers the metrics -
+// see its header comment for what it reads and how.
+//
+//go:embed piexporter.sh
+var piExporterScript string
+
+// newPiExporter deploys pi-exporter - Raspberry Pi hardware health (SoC
+// temperature, `vcgencmd get_throttled` undervoltage/throttling flags,
+// firmware and EEPROM bootloader versions, eMMC and NVMe wear) as
+// Prometheus metrics - with its ServiceMonitor and the PrometheusRule that
+// alerts on them.
+//
+// There's no upstream exporter image worth pinning for this, so it's a
+// stock busybox image running httpd, whose only page is piexporter.sh as a
+// CGI script: every scrape runs it fresh, so there's no collection loop to
+// go stale. vcgencmd needs the host's own binary, libraries and
+// /dev/vcio, so the pod is privileged and the script chroots into the
+// host's root filesystem to run it - nothing is installed into the image.
+//
+// Unlike node-exporter it's a normal (not hostNetwork) ambient pod in
+// "monitoring", so its metrics aren't on the LAN and it needs no
+// kube-rbac-proxy; Prometheus reaches it through the HBONE CCNP pair in
+// network.go, like kube-state-metrics. Scheduled on arm64 nodes only - the
+// Pis - since the x86 node has no VideoCore to ask.
+func newPiExporter(ctx *pulumi.Context, name string, namespace pulumi.StringInput, busyboxVersion string, opts ...pulumi.ResourceOption) error {
+	labels := pulumi.StringMap{
+		"app.kubernetes.io/name":      pulumi.String(PiExporterPodLabel),
+		"app.kubernetes.io/component": pulumi.String("exporter"),
+	}
+
+	script, err := corev1.NewConfigMap(ctx, fmt.Sprintf("%s-script", name), &corev1.ConfigMapArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Name:      pulumi.String("pi-exporter"),
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Data: pulumi.StringMap{
+			"metrics": pulumi.String(piExporterScript),
+		},
+	}, opts...)
+	if err != nil {
+		return err
+	}
+
+	_, err = appsv1.NewDaemonSet(ctx, fmt.Sprintf("%s-daemonset", name), &appsv1.DaemonSetArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Name:      pulumi.String("pi-exporter"),
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: &appsv1.DaemonSetSpecArgs{
+			Selector: &metav1.LabelSelectorArgs{MatchLabels: labels},
+			UpdateStrategy: &appsv1.DaemonSetUpdateStrategyArgs{
+				Type: pulumi.String("RollingUpdate"),
+				RollingUpdate: &appsv1.RollingUpdateDaemonSetArgs{
+					MaxUnavailable: pulumi.Int(1),
+				},
+			},
+			Template: &corev1.PodTemplateSpecArgs{
+				Metadata: &metav1.ObjectMetaArgs{Labels: labels},
+				Spec: &corev1.PodSpecArgs{
+					AutomountServiceAccountToken: pulumi.Bool(false),
+					PriorityClassName:            pulumi.String("system-cluster-critical"),
+					NodeSelector: pulumi.StringMap{
+						"kubernetes.io/os":   pulumi.String("linux"),
+						"kubernetes.io/arch": pulumi.String("arm64"),
+					},
+					Tolerations: corev1.TolerationArray{
+						&corev1.TolerationArgs{Operator: pulumi.String("Exists")},
+					},
+					Volumes: corev1.VolumeArray{
+						&corev1.VolumeArgs{
+							Name:     pulumi.String("root"),
+							HostPath: &corev1.HostPathVolumeSourceArgs{Path: pulumi.String("/")},
+						},
+						&corev1.VolumeArgs{
+							Name: pulumi.String("script"),
+							ConfigMap: &corev1.ConfigMapVolumeSourceArgs{
+								Name:        script.Metadata.Name().Elem(),
+								DefaultMode: pulumi.Int(0755),
+							},
+						},
+					},
+					Containers: corev1.ContainerArray{
+						&corev1.ContainerArgs{
+							Name:  pulumi.String("pi-exporter"),
+							Image: pulumi.Sprintf("%s:%s", busyboxImage, busyboxVersion),
+							Command: pulumi.StringArray{
+								pulumi.String("httpd"),
+								pulumi.String("-f"),
+								pulumi.String("-p"),
+								pulumi.Sprintf("%d", piExporterPort),
+								pulumi.String("-h"),
+								pulumi.String("/www"),
+							},
+							Ports: corev1.ContainerPortArray{
+								&corev1.ContainerPortArgs{Name: pulumi.String("http-metrics"), ContainerPort: pulumi.Int(piExporterPort)},
+							},
+							VolumeMounts: corev1.VolumeMountArray{
+								&corev1.VolumeMountArgs{Name: pulumi.String("root"), MountPath: pulumi.String("/host"), MountPropagation: pulumi.String("HostToContainer"), ReadOnly: pulumi.Bool(true)},
+								&corev1.VolumeMountArgs{Name: pulumi.String("script"), MountPath: pulumi.String("/www/cgi-bin")},
+							},
+							Resources: &corev1.ResourceRequirementsArgs{
+								Requests: pulumi.StringMap{"cpu": pulumi.String("5m"), "memory": pulumi.String("8Mi")},
+								Limits:   pulumi.StringMap{"cpu": pulumi.String("100m"), "memory": pulumi.String("32Mi")},
+							},
+							SecurityContext: &corev1.SecurityContextArgs{
+								// Needed for /dev/vcio (vcgencmd) and
+								// /dev/nvme* (nvme smart-log) through the
+								// chroot.
+								Privileged: pulumi.Bool(true),
+							},
+						},
+					},
+				},
+			},
+		},
+	}, opts...)
+	if err != nil {
+		return err
+	}
+
+	_, err = corev1.NewService(ctx, fmt.Sprintf("%s-service", name), &corev1.ServiceArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Name:      pulumi.String("pi-exporter"),
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: &corev1.ServiceSpecArgs{
+			ClusterIP: pulumi.String("None"),
+			Selector:  labels,
+			Ports: corev1.ServicePortArray{
+				&corev1.ServicePortArgs{Name: pulumi.String("http-metrics"), Port: pulumi.Int(piExporterPort), TargetPort: pulumi.String("http-metrics")},
+			},
+		},
+	}, opts...)
+	if err != nil {
+		return err
+	}
+
+	_, err = monitoringv1.NewServiceMonitor(ctx, fmt.Sprintf("%s-servicemonitor", name), &monitoringv1.ServiceMonitorArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Name:      pulumi.String("pi-exporter"),
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: &monitoringv1.ServiceMonitorSpecArgs{
+			JobLabel: pulumi.String("app.kubernetes.io/name"),
+			Selector: &monitoringv1.ServiceMonitorSpecSelectorArgs{MatchLabels: labels},
+			Endpoints: monitoringv1.ServiceMonitorSpecEndpointsArray{
+				&monitoringv1.ServiceMonitorSpecEndpointsArgs{
+					Port:     pulumi.String("http-metrics"),
+					Path:     pulumi.String("/cgi-bin/metrics"),
+					Interval: pulumi.String("30s"),
+					// Same instance=<node name> relabeling as
+					// node-exporter's, so the two line up in queries.
+					Relabelings: monitoringv1.ServiceMonitorSpecEndpointsRelabelingsArray{
+						&monitoringv1.ServiceMonitorSpecEndpointsRelabelingsArgs{
+							Action:       pulumi.String("replace"),
+							Regex:        pulumi.String("(.*)"),
+							Replacement:  pulumi.String("$1"),
+							SourceLabels: pulumi.StringArray{pulumi.String("__meta_kubernetes_pod_node_name")},
+							TargetLabel:  pulumi.String("instance"),
+						},
+					},
+				},
+			},
+		},
+	}, opts...)
+	if err != nil {
+		return err
+	}
+
+	_, err = monitoringv1.NewPrometheusRule(ctx, fmt.Sprintf("%s-rules", name), &monitoringv1.PrometheusRuleArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Name:      pulumi.String("pi-health"),
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: &monitoringv1.PrometheusRuleSpecArgs{
+			Groups: monitoringv1.PrometheusRuleSpecGroupsArray{
+				&monitoringv1.PrometheusRuleSpecGroupsArgs{
+					Name:  pulumi.String("pi-health"),
+					Rules: piHealthRules(),
+				},
+			},
+		},
+	}, opts...)
+	return err
+}
+
+// piAlert is one alerting rule on pi-exporter's metrics.
+type piAlert struct {
+	name, expr, duration, severity, summary string
+}
+
+// piHealthRules returns the alerting rules for pi-exporter's metrics.
+// Undervoltage is the one that's actually bitten us, so it alerts even
+// when it's only been seen since boot and not right now - a marginal PSU or
+// cable dips briefly under load, and the sticky flag is the only trace.
+func piHealthRules() monitoringv1.PrometheusRuleSpecGroupsRulesArray {
+	alerts := []piAlert{
+		{"PiUnderVoltage", `rpi_throttled{condition="under_voltage"} == 1`, "1m", "critical",
+			"{{ $labels.instance }} is under-voltage now - check its PSU and cable"},
+		{"PiUnderVoltageSinceBoot", `rpi_throttled_since_boot{condition="under_voltage"} == 1 unless on (instance) rpi_throttled{condition="under_voltage"} == 1`, "0m", "warning",
+			"{{ $labels.instance }} has been under-voltage since its last boot"},
+		{"PiThrottled", `rpi_throttled{condition=~"throttled|frequency_capped"} == 1`, "10m", "warning",
+			"{{ $labels.instance }} firmware is limiting its CPU ({{ $labels.condition }})"},
+		{"PiSoftTempLimit", `rpi_throttled{condition="soft_temp_limit"} == 1`, "10m", "warning",
+			"{{ $labels.instance }} is at its soft temperature limit"},
+		{"PiHighTemperature", `rpi_soc_temperature_celsius > 75`, "10m", "warning",
+			"{{ $labels.instance }} SoC is at {{ $value | printf \"%.0f\" }}°C, close to the 80°C throttling point"},
+		{"PiNVMeWearHigh", `rpi_nvme_wear_ratio > 0.8`, "1h", "warning",
+			"{{ $labels.instance }} {{ $labels.device }} has used {{ $value | humanizePercentage }} of its rated life"},
+		{"PiNVMeSpareLow", `rpi_nvme_available_spare_ratio < 0.1`, "1h", "critical",
+			"{{ $labels.instance }} {{ $labels.device }} has only {{ $value | humanizePercentage }} spare capacity left"},
+		{"PiNVMeCriticalWarning", `rpi_nvme_critical_warning > 0`, "0m", "critical",
+			"{{ $labels.instance }} {{ $labels.device }} is reporting an NVMe critical warning ({{ $value }})"},
+		{"PiNVMeMediaErrors", `increase(rpi_nvme_media_errors_total[1h]) > 0`, "0m", "warning",
+			"{{ $labels.instance }} {{ $labels.device }} has new unrecovered media errors"},
+		{"PiEMMCWearHigh", `rpi_emmc_life_time_used >= 9 or rpi_emmc_pre_eol >= 2`, "1h", "warning",
+			"{{ $labels.instance }} {{ $labels.device }} eMMC is nearing end of life"},
+		{"PiExporterVcgencmdDown", `rpi_vcgencmd_up == 0`, "15m", "warning",
+			"pi-exporter can't run vcgencmd on {{ $labels.instance }}, so its throttling state is unknown"},
+	}
+
+	rules := monitoringv1.PrometheusRuleSpecGroupsRulesArray{}
+	for _, a := range alerts {
+		rules = append(rules, &monitoringv1.PrometheusRuleSpecGroupsRulesArgs{
+			Alert:       pulumi.String(a.name),
+			Expr:        pulumi.String(a.expr),
+			For:         pulumi.String(a.duration),
+			Labels:      pulumi.StringMap{"severity": pulumi.String(a.severity)},
+			Annotations: pulumi.StringMap{"summary": pulumi.String(a.summary)},
+		})
+	}
+	return rules
+}
@@ -0,0 +1,102 @@
+#!/bin/sh
+# CGI script busybox httpd runs for every scrape of /cgi-bin/metrics,
+# printing the node's Raspberry Pi hardware health in the Prometheus text
+# format. The host's root filesystem is mounted at /host: sysfs is read
+# through it directly, and vcgencmd/nvme run chrooted into it, so they use
+# the host's own binaries and libraries. Anything a node lacks (vcgencmd on
+# a non-Pi, nvme-cli, an NVMe drive, eMMC) just leaves its series out.
+
+HOST=/host
+SAMPLES=$(mktemp)
+trap 'rm -f "$SAMPLES"' EXIT
+
+host() {
+	chroot "$HOST" "$@" 2>/dev/null
+}
+
+# sample records one sample; they're grouped by family on output, as the
+# text format requires.
+sample() {
+	echo "$1 $2" >>"$SAMPLES"
+}
+
+# family prints a family's HELP/TYPE header and samples, if it has any.
+family() {
+	lines=$(grep -E "^$1[{ ]" "$SAMPLES") || return 0
+	echo "# HELP $1 $3"
+	echo "# TYPE $1 $2"
+	echo "$lines"
+}
+
+if t=$(cat "$HOST/sys/class/thermal/thermal_zone0/temp" 2>/dev/null); then
+	sample rpi_soc_temperature_celsius "$(awk -v t="$t" 'BEGIN { printf "%.3f", t / 1000 }')"
+fi
+
+# get_throttled's low bits are conditions now, and the same bits shifted up
+# by 16 whether each has happened since boot.
+throttled=$(host vcgencmd get_throttled | sed -n 's/^throttled=//p')
+if [ -n "$throttled" ]; then
+	sample rpi_vcgencmd_up 1
+	bit=0
+	for condition in under_voltage frequency_capped throttled soft_temp_limit; do
+		sample "rpi_throttled{condition=\"$condition\"}" $(((throttled >> bit) & 1))
+		sample "rpi_throttled_since_boot{condition=\"$condition\"}" $(((throttled >> (bit + 16)) & 1))
+		bit=$((bit + 1))
+	done
+
+	version=$(host vcgencmd version | sed -n 's/^version \([0-9a-f]*\).*/\1/p')
+	sample "rpi_firmware_info{version=\"$version\"}" 1
+
+	bootloader=$(host vcgencmd bootloader_version)
+	version=$(echo "$bootloader" | sed -n 's/^version \([0-9a-f]*\).*/\1/p')
+	sample "rpi_bootloader_info{version=\"$version\"}" 1
+	timestamp=$(echo "$bootloader" | sed -n 's/^timestamp \([0-9]*\).*/\1/p')
+	[ -n "$timestamp" ] && sample rpi_bootloader_timestamp_seconds "$timestamp"
+else
+	sample rpi_vcgencmd_up 0
+fi
+
+# eMMC (Compute Modules) reports wear in 10% steps per memory type: 1 means
+# 0-10% of its life used, ..., 10 means 90-100%, 11 means exceeded. SD
+# cards have no standard equivalent, so aren't covered.
+for dev in "$HOST"/sys/block/mmcblk*; do
+	[ -r "$dev/device/life_time" ] || continue
+	name=${dev##*/}
+	set -- $(cat "$dev/device/life_time")
+	sample "rpi_emmc_life_time_used{device=\"$name\",type=\"a\"}" $(($1))
+	sample "rpi_emmc_life_time_used{device=\"$name\",type=\"b\"}" $(($2))
+	sample "rpi_emmc_pre_eol{device=\"$name\"}" $(($(cat "$dev/device/pre_eol_info")))
+done
+
+for dev in "$HOST"/dev/nvme[0-9]; do
+	[ -e "$dev" ] || continue
+	smart=$(host nvme smart-log "${dev#"$HOST"}" -o json | tr -d ' \n')
+	[ -n "$smart" ] || continue
+	field() {
+		echo "$smart" | sed -n "s/.*\"$1\":\([0-9]*\).*/\1/p"
+	}
+	name=${dev##*/}
+	sample "rpi_nvme_wear_ratio{device=\"$name\"}" "$(awk -v v="$(field percent_used)" 'BEGIN { print v / 100 }')"
+	sample "rpi_nvme_available_spare_ratio{device=\"$name\"}" "$(awk -v v="$(field avail_spare)" 'BEGIN { print v / 100 }')"
+	sample "rpi_nvme_critical_warning{device=\"$name\"}" "$(field critical_warning)"
+	sample "rpi_nvme_media_errors_total{device=\"$name\"}" "$(field media_errors)"
+	# Data units are 1000 512-byte blocks.
+	sample "rpi_nvme_data_written_bytes_total{device=\"$name\"}" "$(awk -v v="$(field data_units_written)" 'BEGIN { printf "%.0f", v * 512000 }')"
+done
+
+echo "Content-Type: text/plain; version=0.0.4"
+echo
+family rpi_soc_temperature_celsius gauge "SoC temperature."
+family rpi_vcgencmd_up gauge "Whether vcgencmd on the host answered."
+family rpi_throttled gauge "Whether a firmware throttling condition is active now."
+family rpi_throttled_since_boot gauge "Whether a firmware throttling condition has occurred since boot."
+family rpi_firmware_info gauge "VideoCore firmware version, as a label."
+family rpi_bootloader_info gauge "EEPROM bootloader version, as a label."
+family rpi_bootloader_timestamp_seconds gauge "EEPROM bootloader build time."
+family rpi_emmc_life_time_used gauge "eMMC estimated life used, in 10% steps (11 = exceeded)."
+family rpi_emmc_pre_eol gauge "eMMC reserved block consumption: 1 normal, 2 warning, 3 urgent."
+family rpi_nvme_wear_ratio gauge "NVMe vendor estimate of life used (can exceed 1)."
+family rpi_nvme_available_spare_ratio gauge "NVMe spare capacity remaining."
+family rpi_nvme_critical_warning gauge "NVMe critical warning bitmap (0 is healthy)."
+family rpi_nvme_media_errors_total counter "NVMe unrecovered data integrity errors over the drive's life."
+family rpi_nvme_data_written_bytes_total counter "NVMe bytes written over the drive's life."
@@ -1,11 +1,14 @@
 package config
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"net"
 	"os"
 	"strings"
 
+	"github.com/liamawhite/homelab/pkg/ssh"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"gopkg.in/yaml.v3"
@@ -140,23 +143,168 @@ type AdminConfig struct {
 	OAuthClientSecret string `yaml:"oauthClientSecret" mapstructure:"oauthClientSecret"`
 }
 
+// SSHConfig is how to log in to a node. At least one of Password, KeyFile
+// or Agent must be set; when several are, all are offered (see
+// ssh.Credentials for the order).
 type SSHConfig struct {
 	User     string `yaml:"user" mapstructure:"user"`
 	Port     int    `yaml:"port" mapstructure:"port"`
 	Password string `yaml:"password" mapstructure:"password"`
+	// KeyFile is a private key path (~/ is expanded), for nodes like the
+	// NixOS ones whose sshd doesn't accept passwords at all.
+	KeyFile string `yaml:"keyFile,omitempty" mapstructure:"keyFile"`
+	// KeyPassphrase decrypts KeyFile, if it's encrypted.
+	KeyPassphrase string `yaml:"keyPassphrase,omitempty" mapstructure:"keyPassphrase"`
+	// Agent offers the keys held by the running ssh-agent ($SSH_AUTH_SOCK).
+	Agent bool `yaml:"agent,omitempty" mapstructure:"agent"`
 }
 
+// Credentials returns s as ssh.Client credentials.
+func (s SSHConfig) Credentials() ssh.Credentials {
+	return ssh.Credentials{
+		User:          s.User,
+		Password:      s.Password,
+		KeyFile:       s.KeyFile,
+		KeyPassphrase: s.KeyPassphrase,
+		Agent:         s.Agent,
+	}
+}
+
+// hasAuth reports whether s configures any way to authenticate.
+func (s SSHConfig) hasAuth() bool {
+	return s.Password != "" || s.KeyFile != "" || s.Agent
+}
+
+// NodeRole is the k3s role a node is installed with.
+type NodeRole string
+
+const (
+	// RoleServer runs the control plane and an etcd member, as well as
+	// workloads. The default.
+	RoleServer NodeRole = "server"
+	// RoleAgent only runs workloads, joining through the servers - a way to
+	// add capacity without growing etcd.
+	RoleAgent NodeRole = "agent"
+)
+
 type NodeConfig struct {
 	Name    string            `yaml:"name" mapstructure:"name"`
 	Address string            `yaml:"address" mapstructure:"address"`
+	Role    NodeRole          `yaml:"role,omitempty" mapstructure:"role"`
 	Labels  map[string]string `yaml:"labels,omitempty" mapstructure:"labels"`
 	SSH     SSHConfig         `yaml:"ssh" mapstructure:"ssh"`
+	// MAC is recorded by "node status" from the local neighbor table, for
+	// "node wake" to send Wake-on-LAN packets to.
+	MAC string `yaml:"mac,omitempty" mapstructure:"mac"`
+	// Boot overrides the Raspberry Pi boot configuration "bootstrap"
+	// writes to this node.
+	Boot BootConfig `yaml:"boot,omitempty" mapstructure:"boot"`
+	// Network, if set, has "bootstrap" give the node Address as a static
+	// address, rather than relying on a DHCP reservation on the router.
+	Network *NetworkConfig `yaml:"network,omitempty" mapstructure:"network"`
+}
+
+// NetworkConfig is the rest of a node's static IPv4 configuration, alongside
+// NodeConfig.Address.
+type NetworkConfig struct {
+	// Interface is the NetworkManager device to configure. Defaults to eth0.
+	Interface string `yaml:"interface,omitempty" mapstructure:"interface"`
+	// Prefix is Address's network prefix length, e.g. 24.
+	Prefix  int      `yaml:"prefix" mapstructure:"prefix"`
+	Gateway string   `yaml:"gateway" mapstructure:"gateway"`
+	DNS     []string `yaml:"dns" mapstructure:"dns"`
+}
+
+// BootConfig is a node's additions to the boot files every Pi gets
+// (pkg/raspberry's embedded config.txt and eeprom.conf, plus the cgroup
+// args k3s needs in cmdline.txt).
+type BootConfig struct {
+	// ConfigTxt lines are appended to config.txt, under an [all] filter so
+	// they apply whatever model-specific section the base file ends in -
+	// e.g. "arm_freq=2800", or "dtparam=poe_fan_temp0=50000" for a PoE HAT
+	// fan curve.
+	ConfigTxt []string `yaml:"configTxt,omitempty" mapstructure:"configTxt"`
+	// Eeprom sets bootloader config keys, replacing the base eeprom.conf's
+	// value where it already sets the key.
+	Eeprom map[string]string `yaml:"eeprom,omitempty" mapstructure:"eeprom"`
+	// Cmdline args are added to cmdline.txt if not already present. Unlike
+	// the other two, cmdline.txt is the OS's own file rather than one
+	// rendered wholesale, so removing an arg here doesn't remove it from
+	// the node.
+	Cmdline []string `yaml:"cmdline,omitempty" mapstructure:"cmdline"`
+}
+
+// IsServer reports whether n is a k3s server node.
+func (n NodeConfig) IsServer() bool {
+	return n.Role != RoleAgent
+}
+
+// validate rejects boot overrides that couldn't be written as a single
+// line/key/arg of their file.
+func (b BootConfig) validate() error {
+	for _, line := range b.ConfigTxt {
+		if strings.ContainsAny(line, "\r\n") {
+			return fmt.Errorf("boot.configTxt line %q must not contain a newline", line)
+		}
+	}
+	for key, value := range b.Eeprom {
+		if key == "" || strings.ContainsAny(key, "=# \t\r\n") {
+			return fmt.Errorf("invalid boot.eeprom key %q", key)
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("boot.eeprom.%s value must not contain a newline", key)
+		}
+	}
+	for _, arg := range b.Cmdline {
+		if arg == "" || strings.ContainsAny(arg, " \t\r\n") {
+			return fmt.Errorf("invalid boot.cmdline arg %q (must be a single non-empty word)", arg)
+		}
+	}
+	return nil
+}
+
+// validate checks n describes a usable static configuration for address.
+func (n NetworkConfig) validate(address string) error {
+	ip := net.ParseIP(address).To4()
+	if ip == nil {
+		return fmt.Errorf("network requires address to be an IPv4 address, got %q", address)
+	}
+	if n.Prefix < 1 || n.Prefix > 32 {
+		return fmt.Errorf("invalid network.prefix %d (must be 1-32)", n.Prefix)
+	}
+	gateway := net.ParseIP(n.Gateway).To4()
+	if gateway == nil {
+		return fmt.Errorf("invalid network.gateway %q", n.Gateway)
+	}
+	if subnet := (&net.IPNet{IP: ip.Mask(net.CIDRMask(n.Prefix, 32)), Mask: net.CIDRMask(n.Prefix, 32)}); !subnet.Contains(gateway) {
+		return fmt.Errorf("network.gateway %s is outside %s/%d", n.Gateway, address, n.Prefix)
+	}
+	if len(n.DNS) == 0 {
+		return fmt.Errorf("network.dns needs at least one server")
+	}
+	for _, dns := range n.DNS {
+		if net.ParseIP(dns) == nil {
+			return fmt.Errorf("invalid network.dns server %q", dns)
+		}
+	}
+	return nil
+}
+
+// Servers returns cfg's server nodes, in infra.yaml order.
+func (cfg *InfraConfig) Servers() []NodeConfig {
+	var servers []NodeConfig
+	for _, n := range cfg.Nodes {
+		if n.IsServer() {
+			servers = append(servers, n)
+		}
+	}
+	return servers
 }
 
 type Config struct {
 	Node             string
-	SSHUser          string
-	SSHPassword      string
+	Role             NodeRole
+	SSH              SSHConfig
 	K3SSANS          []string
 	ClusterInit      bool
 	ServerURL        string
@@ -166,6 +314,9 @@ type Config struct {
 	// New fields for config file support
 	ConfigFile  string
 	InfraConfig *InfraConfig
+	// Entry is the selected node's whole infra.yaml entry - or, for a node
+	// given by address alone, one with just that Address.
+	Entry NodeConfig
 
 	// Skip K3s-specific validation (for commands like kubeconfig)
 	SkipK3sValidation bool
@@ -296,38 +447,76 @@ func applyDefaults(cfg *InfraConfig) {
 		if cfg.Nodes[i].SSH.Port == 0 {
 			cfg.Nodes[i].SSH.Port = 22
 		}
+		if cfg.Nodes[i].Role == "" {
+			cfg.Nodes[i].Role = RoleServer
+		}
 	}
 
 	// SANs default to empty - K3s includes localhost, 127.0.0.1, hostname, and node IPs by default
 	// Users should only add the VIP or custom DNS names they actually use
 }
 
-// validateInfraConfig validates the infra.yaml structure
+// validateInfraConfig validates the infra.yaml structure, failing on the
+// first of infraProblems.
 func validateInfraConfig(cfg *InfraConfig) error {
+	if problems := infraProblems(cfg); len(problems) > 0 {
+		return errors.New(problems[0].Message)
+	}
+	return nil
+}
+
+// infraProblems runs every check loading infra.yaml needs to pass, rather
+// than stopping at the first failure (for "config validate").
+func infraProblems(cfg *InfraConfig) []Problem {
+	var problems []Problem
+	add := func(path, format string, args ...any) {
+		problems = append(problems, Problem{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
 	if len(cfg.Nodes) == 0 {
-		return fmt.Errorf("at least one node must be defined in infra.yaml")
+		add("nodes", "at least one node must be defined in infra.yaml")
 	}
 
 	// Check for unique node names and required SSH credentials
 	names := make(map[string]bool)
-	for _, node := range cfg.Nodes {
+	for i, node := range cfg.Nodes {
+		path := fmt.Sprintf("nodes[%d]", i)
 		if names[node.Name] {
-			return fmt.Errorf("duplicate node name: %s", node.Name)
+			add(path+".name", "duplicate node name: %s", node.Name)
 		}
 		names[node.Name] = true
 
 		if node.SSH.User == "" {
-			return fmt.Errorf("node '%s': ssh.user is required", node.Name)
+			add(path+".ssh.user", "node '%s': ssh.user is required", node.Name)
 		}
-		if node.SSH.Password == "" {
-			return fmt.Errorf("node '%s': ssh.password is required", node.Name)
+		if !node.SSH.hasAuth() {
+			add(path+".ssh", "node '%s': one of ssh.password, ssh.keyFile or ssh.agent is required", node.Name)
 		}
+		if node.Role != RoleServer && node.Role != RoleAgent {
+			add(path+".role", "node '%s': invalid role %q (must be %q or %q)", node.Name, node.Role, RoleServer, RoleAgent)
+		}
+		if node.MAC != "" {
+			if _, err := net.ParseMAC(node.MAC); err != nil {
+				add(path+".mac", "node '%s': invalid mac %q", node.Name, node.MAC)
+			}
+		}
+		if err := node.Boot.validate(); err != nil {
+			add(path+".boot", "node '%s': %v", node.Name, err)
+		}
+		if node.Network != nil {
+			if err := node.Network.validate(node.Address); err != nil {
+				add(path+".network", "node '%s': %v", node.Name, err)
+			}
+		}
+	}
+	if len(cfg.Nodes) > 0 && len(cfg.Servers()) == 0 {
+		add("nodes", "at least one node must have role %q", RoleServer)
 	}
 
 	// Validate VIP if provided
 	if cfg.Cluster.VIP != "" {
 		if net.ParseIP(cfg.Cluster.VIP) == nil {
-			return fmt.Errorf("invalid cluster VIP: %s", cfg.Cluster.VIP)
+			add("cluster.vip", "invalid cluster VIP: %s", cfg.Cluster.VIP)
 		}
 	}
 
@@ -335,13 +524,13 @@ func validateInfraConfig(cfg *InfraConfig) error {
 	// is actually created, but checked eagerly here like every other
 	// infra.yaml field rather than deferred to the controller.
 	if lat := cfg.Lumenetes.Location.Latitude; lat < -90 || lat > 90 {
-		return fmt.Errorf("invalid lumenetes.location.latitude: %v (must be between -90 and 90)", lat)
+		add("lumenetes.location.latitude", "invalid lumenetes.location.latitude: %v (must be between -90 and 90)", lat)
 	}
 	if lon := cfg.Lumenetes.Location.Longitude; lon < -180 || lon > 180 {
-		return fmt.Errorf("invalid lumenetes.location.longitude: %v (must be between -180 and 180)", lon)
+		add("lumenetes.location.longitude", "invalid lumenetes.location.longitude: %v (must be between -180 and 180)", lon)
 	}
 
-	return nil
+	return problems
 }
 
 // applyConfigWithPrecedence applies configuration with proper precedence
@@ -364,10 +553,21 @@ func applyConfigWithPrecedence(cmd *cobra.Command, cfg *Config, infraCfg *InfraC
 		cfg.Node = env
 	}
 
-	// SSH User/Password come solely from the selected node's infra.yaml entry
+	// SSH credentials come solely from the selected node's infra.yaml entry
+	if selectedNode != nil {
+		cfg.SSH = selectedNode.SSH
+	}
+
+	// Role likewise only comes from infra.yaml; a node given by address
+	// alone (via env) is assumed to be a server.
+	cfg.Role = RoleServer
 	if selectedNode != nil {
-		cfg.SSHUser = selectedNode.SSH.User
-		cfg.SSHPassword = selectedNode.SSH.Password
+		cfg.Role = selectedNode.Role
+	}
+
+	cfg.Entry = NodeConfig{Address: cfg.Node, Role: cfg.Role, SSH: cfg.SSH}
+	if selectedNode != nil {
+		cfg.Entry = *selectedNode
 	}
 
 	// SANs
@@ -430,80 +630,77 @@ func FindNodeByAddress(cfg *InfraConfig, address string) *NodeConfig {
 	return nil
 }
 
-// SetClusterToken writes token into path's cluster.token field, editing the
-// parsed node tree rather than a plain struct so comments are preserved.
-// Blank lines and indentation aren't preserved (yaml.v3 reformats to its
-// own default indent when re-encoding a node tree).
+// SetClusterToken writes token into path's cluster.token field, via an
+// Editor so comments are preserved.
 func SetClusterToken(path, token string) error {
-	data, err := os.ReadFile(path)
+	e, err := OpenEditor(path)
 	if err != nil {
-		return fmt.Errorf("failed to read config file: %w", err)
+		return err
 	}
-
-	var doc yaml.Node
-	if err := yaml.Unmarshal(data, &doc); err != nil {
-		return fmt.Errorf("failed to parse config file: %w", err)
-	}
-	if len(doc.Content) == 0 {
-		return fmt.Errorf("empty config file: %s", path)
+	if err := e.SetString("cluster.token", token); err != nil {
+		return err
 	}
+	return e.Save()
+}
 
-	cluster := mappingValue(doc.Content[0], "cluster")
-	if cluster == nil {
-		return fmt.Errorf("no top-level 'cluster' key in %s", path)
+// SameMAC reports whether a and b are the same hardware address, however
+// each is written - upper or lower case, or any of net.ParseMAC's forms.
+func SameMAC(a, b string) bool {
+	hwA, errA := net.ParseMAC(a)
+	hwB, errB := net.ParseMAC(b)
+	if errA != nil || errB != nil {
+		return strings.EqualFold(a, b)
 	}
-	tokenNode := mappingValue(cluster, "token")
-	if tokenNode == nil {
-		return fmt.Errorf("no 'cluster.token' key in %s", path)
-	}
-
-	tokenNode.SetString(token)
-
-	return writeConfigDoc(path, &doc)
+	return bytes.Equal(hwA, hwB)
 }
 
-// SaveHueBridge upserts a paired Hue bridge's application key into path's
-// lumenetes.hue.bridges list, keyed by bridge ID - editing the parsed node
-// tree (like SetClusterToken) rather than a plain struct so the rest of
-// the file's comments/formatting survive. Creates the lumenetes/hue/bridges
-// structure if it doesn't already exist.
-func SaveHueBridge(path, bridgeID, appKey string) error {
-	data, err := os.ReadFile(path)
+// SetNodeMACs writes each node's MAC address (keyed by node name) into its
+// entry in path's nodes list, via an Editor so comments are preserved.
+// Names with no entry, and MACs already recorded (in any form), are
+// ignored.
+func SetNodeMACs(path string, macs map[string]string) error {
+	e, err := OpenEditor(path)
 	if err != nil {
-		return fmt.Errorf("failed to read config file: %w", err)
-	}
-
-	var doc yaml.Node
-	if err := yaml.Unmarshal(data, &doc); err != nil {
-		return fmt.Errorf("failed to parse config file: %w", err)
+		return err
 	}
-	if len(doc.Content) == 0 {
-		return fmt.Errorf("empty config file: %s", path)
-	}
-	root := doc.Content[0]
-
-	hueNode := ensureMapping(ensureMapping(root, "lumenetes"), "hue")
-	bridges := ensureSequence(hueNode, "bridges")
 
-	for _, item := range bridges.Content {
-		idNode := mappingValue(item, "id")
-		if idNode == nil || idNode.Value != bridgeID {
+	for name, mac := range macs {
+		node, err := e.Get(nodePath(name))
+		if err != nil {
+			return err
+		}
+		if node == nil {
 			continue
 		}
-		if keyNode := mappingValue(item, "appKey"); keyNode != nil {
-			keyNode.SetString(appKey)
-		} else {
-			appendMapEntry(item, "appKey", stringNode(appKey))
+		if existing := mappingValue(node, "mac"); existing != nil && SameMAC(existing.Value, mac) {
+			continue
+		}
+		if err := e.SetString(nodePath(name)+".mac", mac); err != nil {
+			return err
 		}
-		return writeConfigDoc(path, &doc)
 	}
 
-	entry := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
-	appendMapEntry(entry, "id", stringNode(bridgeID))
-	appendMapEntry(entry, "appKey", stringNode(appKey))
-	bridges.Content = append(bridges.Content, entry)
+	return e.Save()
+}
+
+// SaveHueBridge upserts a paired Hue bridge's application key into path's
+// lumenetes.hue.bridges list, keyed by bridge ID - via an Editor, so the
+// rest of the file's comments/formatting survive. Creates the
+// lumenetes/hue/bridges structure if it doesn't already exist.
+func SaveHueBridge(path, bridgeID, appKey string) error {
+	e, err := OpenEditor(path)
+	if err != nil {
+		return err
+	}
+	if err := e.SetString(fmt.Sprintf("lumenetes.hue.bridges[id=%s].appKey", bridgeID), appKey); err != nil {
+		return err
+	}
+	return e.Save()
+}
 
-	return writeConfigDoc(path, &doc)
+// nodePath is the Editor path to the named node's entry.
+func nodePath(name string) string {
+	return fmt.Sprintf("nodes[name=%s]", name)
 }
 
 // writeConfigDoc marshals doc back to path.
@@ -537,28 +734,6 @@ func appendMapEntry(m *yaml.Node, key string, value *yaml.Node) {
 	m.Content = append(m.Content, stringNode(key), value)
 }
 
-// ensureMapping returns parent's existing mapping child named key, or
-// creates, appends, and returns an empty one.
-func ensureMapping(parent *yaml.Node, key string) *yaml.Node {
-	if child := mappingValue(parent, key); child != nil {
-		return child
-	}
-	child := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
-	appendMapEntry(parent, key, child)
-	return child
-}
-
-// ensureSequence returns parent's existing sequence child named key, or
-// creates, appends, and returns an empty one.
-func ensureSequence(parent *yaml.Node, key string) *yaml.Node {
-	if child := mappingValue(parent, key); child != nil {
-		return child
-	}
-	child := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
-	appendMapEntry(parent, key, child)
-	return child
-}
-
 // stringNode builds a scalar string yaml.Node for use as a map value.
 func stringNode(s string) *yaml.Node {
 	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: s}
@@ -569,8 +744,8 @@ func validateConfig(cfg *Config) error {
 		return fmt.Errorf("node is required (use --node with node name from infra.yaml)")
 	}
 
-	if cfg.SSHUser == "" || cfg.SSHPassword == "" {
-		return fmt.Errorf("node ssh credentials are required (define ssh.user/ssh.password in infra.yaml)")
+	if cfg.SSH.User == "" || !cfg.SSH.hasAuth() {
+		return fmt.Errorf("node ssh credentials are required (define ssh.user and ssh.password, ssh.keyFile or ssh.agent in infra.yaml)")
 	}
 
 	// K3s-specific validation (skip for commands like kubeconfig).
@@ -584,6 +759,10 @@ func validateConfig(cfg *Config) error {
 		if cfg.ClusterInit && (cfg.ServerURL != "" || cfg.Token != "") {
 			return fmt.Errorf("--cluster-init cannot be used with --server or --token")
 		}
+
+		if cfg.ClusterInit && cfg.Role == RoleAgent {
+			return fmt.Errorf("--cluster-init cannot be used on an agent node - initialize the cluster on a server")
+		}
 	}
 
 	return nil
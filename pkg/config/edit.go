@@ -0,0 +1,316 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Editor edits an infra.yaml file through its parsed node tree rather than
+// a plain struct, so comments are preserved - the generic form of what
+// SetClusterToken and friends each used to do by hand. Blank lines and
+// indentation aren't preserved (yaml.v3 reformats to its own default
+// indent when re-encoding a node tree).
+//
+// Values are addressed by path expressions: dotted mapping keys, with list
+// items picked by index or by one of their keys' values, e.g.
+//
+//	cluster.vip
+//	cluster.sans[0]
+//	nodes[name=pi-0].ssh.user
+//	lumenetes.hue.bridges[id=001788fffe123456].appKey
+type Editor struct {
+	path string
+	doc  yaml.Node
+}
+
+// OpenEditor parses path for editing.
+func OpenEditor(path string) (*Editor, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	e := &Editor{path: path}
+	if err := yaml.Unmarshal(data, &e.doc); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+	if len(e.doc.Content) == 0 {
+		return nil, fmt.Errorf("empty config file: %s", path)
+	}
+	return e, nil
+}
+
+// Get returns the node at expr, or nil if there's nothing there.
+func (e *Editor) Get(expr string) (*yaml.Node, error) {
+	steps, err := parsePath(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	node := e.doc.Content[0]
+	for _, step := range steps {
+		if node, _, err = step.child(node); err != nil || node == nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+// Set puts value at expr, replacing whatever's there (but keeping its
+// comments). Missing mappings along the way are created, as is a missing
+// list item picked by key (seeded with that key) or by the index one past
+// the end.
+func (e *Editor) Set(expr string, value *yaml.Node) error {
+	steps, err := parsePath(expr)
+	if err != nil {
+		return err
+	}
+
+	node := e.doc.Content[0]
+	for i, step := range steps[:len(steps)-1] {
+		child, _, err := step.child(node)
+		if err != nil {
+			return err
+		}
+		if child == nil {
+			// Create the container the next step expects.
+			child = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			if steps[i+1].key == "" {
+				child = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+			}
+			if err := step.insert(node, child); err != nil {
+				return err
+			}
+		}
+		node = child
+	}
+
+	last := steps[len(steps)-1]
+	existing, _, err := last.child(node)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return last.insert(node, value)
+	}
+	value.HeadComment, value.LineComment, value.FootComment = existing.HeadComment, existing.LineComment, existing.FootComment
+	*existing = *value
+	return nil
+}
+
+// SetString puts the string s at expr (see Set).
+func (e *Editor) SetString(expr, s string) error {
+	return e.Set(expr, stringNode(s))
+}
+
+// Unset removes expr - a mapping entry or a list item. It's an error if
+// there's nothing there.
+func (e *Editor) Unset(expr string) error {
+	steps, err := parsePath(expr)
+	if err != nil {
+		return err
+	}
+
+	node := e.doc.Content[0]
+	for _, step := range steps[:len(steps)-1] {
+		if node, _, err = step.child(node); err != nil {
+			return err
+		}
+		if node == nil {
+			return fmt.Errorf("%s not found", expr)
+		}
+	}
+
+	last := steps[len(steps)-1]
+	child, i, err := last.child(node)
+	if err != nil {
+		return err
+	}
+	if child == nil {
+		return fmt.Errorf("%s not found", expr)
+	}
+	if last.key != "" {
+		// Mapping content alternates key, value; i is the value.
+		node.Content = append(node.Content[:i-1], node.Content[i+1:]...)
+	} else {
+		node.Content = append(node.Content[:i], node.Content[i+1:]...)
+	}
+	return nil
+}
+
+// Save validates the edited file the same way loading it would, and only
+// if it's valid writes it back.
+func (e *Editor) Save() error {
+	var cfg InfraConfig
+	if err := e.doc.Decode(&cfg); err != nil {
+		return fmt.Errorf("edited config doesn't parse: %w", err)
+	}
+	applyDefaults(&cfg)
+	if err := validateInfraConfig(&cfg); err != nil {
+		return fmt.Errorf("edited config is invalid, not saving: %w", err)
+	}
+	return writeConfigDoc(e.path, &e.doc)
+}
+
+// ParseValue parses s as a YAML value for Set, so "22" is a number, "true"
+// a bool and "[a, b]" a list. Collections are switched to block style to
+// match the rest of the file.
+func ParseValue(s string) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(s), &doc); err != nil {
+		return nil, fmt.Errorf("invalid value %q: %w", s, err)
+	}
+	if len(doc.Content) == 0 {
+		return stringNode(""), nil
+	}
+	value := doc.Content[0]
+	blockStyle(value)
+	return value, nil
+}
+
+func blockStyle(n *yaml.Node) {
+	if n.Kind == yaml.MappingNode || n.Kind == yaml.SequenceNode {
+		n.Style = 0
+	}
+	for _, c := range n.Content {
+		blockStyle(c)
+	}
+}
+
+// pathStep is one step of a path expression: a mapping key, or a list item
+// by index or by the value of one of its keys.
+type pathStep struct {
+	key string
+
+	index int // -1 unless picking by index
+
+	selectKey, selectValue string
+}
+
+func (s pathStep) String() string {
+	switch {
+	case s.key != "":
+		return s.key
+	case s.index >= 0:
+		return fmt.Sprintf("[%d]", s.index)
+	default:
+		return fmt.Sprintf("[%s=%s]", s.selectKey, s.selectValue)
+	}
+}
+
+// parsePath splits expr into its steps.
+func parsePath(expr string) ([]pathStep, error) {
+	var steps []pathStep
+	rest := expr
+	for rest != "" {
+		end := strings.IndexAny(rest, ".[")
+		if end == -1 {
+			end = len(rest)
+		}
+		if end == 0 {
+			return nil, fmt.Errorf("invalid path %q: expected a key at %q", expr, rest)
+		}
+		steps = append(steps, pathStep{key: rest[:end], index: -1})
+		rest = rest[end:]
+
+		for strings.HasPrefix(rest, "[") {
+			closing := strings.Index(rest, "]")
+			if closing == -1 {
+				return nil, fmt.Errorf("invalid path %q: unclosed [", expr)
+			}
+			step, err := parseSelector(rest[1:closing])
+			if err != nil {
+				return nil, fmt.Errorf("invalid path %q: %w", expr, err)
+			}
+			steps = append(steps, step)
+			rest = rest[closing+1:]
+		}
+
+		if rest != "" {
+			if !strings.HasPrefix(rest, ".") || len(rest) == 1 {
+				return nil, fmt.Errorf("invalid path %q: expected . or end at %q", expr, rest)
+			}
+			rest = rest[1:]
+		}
+	}
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("empty path")
+	}
+	return steps, nil
+}
+
+// parseSelector parses what's inside [...]: an index, or key=value.
+func parseSelector(s string) (pathStep, error) {
+	if key, value, ok := strings.Cut(s, "="); ok {
+		if key == "" {
+			return pathStep{}, fmt.Errorf("missing key in [%s]", s)
+		}
+		return pathStep{index: -1, selectKey: key, selectValue: value}, nil
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil || i < 0 {
+		return pathStep{}, fmt.Errorf("[%s] isn't an index or key=value", s)
+	}
+	return pathStep{index: i}, nil
+}
+
+// child returns the node step picks out of node, and its position in
+// node.Content - or nil if there's no such child. It's an error if node is
+// the wrong kind for the step.
+func (s pathStep) child(node *yaml.Node) (*yaml.Node, int, error) {
+	if s.key != "" {
+		if node.Kind != yaml.MappingNode {
+			return nil, 0, fmt.Errorf("can't look up %q: not a mapping", s.key)
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == s.key {
+				return node.Content[i+1], i + 1, nil
+			}
+		}
+		return nil, 0, nil
+	}
+
+	if node.Kind != yaml.SequenceNode {
+		return nil, 0, fmt.Errorf("can't look up %s: not a list", s)
+	}
+	if s.index >= 0 {
+		if s.index < len(node.Content) {
+			return node.Content[s.index], s.index, nil
+		}
+		return nil, 0, nil
+	}
+	for i, item := range node.Content {
+		if v := mappingValue(item, s.selectKey); v != nil && v.Value == s.selectValue {
+			return item, i, nil
+		}
+	}
+	return nil, 0, nil
+}
+
+// insert adds value to node as the (missing) child step picks out.
+func (s pathStep) insert(node, value *yaml.Node) error {
+	switch {
+	case s.key != "":
+		appendMapEntry(node, s.key, value)
+	case s.index >= 0:
+		if s.index != len(node.Content) {
+			return fmt.Errorf("can't set %s: list has %d items", s, len(node.Content))
+		}
+		node.Content = append(node.Content, value)
+	default:
+		// A new item picked by key starts out holding that key, so the
+		// same path finds it again.
+		if value.Kind != yaml.MappingNode {
+			return fmt.Errorf("can't set %s to a non-mapping value", s)
+		}
+		if mappingValue(value, s.selectKey) == nil {
+			value.Content = append([]*yaml.Node{stringNode(s.selectKey), stringNode(s.selectValue)}, value.Content...)
+		}
+		node.Content = append(node.Content, value)
+	}
+	return nil
+}
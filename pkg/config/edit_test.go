@@ -0,0 +1,303 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+const testInfra = `# Homelab nodes
+nodes:
+  - name: pi-0 # the first server
+    address: 10.0.0.10
+    role: server
+    ssh:
+      user: pi
+      agent: true
+  - name: pi-1
+    address: 10.0.0.11
+    role: agent
+    ssh:
+      # set up by bootstrap
+      user: pi
+      agent: true
+cluster:
+  vip: 10.0.0.5 # kube-vip
+`
+
+func openTestEditor(t *testing.T, content string) (*Editor, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "infra.yaml")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	e, err := OpenEditor(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e, path
+}
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		expr    string
+		want    []pathStep
+		wantErr string
+	}{
+		{expr: "cluster", want: []pathStep{{key: "cluster", index: -1}}},
+		{expr: "cluster.vip", want: []pathStep{{key: "cluster", index: -1}, {key: "vip", index: -1}}},
+		{expr: "cluster.sans[0]", want: []pathStep{{key: "cluster", index: -1}, {key: "sans", index: -1}, {index: 0}}},
+		{
+			expr: "nodes[name=pi-0].ssh.user",
+			want: []pathStep{
+				{key: "nodes", index: -1},
+				{index: -1, selectKey: "name", selectValue: "pi-0"},
+				{key: "ssh", index: -1},
+				{key: "user", index: -1},
+			},
+		},
+		{expr: "nodes[name=]", want: []pathStep{{key: "nodes", index: -1}, {index: -1, selectKey: "name"}}},
+		{expr: "a[0][1]", want: []pathStep{{key: "a", index: -1}, {index: 0}, {index: 1}}},
+		{expr: "", wantErr: "empty path"},
+		{expr: ".vip", wantErr: "expected a key"},
+		{expr: "cluster.", wantErr: "expected . or end"},
+		{expr: "cluster..vip", wantErr: "expected a key"},
+		{expr: "[0]", wantErr: "expected a key"},
+		{expr: "nodes[0", wantErr: "unclosed ["},
+		{expr: "nodes[0]x", wantErr: "expected . or end"},
+		{expr: "nodes[-1]", wantErr: "isn't an index or key=value"},
+		{expr: "nodes[x]", wantErr: "isn't an index or key=value"},
+		{expr: "nodes[=pi-0]", wantErr: "missing key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := parsePath(tt.expr)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parsePath(%q) error = %v, want one containing %q", tt.expr, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parsePath(%q): %v", tt.expr, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parsePath(%q) = %+v, want %+v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEditorSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		value   string
+		get     string // where to read the value back, if not expr
+		wantErr string
+	}{
+		{name: "replace scalar", expr: "cluster.vip", value: "10.0.0.6"},
+		{name: "new key in existing map", expr: "cluster.token", value: "secret"},
+		{name: "missing map", expr: "tailscale.magicDnsSuffix", value: "tail1234.ts.net"},
+		{name: "nested missing maps", expr: "lumenetes.location.latitude", value: "51.5"},
+		{name: "missing list", expr: "cluster.sans[0]", value: "k3s.example.com"},
+		{name: "append to list", expr: "nodes[2]", value: "{name: pi-2}", get: "nodes[name=pi-2].name"},
+		{name: "index past the end", expr: "nodes[3]", value: "{name: pi-3}", wantErr: "list has 2 items"},
+		{name: "item by index", expr: "nodes[1].ssh.user", value: "admin"},
+		{name: "item by key", expr: "nodes[name=pi-0].address", value: "10.0.0.20"},
+		{name: "new item by key", expr: "nodes[name=pi-2].address", value: "10.0.0.12"},
+		{name: "new item by key in missing list", expr: "lumenetes.hue.bridges[id=001788fffe123456].appKey", value: "key"},
+		{name: "key into a list", expr: "nodes.name", value: "x", wantErr: "not a mapping"},
+		{name: "index into a map", expr: "cluster[0]", value: "x", wantErr: "not a list"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, _ := openTestEditor(t, testInfra)
+			value, err := ParseValue(tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = e.Set(tt.expr, value)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Set(%q) error = %v, want one containing %q", tt.expr, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Set(%q): %v", tt.expr, err)
+			}
+
+			get, want := tt.get, tt.value
+			if get == "" {
+				get = tt.expr
+			} else {
+				want = strings.TrimSuffix(strings.TrimPrefix(tt.value, "{name: "), "}")
+			}
+			got, err := e.Get(get)
+			if err != nil {
+				t.Fatalf("Get(%q): %v", get, err)
+			}
+			if got == nil || got.Value != want {
+				t.Errorf("Get(%q) = %v, want %q", get, got, want)
+			}
+		})
+	}
+}
+
+func TestEditorSetKeepsComments(t *testing.T) {
+	e, _ := openTestEditor(t, testInfra)
+	if err := e.SetString("cluster.vip", "10.0.0.6"); err != nil {
+		t.Fatal(err)
+	}
+	got, err := e.Get("cluster.vip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.LineComment != "# kube-vip" {
+		t.Errorf("cluster.vip line comment = %q, want %q", got.LineComment, "# kube-vip")
+	}
+}
+
+func TestEditorUnset(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		expr    string
+		check   string // must be missing afterwards
+		want    string // YAML the parent should be left as
+		wantErr string
+	}{
+		{
+			name:    "mapping entry",
+			content: "cluster:\n  vip: 10.0.0.5\n  token: t\n",
+			expr:    "cluster.vip",
+			check:   "cluster.vip",
+			want:    "cluster:\n    token: t\n",
+		},
+		{
+			name:    "middle list item",
+			content: "cluster:\n  sans: [a, b, c]\n",
+			expr:    "cluster.sans[1]",
+			want:    "cluster:\n    sans: [a, c]\n",
+		},
+		{
+			name:    "last list item",
+			content: "cluster:\n  sans:\n    - a\n",
+			expr:    "cluster.sans[0]",
+			check:   "cluster.sans[0]",
+			want:    "cluster:\n    sans: []\n",
+		},
+		{
+			name:    "last item by key",
+			content: "nodes:\n  - name: pi-0\n",
+			expr:    "nodes[name=pi-0]",
+			check:   "nodes[name=pi-0]",
+			want:    "nodes: []\n",
+		},
+		{
+			name:    "missing key",
+			content: "cluster:\n  vip: 10.0.0.5\n",
+			expr:    "cluster.token",
+			wantErr: "cluster.token not found",
+		},
+		{
+			name:    "missing parent",
+			content: "cluster:\n  vip: 10.0.0.5\n",
+			expr:    "tailscale.magicDnsSuffix",
+			wantErr: "tailscale.magicDnsSuffix not found",
+		},
+		{
+			name:    "index past the end",
+			content: "cluster:\n  sans: [a]\n",
+			expr:    "cluster.sans[1]",
+			wantErr: "cluster.sans[1] not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, _ := openTestEditor(t, tt.content)
+			err := e.Unset(tt.expr)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Unset(%q) error = %v, want one containing %q", tt.expr, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unset(%q): %v", tt.expr, err)
+			}
+			if tt.check != "" {
+				if got, err := e.Get(tt.check); err != nil || got != nil {
+					t.Errorf("Get(%q) after Unset = %v, %v; want nothing", tt.check, got, err)
+				}
+			}
+			out, err := yaml.Marshal(&e.doc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(out) != tt.want {
+				t.Errorf("after Unset(%q):\n%s\nwant:\n%s", tt.expr, out, tt.want)
+			}
+		})
+	}
+}
+
+func TestEditorSave(t *testing.T) {
+	e, path := openTestEditor(t, testInfra)
+	if err := e.SetString("nodes[name=pi-1].ssh.user", "admin"); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := string(data)
+	for _, comment := range []string{"# Homelab nodes", "# the first server", "# set up by bootstrap", "# kube-vip"} {
+		if !strings.Contains(saved, comment) {
+			t.Errorf("saved file lost comment %q:\n%s", comment, saved)
+		}
+	}
+	if !strings.Contains(saved, "user: admin") {
+		t.Errorf("saved file is missing the edit:\n%s", saved)
+	}
+}
+
+func TestEditorSaveRefusesInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{name: "required key", expr: "nodes[name=pi-0].ssh.user", wantErr: "ssh.user is required"},
+		{name: "last node", expr: "nodes", wantErr: "at least one node"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, path := openTestEditor(t, testInfra)
+			if err := e.Unset(tt.expr); err != nil {
+				t.Fatal(err)
+			}
+			err := e.Save()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Save() error = %v, want one containing %q", err, tt.wantErr)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != testInfra {
+				t.Errorf("invalid edit was written:\n%s", data)
+			}
+		})
+	}
+}
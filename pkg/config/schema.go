@@ -0,0 +1,143 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+//go:generate sh -c "go run ../../cli/infra config schema > ../../infra.schema.json"
+
+// Schema is the subset of JSON Schema that InfraSchema uses - enough for
+// editors to autocomplete and flag infra.yaml, and for ValidateFile to
+// check it against the same rules.
+type Schema struct {
+	SchemaURI   string             `json:"$schema,omitempty"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	// AdditionalProperties is false for structs, whose keys are all known,
+	// and the value schema for maps.
+	AdditionalProperties any      `json:"additionalProperties,omitempty"`
+	Items                *Schema  `json:"items,omitempty"`
+	Required             []string `json:"required,omitempty"`
+	Enum                 []string `json:"enum,omitempty"`
+	Pattern              string   `json:"pattern,omitempty"`
+	Minimum              *float64 `json:"minimum,omitempty"`
+	Maximum              *float64 `json:"maximum,omitempty"`
+}
+
+// hostnamePattern matches a DNS name of at least two labels.
+const hostnamePattern = `^([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\.)+[A-Za-z]{2,63}$`
+
+// macPattern matches what net.ParseMAC accepts, which is what loading
+// infra.yaml checks nodes[].mac with: 6, 8 or 20 bytes as colon- or
+// hyphen-separated pairs, dot-separated groups of four hex digits, or bare
+// hex.
+var macPattern = strings.NewReplacer("XXXX", "[0-9A-Fa-f]{4}", "XX", "[0-9A-Fa-f]{2}").Replace(
+	`^(XX(:XX){5}((:XX){2}((:XX){12})?)?|XX(-XX){5}((-XX){2}((-XX){12})?)?|XXXX(\.XXXX){2}((\.XXXX)((\.XXXX){6})?)?|(XX){6}((XX){2}((XX){12})?)?)$`)
+
+// schemaRules adds what the Go types can't say to the schema generated
+// from them, keyed by path (with [] standing for any list item).
+var schemaRules = map[string]func(*Schema){
+	"nodes[]":                  required("name", "address", "ssh"),
+	"nodes[].role":             enum(string(RoleServer), string(RoleAgent)),
+	"nodes[].mac":              pattern(macPattern, "a MAC address like dc:a6:32:01:02:03 (or dc-a6-32-01-02-03, dca6.3201.0203)"),
+	"nodes[].ssh":              required("user"),
+	"nodes[].ssh.port":         between(1, 65535),
+	"nodes[].network":          required("prefix", "gateway", "dns"),
+	"nodes[].network.prefix":   between(1, 32),
+	"cloudflare.tunnel.domain": pattern(hostnamePattern, "a domain name like example.com"),
+	"cloudflare.access.teamDomain": pattern(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`,
+		"just the <team-name> of <team-name>.cloudflareaccess.com"),
+	"tailscale.magicDnsSuffix": pattern(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?\.ts\.net$`,
+		"the tailnet's DNS name, like tail1234.ts.net"),
+	"lumenetes.hue.bridges[]": required("id", "appKey"),
+	"lumenetes.hue.bridges[].id": pattern(`^[0-9A-Fa-f]{16}$`,
+		"the bridge's 16 hex digit ID, like 001788FFFE123456"),
+	"lumenetes.location.latitude":  between(-90, 90),
+	"lumenetes.location.longitude": between(-180, 180),
+}
+
+func required(keys ...string) func(*Schema) {
+	return func(s *Schema) { s.Required = keys }
+}
+
+func enum(values ...string) func(*Schema) {
+	return func(s *Schema) { s.Enum = values }
+}
+
+// pattern's description is what a value should look like, for editors to
+// show and ValidateFile to say when one doesn't match.
+func pattern(re, description string) func(*Schema) {
+	return func(s *Schema) { s.Pattern, s.Description = re, description }
+}
+
+func between(min, max float64) func(*Schema) {
+	return func(s *Schema) { s.Minimum, s.Maximum = &min, &max }
+}
+
+// InfraSchema returns the JSON Schema for infra.yaml, generated from
+// InfraConfig's yaml tags plus schemaRules. infra.schema.json at the repo
+// root is this, written out by "go generate" for editors to use.
+func InfraSchema() *Schema {
+	s := schemaFor(reflect.TypeOf(InfraConfig{}), "")
+	s.SchemaURI = "https://json-schema.org/draft/2020-12/schema"
+	s.Title = "infra.yaml"
+	s.Description = "Homelab cluster, node and service configuration (see pkg/config)"
+	s.Required = []string{"nodes"}
+	return s
+}
+
+// schemaFor builds the schema for values of t found at path.
+func schemaFor(t reflect.Type, path string) *Schema {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var s *Schema
+	switch t.Kind() {
+	case reflect.Struct:
+		s = &Schema{Type: "object", Properties: map[string]*Schema{}, AdditionalProperties: false}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+			if !field.IsExported() || name == "-" {
+				continue
+			}
+			if name == "" {
+				name = strings.ToLower(field.Name)
+			}
+			s.Properties[name] = schemaFor(field.Type, joinPath(path, name))
+		}
+	case reflect.Slice:
+		s = &Schema{Type: "array", Items: schemaFor(t.Elem(), path+"[]")}
+	case reflect.Map:
+		s = &Schema{Type: "object", AdditionalProperties: schemaFor(t.Elem(), path+"[]")}
+	case reflect.String:
+		s = &Schema{Type: "string"}
+	case reflect.Bool:
+		s = &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		s = &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		s = &Schema{Type: "number"}
+	default:
+		panic(fmt.Sprintf("config: no schema for %s at %s", t, path))
+	}
+
+	if rule, ok := schemaRules[path]; ok {
+		rule(s)
+	}
+	return s
+}
+
+// joinPath appends key to the dotted path.
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
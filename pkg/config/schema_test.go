@@ -0,0 +1,35 @@
+package config
+
+import (
+	"net"
+	"regexp"
+	"testing"
+)
+
+func TestMACPatternMatchesParseMAC(t *testing.T) {
+	re := regexp.MustCompile(macPattern)
+	for _, mac := range []string{
+		"dc:a6:32:01:02:03",
+		"DC:A6:32:01:02:03",
+		"dc-a6-32-01-02-03",
+		"dca6.3201.0203",
+		"dca632010203",
+		"02:00:5e:10:00:00:00:01",
+		"0200.5e10.0000.0001",
+		"00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01",
+		"0000.0000.fe80.0000.0000.0000.0200.5e10.0000.0001",
+		"dc:a6:32:01:02",
+		"dc:a6:32:01:02:03:04",
+		"dc:a6-32:01:02:03",
+		"dc:a6:32:01:02:0g",
+		"dca6.3201.020",
+		"dca6:3201:0203",
+		"dca63201020",
+		"",
+	} {
+		_, err := net.ParseMAC(mac)
+		if want, got := err == nil, re.MatchString(mac); got != want {
+			t.Errorf("%q: pattern matches = %v, net.ParseMAC accepts = %v", mac, got, want)
+		}
+	}
+}
@@ -0,0 +1,278 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Problem is one thing wrong with an infra.yaml.
+type Problem struct {
+	// Path is where in the file, in the form "homelab config" takes (e.g.
+	// nodes[1].ssh.user), or empty for the file as a whole.
+	Path string
+	// Line is Path's line in the file - or, if Path is missing, its
+	// nearest parent's. Zero if unknown.
+	Line    int
+	Message string
+}
+
+func (p Problem) String() string {
+	if p.Line > 0 {
+		return fmt.Sprintf("line %d: %s", p.Line, p.Message)
+	}
+	return p.Message
+}
+
+// ValidateFile checks the infra.yaml at path against InfraSchema, the
+// checks loading it runs, and stricter cross-field checks that loading
+// doesn't enforce - returning every problem found, in line order, rather
+// than stopping at the first. The error is for a file that can't be read
+// or parsed as YAML at all.
+func ValidateFile(path string) ([]Problem, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return []Problem{{Message: "empty config file"}}, nil
+	}
+	root := doc.Content[0]
+
+	var problems []Problem
+	InfraSchema().check(root, "", &problems)
+
+	// Values of the wrong type are left zero, and already reported above.
+	var cfg InfraConfig
+	var typeErr *yaml.TypeError
+	if err := root.Decode(&cfg); err != nil && !errors.As(err, &typeErr) {
+		return nil, fmt.Errorf("failed to decode config file: %w", err)
+	}
+	applyDefaults(&cfg)
+
+	schemaProblems := problems
+	for _, p := range append(infraProblems(&cfg), crossFieldProblems(&cfg)...) {
+		if coveredBy(p.Path, schemaProblems) {
+			continue
+		}
+		p.Line = lineOf(root, p.Path)
+		problems = append(problems, p)
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool { return problems[i].Line < problems[j].Line })
+	return problems, nil
+}
+
+// coveredBy reports whether a schema problem was already found at path or
+// within it, so a check doesn't report the same mistake a second time.
+func coveredBy(path string, problems []Problem) bool {
+	if path == "" {
+		return false
+	}
+	for _, p := range problems {
+		if p.Path == path || strings.HasPrefix(p.Path, path+".") || strings.HasPrefix(p.Path, path+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// lineOf returns the line of path within root, or of its nearest parent
+// that exists.
+func lineOf(root *yaml.Node, path string) int {
+	line := root.Line
+	steps, err := parsePath(path)
+	if err != nil {
+		return line
+	}
+	node := root
+	for _, step := range steps {
+		child, _, err := step.child(node)
+		if err != nil || child == nil {
+			break
+		}
+		node, line = child, child.Line
+	}
+	return line
+}
+
+// check appends a Problem to problems for each way node, found at path,
+// doesn't match s.
+func (s *Schema) check(node *yaml.Node, path string, problems *[]Problem) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	report := func(format string, args ...any) {
+		*problems = append(*problems, Problem{Path: path, Line: node.Line, Message: where(path) + fmt.Sprintf(format, args...)})
+	}
+
+	// A key with no value decodes to the zero value, like an absent one.
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+		return
+	}
+	if got := yamlKind(node); !schemaAccepts(s.Type, got) {
+		if s.Type == "string" && node.Kind == yaml.ScalarNode {
+			report("expected string, got %s (quote it)", got)
+		} else {
+			report("expected %s, got %s", schemaKind(s.Type), got)
+		}
+		return
+	}
+
+	switch s.Type {
+	case "object":
+		present := map[string]bool{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			present[key.Value] = true
+			keyPath := joinPath(path, key.Value)
+			if prop, ok := s.Properties[key.Value]; ok {
+				prop.check(value, keyPath, problems)
+			} else if values, ok := s.AdditionalProperties.(*Schema); ok {
+				values.check(value, keyPath, problems)
+			} else {
+				*problems = append(*problems, Problem{Path: keyPath, Line: key.Line, Message: fmt.Sprintf("%s: unknown key", keyPath)})
+			}
+		}
+		for _, key := range s.Required {
+			if !present[key] {
+				keyPath := joinPath(path, key)
+				*problems = append(*problems, Problem{Path: keyPath, Line: node.Line, Message: keyPath + " is required"})
+			}
+		}
+	case "array":
+		for i, item := range node.Content {
+			s.Items.check(item, fmt.Sprintf("%s[%d]", path, i), problems)
+		}
+	default:
+		if len(s.Enum) > 0 && !contains(s.Enum, node.Value) {
+			report("%q must be one of %s", node.Value, strings.Join(s.Enum, ", "))
+		}
+		if s.Pattern != "" && !regexp.MustCompile(s.Pattern).MatchString(node.Value) {
+			if s.Description != "" {
+				report("%q is not valid - expected %s", node.Value, s.Description)
+			} else {
+				report("%q doesn't match %s", node.Value, s.Pattern)
+			}
+		}
+		if s.Minimum != nil && s.Maximum != nil {
+			if v, err := strconv.ParseFloat(node.Value, 64); err == nil && (v < *s.Minimum || v > *s.Maximum) {
+				report("%s must be between %g and %g", node.Value, *s.Minimum, *s.Maximum)
+			}
+		}
+	}
+}
+
+// where prefixes a message about path with it.
+func where(path string) string {
+	if path == "" {
+		return ""
+	}
+	return path + ": "
+}
+
+// yamlKind names node's kind the way check's messages do.
+func yamlKind(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "mapping"
+	case yaml.SequenceNode:
+		return "list"
+	}
+	switch node.Tag {
+	case "!!str":
+		return "string"
+	case "!!int":
+		return "integer"
+	case "!!float":
+		return "number"
+	case "!!bool":
+		return "boolean"
+	}
+	return strings.TrimPrefix(node.Tag, "!!")
+}
+
+// schemaKind names a JSON Schema type the way yamlKind does.
+func schemaKind(t string) string {
+	switch t {
+	case "object":
+		return "mapping"
+	case "array":
+		return "list"
+	}
+	return t
+}
+
+// schemaAccepts reports whether a value of yamlKind got is a JSON Schema t.
+func schemaAccepts(t, got string) bool {
+	if t == "number" && got == "integer" {
+		return true
+	}
+	return schemaKind(t) == got
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// crossFieldProblems are the checks that need more than one field at a
+// time - stricter than loading infra.yaml requires, so only "config
+// validate" runs them.
+func crossFieldProblems(cfg *InfraConfig) []Problem {
+	var problems []Problem
+
+	addresses := map[string]string{}
+	for i, node := range cfg.Nodes {
+		if node.Address == "" {
+			continue
+		}
+		if other, ok := addresses[node.Address]; ok {
+			problems = append(problems, Problem{
+				Path:    fmt.Sprintf("nodes[%d].address", i),
+				Message: fmt.Sprintf("node '%s': address %s is already used by node '%s'", node.Name, node.Address, other),
+			})
+			continue
+		}
+		addresses[node.Address] = node.Name
+	}
+
+	bridges := map[string]bool{}
+	for i, bridge := range cfg.Lumenetes.Hue.Bridges {
+		id := strings.ToLower(bridge.ID)
+		if bridges[id] {
+			problems = append(problems, Problem{
+				Path:    fmt.Sprintf("lumenetes.hue.bridges[%d].id", i),
+				Message: fmt.Sprintf("duplicate hue bridge id: %s", bridge.ID),
+			})
+		}
+		bridges[id] = true
+	}
+
+	// "up" builds and pushes the app images (home, lumenetes, ...) to
+	// ghcr.io, so a file set up for it - cloudflare.accountId is one of
+	// the fields it requires - needs both GHCR credentials.
+	buildsImages := cfg.Cloudflare.AccountID != ""
+	if cfg.GHCR.Username == "" && (buildsImages || cfg.GHCR.Token != "") {
+		problems = append(problems, Problem{Path: "ghcr.username", Message: "ghcr.username is required to push the images homelab up builds"})
+	}
+	if cfg.GHCR.Token == "" && (buildsImages || cfg.GHCR.Username != "") {
+		problems = append(problems, Problem{Path: "ghcr.token", Message: "ghcr.token (with write:packages) is required to push the images homelab up builds"})
+	}
+
+	return problems
+}
@@ -331,24 +331,34 @@ func (o CircadianSchedulePatchTypeOutput) Status() CircadianScheduleStatusPatchP
 
 // CircadianScheduleSpec declares a continuous brightness/colorTempK curve
 // for all of a Group's lights, anchored to sun position rather than fixed
-// times. Unlike Scene, there's no per-light Lights list - a circadian
-// curve is one uniform "ambient tone" applied to every light in Group, not
-// per-fixture state. A CircadianSchedule does no enactment itself -
-// internal/groupcontroller.Reconciler applies the current interpolated
-// value onto each target Light.Spec when the owning Group's
-// Spec.ActiveScene references this schedule (Kind: CircadianSchedule),
-// reusing the same per-light capability-sentinel skip Scene enactment
-// already uses.
+// times - either via keyframes pinned to solar events, or directly via the
+// sun's elevation angle (see Mode). Unlike Scene, there's no per-light
+// Lights list - a circadian curve is one uniform "ambient tone" applied to
+// every light in Group, not per-fixture state. A CircadianSchedule does no
+// enactment itself - internal/groupcontroller.Reconciler applies the
+// current interpolated value onto each target Light.Spec when the owning
+// Group's Spec.ActiveScene references this schedule (Kind:
+// CircadianSchedule), reusing the same per-light capability-sentinel skip
+// Scene enactment already uses.
 type CircadianScheduleSpec struct {
+	// Elevation defines the curve in "elevation" mode, at least 2 points,
+	// interpolated against the sun's elevation right now by
+	// internal/circadian.InterpolateElevation - clamped to the first/last
+	// point outside the table's range. Order in this list doesn't matter.
+	// Elevation mode never manages on/off (there's no per-point On), since
+	// a step function keyed on elevation would switch at the same angle
+	// morning and evening.
+	Elevation []CircadianScheduleSpecElevation `pulumi:"elevation"`
 	// Group is the name of the Group this schedule applies to. A Group's
 	// Spec.ActiveScene must reference this schedule's own metadata.name
 	// for it to ever be enacted - same reciprocal-match convention as
 	// SceneSpec.Group.
 	Group *string `pulumi:"group"`
-	// Keyframes define the curve, at least 2, resolved and interpolated
-	// against "now" by internal/circadian.Interpolate. Order in this list
-	// doesn't matter - they're sorted by resolved instant before
-	// interpolating.
+	// Keyframes define the curve in "keyframes" mode, at least 2, resolved
+	// and interpolated against "now" by internal/circadian.Interpolate.
+	// Order in this list doesn't matter - they're sorted by resolved
+	// instant before interpolating. Used on every day no Variant lists -
+	// may be left empty only if Variants cover the whole week.
 	Keyframes []CircadianScheduleSpecKeyframes `pulumi:"keyframes"`
 	// Latitude of the location Keyframes are anchored to, decimal degrees
 	// positive north. Required, not defaulted: 0 is a real location (Null
@@ -361,6 +371,20 @@ type CircadianScheduleSpec struct {
 	// Longitude of the location Keyframes are anchored to, decimal degrees
 	// positive east. Required - see Latitude's doc comment for why.
 	Longitude *float64 `pulumi:"longitude"`
+	// Mode selects which of Keyframes/Elevation defines the curve - the
+	// other is ignored. Defaults to "keyframes".
+	Mode *string `pulumi:"mode"`
+	// TimeZone is the IANA time zone name (e.g. "Europe/London") clockTime
+	// keyframes' Time and Variants' Days are read in. Required if any
+	// keyframe uses clockTime or any Variants are set - deliberately not
+	// defaulted to UTC, for the same "a silently wrong place is worse than
+	// a validation error" reason as Latitude.
+	TimeZone *string `pulumi:"timeZone"`
+	// Variants swap in a different keyframe set on particular days of
+	// the week (in TimeZone), e.g. a later start at the weekend - see
+	// internal/circadian.InterpolateVariants for how the day boundary is
+	// smoothed over. Only used in "keyframes" mode.
+	Variants []CircadianScheduleSpecVariants `pulumi:"variants"`
 }
 
 // CircadianScheduleSpecInput is an input type that accepts CircadianScheduleSpecArgs and CircadianScheduleSpecOutput values.
@@ -376,24 +400,34 @@ type CircadianScheduleSpecInput interface {
 
 // CircadianScheduleSpec declares a continuous brightness/colorTempK curve
 // for all of a Group's lights, anchored to sun position rather than fixed
-// times. Unlike Scene, there's no per-light Lights list - a circadian
-// curve is one uniform "ambient tone" applied to every light in Group, not
-// per-fixture state. A CircadianSchedule does no enactment itself -
-// internal/groupcontroller.Reconciler applies the current interpolated
-// value onto each target Light.Spec when the owning Group's
-// Spec.ActiveScene references this schedule (Kind: CircadianSchedule),
-// reusing the same per-light capability-sentinel skip Scene enactment
-// already uses.
+// times - either via keyframes pinned to solar events, or directly via the
+// sun's elevation angle (see Mode). Unlike Scene, there's no per-light
+// Lights list - a circadian curve is one uniform "ambient tone" applied to
+// every light in Group, not per-fixture state. A CircadianSchedule does no
+// enactment itself - internal/groupcontroller.Reconciler applies the
+// current interpolated value onto each target Light.Spec when the owning
+// Group's Spec.ActiveScene references this schedule (Kind:
+// CircadianSchedule), reusing the same per-light capability-sentinel skip
+// Scene enactment already uses.
 type CircadianScheduleSpecArgs struct {
+	// Elevation defines the curve in "elevation" mode, at least 2 points,
+	// interpolated against the sun's elevation right now by
+	// internal/circadian.InterpolateElevation - clamped to the first/last
+	// point outside the table's range. Order in this list doesn't matter.
+	// Elevation mode never manages on/off (there's no per-point On), since
+	// a step function keyed on elevation would switch at the same angle
+	// morning and evening.
+	Elevation CircadianScheduleSpecElevationArrayInput `pulumi:"elevation"`
 	// Group is the name of the Group this schedule applies to. A Group's
 	// Spec.ActiveScene must reference this schedule's own metadata.name
 	// for it to ever be enacted - same reciprocal-match convention as
 	// SceneSpec.Group.
 	Group pulumi.StringPtrInput `pulumi:"group"`
-	// Keyframes define the curve, at least 2, resolved and interpolated
-	// against "now" by internal/circadian.Interpolate. Order in this list
-	// doesn't matter - they're sorted by resolved instant before
-	// interpolating.
+	// Keyframes define the curve in "keyframes" mode, at least 2, resolved
+	// and interpolated against "now" by internal/circadian.Interpolate.
+	// Order in this list doesn't matter - they're sorted by resolved
+	// instant before interpolating. Used on every day no Variant lists -
+	// may be left empty only if Variants cover the whole week.
 	Keyframes CircadianScheduleSpecKeyframesArrayInput `pulumi:"keyframes"`
 	// Latitude of the location Keyframes are anchored to, decimal degrees
 	// positive north. Required, not defaulted: 0 is a real location (Null
@@ -406,6 +440,20 @@ type CircadianScheduleSpecArgs struct {
 	// Longitude of the location Keyframes are anchored to, decimal degrees
 	// positive east. Required - see Latitude's doc comment for why.
 	Longitude pulumi.Float64PtrInput `pulumi:"longitude"`
+	// Mode selects which of Keyframes/Elevation defines the curve - the
+	// other is ignored. Defaults to "keyframes".
+	Mode pulumi.StringPtrInput `pulumi:"mode"`
+	// TimeZone is the IANA time zone name (e.g. "Europe/London") clockTime
+	// keyframes' Time and Variants' Days are read in. Required if any
+	// keyframe uses clockTime or any Variants are set - deliberately not
+	// defaulted to UTC, for the same "a silently wrong place is worse than
+	// a validation error" reason as Latitude.
+	TimeZone pulumi.StringPtrInput `pulumi:"timeZone"`
+	// Variants swap in a different keyframe set on particular days of
+	// the week (in TimeZone), e.g. a later start at the weekend - see
+	// internal/circadian.InterpolateVariants for how the day boundary is
+	// smoothed over. Only used in "keyframes" mode.
+	Variants CircadianScheduleSpecVariantsArrayInput `pulumi:"variants"`
 }
 
 func (CircadianScheduleSpecArgs) ElementType() reflect.Type {
@@ -463,14 +511,15 @@ func (i *circadianScheduleSpecPtrType) ToCircadianScheduleSpecPtrOutputWithConte
 
 // CircadianScheduleSpec declares a continuous brightness/colorTempK curve
 // for all of a Group's lights, anchored to sun position rather than fixed
-// times. Unlike Scene, there's no per-light Lights list - a circadian
-// curve is one uniform "ambient tone" applied to every light in Group, not
-// per-fixture state. A CircadianSchedule does no enactment itself -
-// internal/groupcontroller.Reconciler applies the current interpolated
-// value onto each target Light.Spec when the owning Group's
-// Spec.ActiveScene references this schedule (Kind: CircadianSchedule),
-// reusing the same per-light capability-sentinel skip Scene enactment
-// already uses.
+// times - either via keyframes pinned to solar events, or directly via the
+// sun's elevation angle (see Mode). Unlike Scene, there's no per-light
+// Lights list - a circadian curve is one uniform "ambient tone" applied to
+// every light in Group, not per-fixture state. A CircadianSchedule does no
+// enactment itself - internal/groupcontroller.Reconciler applies the
+// current interpolated value onto each target Light.Spec when the owning
+// Group's Spec.ActiveScene references this schedule (Kind:
+// CircadianSchedule), reusing the same per-light capability-sentinel skip
+// Scene enactment already uses.
 type CircadianScheduleSpecOutput struct{ *pulumi.OutputState }
 
 func (CircadianScheduleSpecOutput) ElementType() reflect.Type {
@@ -495,6 +544,17 @@ func (o CircadianScheduleSpecOutput) ToCircadianScheduleSpecPtrOutputWithContext
 	}).(CircadianScheduleSpecPtrOutput)
 }
 
+// Elevation defines the curve in "elevation" mode, at least 2 points,
+// interpolated against the sun's elevation right now by
+// internal/circadian.InterpolateElevation - clamped to the first/last
+// point outside the table's range. Order in this list doesn't matter.
+// Elevation mode never manages on/off (there's no per-point On), since
+// a step function keyed on elevation would switch at the same angle
+// morning and evening.
+func (o CircadianScheduleSpecOutput) Elevation() CircadianScheduleSpecElevationArrayOutput {
+	return o.ApplyT(func(v CircadianScheduleSpec) []CircadianScheduleSpecElevation { return v.Elevation }).(CircadianScheduleSpecElevationArrayOutput)
+}
+
 // Group is the name of the Group this schedule applies to. A Group's
 // Spec.ActiveScene must reference this schedule's own metadata.name
 // for it to ever be enacted - same reciprocal-match convention as
@@ -503,10 +563,11 @@ func (o CircadianScheduleSpecOutput) Group() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v CircadianScheduleSpec) *string { return v.Group }).(pulumi.StringPtrOutput)
 }
 
-// Keyframes define the curve, at least 2, resolved and interpolated
-// against "now" by internal/circadian.Interpolate. Order in this list
-// doesn't matter - they're sorted by resolved instant before
-// interpolating.
+// Keyframes define the curve in "keyframes" mode, at least 2, resolved
+// and interpolated against "now" by internal/circadian.Interpolate.
+// Order in this list doesn't matter - they're sorted by resolved
+// instant before interpolating. Used on every day no Variant lists -
+// may be left empty only if Variants cover the whole week.
 func (o CircadianScheduleSpecOutput) Keyframes() CircadianScheduleSpecKeyframesArrayOutput {
 	return o.ApplyT(func(v CircadianScheduleSpec) []CircadianScheduleSpecKeyframes { return v.Keyframes }).(CircadianScheduleSpecKeyframesArrayOutput)
 }
@@ -528,6 +589,29 @@ func (o CircadianScheduleSpecOutput) Longitude() pulumi.Float64PtrOutput {
 	return o.ApplyT(func(v CircadianScheduleSpec) *float64 { return v.Longitude }).(pulumi.Float64PtrOutput)
 }
 
+// Mode selects which of Keyframes/Elevation defines the curve - the
+// other is ignored. Defaults to "keyframes".
+func (o CircadianScheduleSpecOutput) Mode() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpec) *string { return v.Mode }).(pulumi.StringPtrOutput)
+}
+
+// TimeZone is the IANA time zone name (e.g. "Europe/London") clockTime
+// keyframes' Time and Variants' Days are read in. Required if any
+// keyframe uses clockTime or any Variants are set - deliberately not
+// defaulted to UTC, for the same "a silently wrong place is worse than
+// a validation error" reason as Latitude.
+func (o CircadianScheduleSpecOutput) TimeZone() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpec) *string { return v.TimeZone }).(pulumi.StringPtrOutput)
+}
+
+// Variants swap in a different keyframe set on particular days of
+// the week (in TimeZone), e.g. a later start at the weekend - see
+// internal/circadian.InterpolateVariants for how the day boundary is
+// smoothed over. Only used in "keyframes" mode.
+func (o CircadianScheduleSpecOutput) Variants() CircadianScheduleSpecVariantsArrayOutput {
+	return o.ApplyT(func(v CircadianScheduleSpec) []CircadianScheduleSpecVariants { return v.Variants }).(CircadianScheduleSpecVariantsArrayOutput)
+}
+
 type CircadianScheduleSpecPtrOutput struct{ *pulumi.OutputState }
 
 func (CircadianScheduleSpecPtrOutput) ElementType() reflect.Type {
@@ -552,6 +636,22 @@ func (o CircadianScheduleSpecPtrOutput) Elem() CircadianScheduleSpecOutput {
 	}).(CircadianScheduleSpecOutput)
 }
 
+// Elevation defines the curve in "elevation" mode, at least 2 points,
+// interpolated against the sun's elevation right now by
+// internal/circadian.InterpolateElevation - clamped to the first/last
+// point outside the table's range. Order in this list doesn't matter.
+// Elevation mode never manages on/off (there's no per-point On), since
+// a step function keyed on elevation would switch at the same angle
+// morning and evening.
+func (o CircadianScheduleSpecPtrOutput) Elevation() CircadianScheduleSpecElevationArrayOutput {
+	return o.ApplyT(func(v *CircadianScheduleSpec) []CircadianScheduleSpecElevation {
+		if v == nil {
+			return nil
+		}
+		return v.Elevation
+	}).(CircadianScheduleSpecElevationArrayOutput)
+}
+
 // Group is the name of the Group this schedule applies to. A Group's
 // Spec.ActiveScene must reference this schedule's own metadata.name
 // for it to ever be enacted - same reciprocal-match convention as
@@ -565,10 +665,11 @@ func (o CircadianScheduleSpecPtrOutput) Group() pulumi.StringPtrOutput {
 	}).(pulumi.StringPtrOutput)
 }
 
-// Keyframes define the curve, at least 2, resolved and interpolated
-// against "now" by internal/circadian.Interpolate. Order in this list
-// doesn't matter - they're sorted by resolved instant before
-// interpolating.
+// Keyframes define the curve in "keyframes" mode, at least 2, resolved
+// and interpolated against "now" by internal/circadian.Interpolate.
+// Order in this list doesn't matter - they're sorted by resolved
+// instant before interpolating. Used on every day no Variant lists -
+// may be left empty only if Variants cover the whole week.
 func (o CircadianScheduleSpecPtrOutput) Keyframes() CircadianScheduleSpecKeyframesArrayOutput {
 	return o.ApplyT(func(v *CircadianScheduleSpec) []CircadianScheduleSpecKeyframes {
 		if v == nil {
@@ -605,15 +706,352 @@ func (o CircadianScheduleSpecPtrOutput) Longitude() pulumi.Float64PtrOutput {
 	}).(pulumi.Float64PtrOutput)
 }
 
+// Mode selects which of Keyframes/Elevation defines the curve - the
+// other is ignored. Defaults to "keyframes".
+func (o CircadianScheduleSpecPtrOutput) Mode() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CircadianScheduleSpec) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Mode
+	}).(pulumi.StringPtrOutput)
+}
+
+// TimeZone is the IANA time zone name (e.g. "Europe/London") clockTime
+// keyframes' Time and Variants' Days are read in. Required if any
+// keyframe uses clockTime or any Variants are set - deliberately not
+// defaulted to UTC, for the same "a silently wrong place is worse than
+// a validation error" reason as Latitude.
+func (o CircadianScheduleSpecPtrOutput) TimeZone() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CircadianScheduleSpec) *string {
+		if v == nil {
+			return nil
+		}
+		return v.TimeZone
+	}).(pulumi.StringPtrOutput)
+}
+
+// Variants swap in a different keyframe set on particular days of
+// the week (in TimeZone), e.g. a later start at the weekend - see
+// internal/circadian.InterpolateVariants for how the day boundary is
+// smoothed over. Only used in "keyframes" mode.
+func (o CircadianScheduleSpecPtrOutput) Variants() CircadianScheduleSpecVariantsArrayOutput {
+	return o.ApplyT(func(v *CircadianScheduleSpec) []CircadianScheduleSpecVariants {
+		if v == nil {
+			return nil
+		}
+		return v.Variants
+	}).(CircadianScheduleSpecVariantsArrayOutput)
+}
+
+// CircadianElevationPoint maps one sun elevation angle to a (Brightness,
+// ColorTempK) pair - CircadianKeyframe's counterpart for
+// CircadianModeElevation. Anchoring to elevation rather than to a solar
+// event means the curve's shape follows the season on its own: a midwinter
+// noon sun sitting 15 degrees up gets the same output a midsummer sun gets
+// at 15 degrees on its way up in mid-morning, instead of the full
+// solarNoon keyframe. The tradeoff is symmetry - morning and evening at the
+// same elevation always produce the same output, so there's no way to
+// (say) warm up faster in the evening than the morning cools down.
+type CircadianScheduleSpecElevation struct {
+	// Brightness at this elevation, 0-100.
+	Brightness *int `pulumi:"brightness"`
+	// ColorTempK at this elevation, in Kelvin.
+	ColorTempK *int `pulumi:"colorTempK"`
+	// Degrees is the sun's elevation above the horizon, negative below it.
+	// Sunrise/sunset are at about -0.833 (see internal/sun.Elevation),
+	// civil twilight ends at -6.
+	Degrees *float64 `pulumi:"degrees"`
+}
+
+// CircadianScheduleSpecElevationInput is an input type that accepts CircadianScheduleSpecElevationArgs and CircadianScheduleSpecElevationOutput values.
+// You can construct a concrete instance of `CircadianScheduleSpecElevationInput` via:
+//
+//	CircadianScheduleSpecElevationArgs{...}
+type CircadianScheduleSpecElevationInput interface {
+	pulumi.Input
+
+	ToCircadianScheduleSpecElevationOutput() CircadianScheduleSpecElevationOutput
+	ToCircadianScheduleSpecElevationOutputWithContext(context.Context) CircadianScheduleSpecElevationOutput
+}
+
+// CircadianElevationPoint maps one sun elevation angle to a (Brightness,
+// ColorTempK) pair - CircadianKeyframe's counterpart for
+// CircadianModeElevation. Anchoring to elevation rather than to a solar
+// event means the curve's shape follows the season on its own: a midwinter
+// noon sun sitting 15 degrees up gets the same output a midsummer sun gets
+// at 15 degrees on its way up in mid-morning, instead of the full
+// solarNoon keyframe. The tradeoff is symmetry - morning and evening at the
+// same elevation always produce the same output, so there's no way to
+// (say) warm up faster in the evening than the morning cools down.
+type CircadianScheduleSpecElevationArgs struct {
+	// Brightness at this elevation, 0-100.
+	Brightness pulumi.IntPtrInput `pulumi:"brightness"`
+	// ColorTempK at this elevation, in Kelvin.
+	ColorTempK pulumi.IntPtrInput `pulumi:"colorTempK"`
+	// Degrees is the sun's elevation above the horizon, negative below it.
+	// Sunrise/sunset are at about -0.833 (see internal/sun.Elevation),
+	// civil twilight ends at -6.
+	Degrees pulumi.Float64PtrInput `pulumi:"degrees"`
+}
+
+func (CircadianScheduleSpecElevationArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CircadianScheduleSpecElevation)(nil)).Elem()
+}
+
+func (i CircadianScheduleSpecElevationArgs) ToCircadianScheduleSpecElevationOutput() CircadianScheduleSpecElevationOutput {
+	return i.ToCircadianScheduleSpecElevationOutputWithContext(context.Background())
+}
+
+func (i CircadianScheduleSpecElevationArgs) ToCircadianScheduleSpecElevationOutputWithContext(ctx context.Context) CircadianScheduleSpecElevationOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CircadianScheduleSpecElevationOutput)
+}
+
+// CircadianScheduleSpecElevationArrayInput is an input type that accepts CircadianScheduleSpecElevationArray and CircadianScheduleSpecElevationArrayOutput values.
+// You can construct a concrete instance of `CircadianScheduleSpecElevationArrayInput` via:
+//
+//	CircadianScheduleSpecElevationArray{ CircadianScheduleSpecElevationArgs{...} }
+type CircadianScheduleSpecElevationArrayInput interface {
+	pulumi.Input
+
+	ToCircadianScheduleSpecElevationArrayOutput() CircadianScheduleSpecElevationArrayOutput
+	ToCircadianScheduleSpecElevationArrayOutputWithContext(context.Context) CircadianScheduleSpecElevationArrayOutput
+}
+
+type CircadianScheduleSpecElevationArray []CircadianScheduleSpecElevationInput
+
+func (CircadianScheduleSpecElevationArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CircadianScheduleSpecElevation)(nil)).Elem()
+}
+
+func (i CircadianScheduleSpecElevationArray) ToCircadianScheduleSpecElevationArrayOutput() CircadianScheduleSpecElevationArrayOutput {
+	return i.ToCircadianScheduleSpecElevationArrayOutputWithContext(context.Background())
+}
+
+func (i CircadianScheduleSpecElevationArray) ToCircadianScheduleSpecElevationArrayOutputWithContext(ctx context.Context) CircadianScheduleSpecElevationArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CircadianScheduleSpecElevationArrayOutput)
+}
+
+// CircadianElevationPoint maps one sun elevation angle to a (Brightness,
+// ColorTempK) pair - CircadianKeyframe's counterpart for
+// CircadianModeElevation. Anchoring to elevation rather than to a solar
+// event means the curve's shape follows the season on its own: a midwinter
+// noon sun sitting 15 degrees up gets the same output a midsummer sun gets
+// at 15 degrees on its way up in mid-morning, instead of the full
+// solarNoon keyframe. The tradeoff is symmetry - morning and evening at the
+// same elevation always produce the same output, so there's no way to
+// (say) warm up faster in the evening than the morning cools down.
+type CircadianScheduleSpecElevationOutput struct{ *pulumi.OutputState }
+
+func (CircadianScheduleSpecElevationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CircadianScheduleSpecElevation)(nil)).Elem()
+}
+
+func (o CircadianScheduleSpecElevationOutput) ToCircadianScheduleSpecElevationOutput() CircadianScheduleSpecElevationOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecElevationOutput) ToCircadianScheduleSpecElevationOutputWithContext(ctx context.Context) CircadianScheduleSpecElevationOutput {
+	return o
+}
+
+// Brightness at this elevation, 0-100.
+func (o CircadianScheduleSpecElevationOutput) Brightness() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecElevation) *int { return v.Brightness }).(pulumi.IntPtrOutput)
+}
+
+// ColorTempK at this elevation, in Kelvin.
+func (o CircadianScheduleSpecElevationOutput) ColorTempK() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecElevation) *int { return v.ColorTempK }).(pulumi.IntPtrOutput)
+}
+
+// Degrees is the sun's elevation above the horizon, negative below it.
+// Sunrise/sunset are at about -0.833 (see internal/sun.Elevation),
+// civil twilight ends at -6.
+func (o CircadianScheduleSpecElevationOutput) Degrees() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecElevation) *float64 { return v.Degrees }).(pulumi.Float64PtrOutput)
+}
+
+type CircadianScheduleSpecElevationArrayOutput struct{ *pulumi.OutputState }
+
+func (CircadianScheduleSpecElevationArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CircadianScheduleSpecElevation)(nil)).Elem()
+}
+
+func (o CircadianScheduleSpecElevationArrayOutput) ToCircadianScheduleSpecElevationArrayOutput() CircadianScheduleSpecElevationArrayOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecElevationArrayOutput) ToCircadianScheduleSpecElevationArrayOutputWithContext(ctx context.Context) CircadianScheduleSpecElevationArrayOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecElevationArrayOutput) Index(i pulumi.IntInput) CircadianScheduleSpecElevationOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) CircadianScheduleSpecElevation {
+		return vs[0].([]CircadianScheduleSpecElevation)[vs[1].(int)]
+	}).(CircadianScheduleSpecElevationOutput)
+}
+
+// CircadianElevationPoint maps one sun elevation angle to a (Brightness,
+// ColorTempK) pair - CircadianKeyframe's counterpart for
+// CircadianModeElevation. Anchoring to elevation rather than to a solar
+// event means the curve's shape follows the season on its own: a midwinter
+// noon sun sitting 15 degrees up gets the same output a midsummer sun gets
+// at 15 degrees on its way up in mid-morning, instead of the full
+// solarNoon keyframe. The tradeoff is symmetry - morning and evening at the
+// same elevation always produce the same output, so there's no way to
+// (say) warm up faster in the evening than the morning cools down.
+type CircadianScheduleSpecElevationPatch struct {
+	// Brightness at this elevation, 0-100.
+	Brightness *int `pulumi:"brightness"`
+	// ColorTempK at this elevation, in Kelvin.
+	ColorTempK *int `pulumi:"colorTempK"`
+	// Degrees is the sun's elevation above the horizon, negative below it.
+	// Sunrise/sunset are at about -0.833 (see internal/sun.Elevation),
+	// civil twilight ends at -6.
+	Degrees *float64 `pulumi:"degrees"`
+}
+
+// CircadianScheduleSpecElevationPatchInput is an input type that accepts CircadianScheduleSpecElevationPatchArgs and CircadianScheduleSpecElevationPatchOutput values.
+// You can construct a concrete instance of `CircadianScheduleSpecElevationPatchInput` via:
+//
+//	CircadianScheduleSpecElevationPatchArgs{...}
+type CircadianScheduleSpecElevationPatchInput interface {
+	pulumi.Input
+
+	ToCircadianScheduleSpecElevationPatchOutput() CircadianScheduleSpecElevationPatchOutput
+	ToCircadianScheduleSpecElevationPatchOutputWithContext(context.Context) CircadianScheduleSpecElevationPatchOutput
+}
+
+// CircadianElevationPoint maps one sun elevation angle to a (Brightness,
+// ColorTempK) pair - CircadianKeyframe's counterpart for
+// CircadianModeElevation. Anchoring to elevation rather than to a solar
+// event means the curve's shape follows the season on its own: a midwinter
+// noon sun sitting 15 degrees up gets the same output a midsummer sun gets
+// at 15 degrees on its way up in mid-morning, instead of the full
+// solarNoon keyframe. The tradeoff is symmetry - morning and evening at the
+// same elevation always produce the same output, so there's no way to
+// (say) warm up faster in the evening than the morning cools down.
+type CircadianScheduleSpecElevationPatchArgs struct {
+	// Brightness at this elevation, 0-100.
+	Brightness pulumi.IntPtrInput `pulumi:"brightness"`
+	// ColorTempK at this elevation, in Kelvin.
+	ColorTempK pulumi.IntPtrInput `pulumi:"colorTempK"`
+	// Degrees is the sun's elevation above the horizon, negative below it.
+	// Sunrise/sunset are at about -0.833 (see internal/sun.Elevation),
+	// civil twilight ends at -6.
+	Degrees pulumi.Float64PtrInput `pulumi:"degrees"`
+}
+
+func (CircadianScheduleSpecElevationPatchArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CircadianScheduleSpecElevationPatch)(nil)).Elem()
+}
+
+func (i CircadianScheduleSpecElevationPatchArgs) ToCircadianScheduleSpecElevationPatchOutput() CircadianScheduleSpecElevationPatchOutput {
+	return i.ToCircadianScheduleSpecElevationPatchOutputWithContext(context.Background())
+}
+
+func (i CircadianScheduleSpecElevationPatchArgs) ToCircadianScheduleSpecElevationPatchOutputWithContext(ctx context.Context) CircadianScheduleSpecElevationPatchOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CircadianScheduleSpecElevationPatchOutput)
+}
+
+// CircadianScheduleSpecElevationPatchArrayInput is an input type that accepts CircadianScheduleSpecElevationPatchArray and CircadianScheduleSpecElevationPatchArrayOutput values.
+// You can construct a concrete instance of `CircadianScheduleSpecElevationPatchArrayInput` via:
+//
+//	CircadianScheduleSpecElevationPatchArray{ CircadianScheduleSpecElevationPatchArgs{...} }
+type CircadianScheduleSpecElevationPatchArrayInput interface {
+	pulumi.Input
+
+	ToCircadianScheduleSpecElevationPatchArrayOutput() CircadianScheduleSpecElevationPatchArrayOutput
+	ToCircadianScheduleSpecElevationPatchArrayOutputWithContext(context.Context) CircadianScheduleSpecElevationPatchArrayOutput
+}
+
+type CircadianScheduleSpecElevationPatchArray []CircadianScheduleSpecElevationPatchInput
+
+func (CircadianScheduleSpecElevationPatchArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CircadianScheduleSpecElevationPatch)(nil)).Elem()
+}
+
+func (i CircadianScheduleSpecElevationPatchArray) ToCircadianScheduleSpecElevationPatchArrayOutput() CircadianScheduleSpecElevationPatchArrayOutput {
+	return i.ToCircadianScheduleSpecElevationPatchArrayOutputWithContext(context.Background())
+}
+
+func (i CircadianScheduleSpecElevationPatchArray) ToCircadianScheduleSpecElevationPatchArrayOutputWithContext(ctx context.Context) CircadianScheduleSpecElevationPatchArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CircadianScheduleSpecElevationPatchArrayOutput)
+}
+
+// CircadianElevationPoint maps one sun elevation angle to a (Brightness,
+// ColorTempK) pair - CircadianKeyframe's counterpart for
+// CircadianModeElevation. Anchoring to elevation rather than to a solar
+// event means the curve's shape follows the season on its own: a midwinter
+// noon sun sitting 15 degrees up gets the same output a midsummer sun gets
+// at 15 degrees on its way up in mid-morning, instead of the full
+// solarNoon keyframe. The tradeoff is symmetry - morning and evening at the
+// same elevation always produce the same output, so there's no way to
+// (say) warm up faster in the evening than the morning cools down.
+type CircadianScheduleSpecElevationPatchOutput struct{ *pulumi.OutputState }
+
+func (CircadianScheduleSpecElevationPatchOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CircadianScheduleSpecElevationPatch)(nil)).Elem()
+}
+
+func (o CircadianScheduleSpecElevationPatchOutput) ToCircadianScheduleSpecElevationPatchOutput() CircadianScheduleSpecElevationPatchOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecElevationPatchOutput) ToCircadianScheduleSpecElevationPatchOutputWithContext(ctx context.Context) CircadianScheduleSpecElevationPatchOutput {
+	return o
+}
+
+// Brightness at this elevation, 0-100.
+func (o CircadianScheduleSpecElevationPatchOutput) Brightness() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecElevationPatch) *int { return v.Brightness }).(pulumi.IntPtrOutput)
+}
+
+// ColorTempK at this elevation, in Kelvin.
+func (o CircadianScheduleSpecElevationPatchOutput) ColorTempK() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecElevationPatch) *int { return v.ColorTempK }).(pulumi.IntPtrOutput)
+}
+
+// Degrees is the sun's elevation above the horizon, negative below it.
+// Sunrise/sunset are at about -0.833 (see internal/sun.Elevation),
+// civil twilight ends at -6.
+func (o CircadianScheduleSpecElevationPatchOutput) Degrees() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecElevationPatch) *float64 { return v.Degrees }).(pulumi.Float64PtrOutput)
+}
+
+type CircadianScheduleSpecElevationPatchArrayOutput struct{ *pulumi.OutputState }
+
+func (CircadianScheduleSpecElevationPatchArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CircadianScheduleSpecElevationPatch)(nil)).Elem()
+}
+
+func (o CircadianScheduleSpecElevationPatchArrayOutput) ToCircadianScheduleSpecElevationPatchArrayOutput() CircadianScheduleSpecElevationPatchArrayOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecElevationPatchArrayOutput) ToCircadianScheduleSpecElevationPatchArrayOutputWithContext(ctx context.Context) CircadianScheduleSpecElevationPatchArrayOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecElevationPatchArrayOutput) Index(i pulumi.IntInput) CircadianScheduleSpecElevationPatchOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) CircadianScheduleSpecElevationPatch {
+		return vs[0].([]CircadianScheduleSpecElevationPatch)[vs[1].(int)]
+	}).(CircadianScheduleSpecElevationPatchOutput)
+}
+
 // CircadianKeyframe pins an absolute (Brightness, ColorTempK) pair to a
-// point in the solar day - Anchor plus OffsetMinutes, not a wall-clock
-// time, so the schedule keeps making sense across the seasons as sunrise/
-// sunset drift. Unlike SceneLightState's pointer fields (which mean "leave
+// point in the day - Anchor plus OffsetMinutes. Solar anchors keep the
+// schedule making sense across the seasons as sunrise/sunset drift; a
+// clockTime anchor is for the things that don't follow the sun at all
+// (e.g. "dim to 20% at 22:30 every night"), and the two can be mixed
+// freely in one schedule. Unlike SceneLightState's pointer fields (which mean "leave
 // this untouched"), Brightness/ColorTempK here are required: a continuous
 // curve needs a value defined at every keyframe, not a sparse override -
 // there's no "untouched" between two points on a curve.
 type CircadianScheduleSpecKeyframes struct {
-	// Anchor is the solar event this keyframe is offset from.
+	// Anchor is the solar event (or clockTime) this keyframe is offset
+	// from.
 	Anchor *string `pulumi:"anchor"`
 	// Brightness at this keyframe, 0-100.
 	Brightness *int `pulumi:"brightness"`
@@ -633,6 +1071,13 @@ type CircadianScheduleSpecKeyframes struct {
 	// interpolated/blended between two keyframes the way Brightness/
 	// ColorTempK are.
 	On *string `pulumi:"on"`
+	// Time is the local wall-clock time, "HH:MM" in the schedule's
+	// TimeZone, a clockTime Anchor resolves to - required for, and only
+	// used by, that anchor. On a DST transition day a Time that doesn't
+	// exist (skipped by spring-forward) or exists twice (repeated by
+	// fall-back) resolves to whichever instant Go's time.Date picks, never
+	// an error - off by at most the DST shift for one night a year.
+	Time *string `pulumi:"time"`
 }
 
 // CircadianScheduleSpecKeyframesInput is an input type that accepts CircadianScheduleSpecKeyframesArgs and CircadianScheduleSpecKeyframesOutput values.
@@ -647,14 +1092,17 @@ type CircadianScheduleSpecKeyframesInput interface {
 }
 
 // CircadianKeyframe pins an absolute (Brightness, ColorTempK) pair to a
-// point in the solar day - Anchor plus OffsetMinutes, not a wall-clock
-// time, so the schedule keeps making sense across the seasons as sunrise/
-// sunset drift. Unlike SceneLightState's pointer fields (which mean "leave
+// point in the day - Anchor plus OffsetMinutes. Solar anchors keep the
+// schedule making sense across the seasons as sunrise/sunset drift; a
+// clockTime anchor is for the things that don't follow the sun at all
+// (e.g. "dim to 20% at 22:30 every night"), and the two can be mixed
+// freely in one schedule. Unlike SceneLightState's pointer fields (which mean "leave
 // this untouched"), Brightness/ColorTempK here are required: a continuous
 // curve needs a value defined at every keyframe, not a sparse override -
 // there's no "untouched" between two points on a curve.
 type CircadianScheduleSpecKeyframesArgs struct {
-	// Anchor is the solar event this keyframe is offset from.
+	// Anchor is the solar event (or clockTime) this keyframe is offset
+	// from.
 	Anchor pulumi.StringPtrInput `pulumi:"anchor"`
 	// Brightness at this keyframe, 0-100.
 	Brightness pulumi.IntPtrInput `pulumi:"brightness"`
@@ -674,6 +1122,13 @@ type CircadianScheduleSpecKeyframesArgs struct {
 	// interpolated/blended between two keyframes the way Brightness/
 	// ColorTempK are.
 	On pulumi.StringPtrInput `pulumi:"on"`
+	// Time is the local wall-clock time, "HH:MM" in the schedule's
+	// TimeZone, a clockTime Anchor resolves to - required for, and only
+	// used by, that anchor. On a DST transition day a Time that doesn't
+	// exist (skipped by spring-forward) or exists twice (repeated by
+	// fall-back) resolves to whichever instant Go's time.Date picks, never
+	// an error - off by at most the DST shift for one night a year.
+	Time pulumi.StringPtrInput `pulumi:"time"`
 }
 
 func (CircadianScheduleSpecKeyframesArgs) ElementType() reflect.Type {
@@ -714,9 +1169,11 @@ func (i CircadianScheduleSpecKeyframesArray) ToCircadianScheduleSpecKeyframesArr
 }
 
 // CircadianKeyframe pins an absolute (Brightness, ColorTempK) pair to a
-// point in the solar day - Anchor plus OffsetMinutes, not a wall-clock
-// time, so the schedule keeps making sense across the seasons as sunrise/
-// sunset drift. Unlike SceneLightState's pointer fields (which mean "leave
+// point in the day - Anchor plus OffsetMinutes. Solar anchors keep the
+// schedule making sense across the seasons as sunrise/sunset drift; a
+// clockTime anchor is for the things that don't follow the sun at all
+// (e.g. "dim to 20% at 22:30 every night"), and the two can be mixed
+// freely in one schedule. Unlike SceneLightState's pointer fields (which mean "leave
 // this untouched"), Brightness/ColorTempK here are required: a continuous
 // curve needs a value defined at every keyframe, not a sparse override -
 // there's no "untouched" between two points on a curve.
@@ -734,7 +1191,8 @@ func (o CircadianScheduleSpecKeyframesOutput) ToCircadianScheduleSpecKeyframesOu
 	return o
 }
 
-// Anchor is the solar event this keyframe is offset from.
+// Anchor is the solar event (or clockTime) this keyframe is offset
+// from.
 func (o CircadianScheduleSpecKeyframesOutput) Anchor() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v CircadianScheduleSpecKeyframes) *string { return v.Anchor }).(pulumi.StringPtrOutput)
 }
@@ -769,6 +1227,16 @@ func (o CircadianScheduleSpecKeyframesOutput) On() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v CircadianScheduleSpecKeyframes) *string { return v.On }).(pulumi.StringPtrOutput)
 }
 
+// Time is the local wall-clock time, "HH:MM" in the schedule's
+// TimeZone, a clockTime Anchor resolves to - required for, and only
+// used by, that anchor. On a DST transition day a Time that doesn't
+// exist (skipped by spring-forward) or exists twice (repeated by
+// fall-back) resolves to whichever instant Go's time.Date picks, never
+// an error - off by at most the DST shift for one night a year.
+func (o CircadianScheduleSpecKeyframesOutput) Time() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecKeyframes) *string { return v.Time }).(pulumi.StringPtrOutput)
+}
+
 type CircadianScheduleSpecKeyframesArrayOutput struct{ *pulumi.OutputState }
 
 func (CircadianScheduleSpecKeyframesArrayOutput) ElementType() reflect.Type {
@@ -790,14 +1258,17 @@ func (o CircadianScheduleSpecKeyframesArrayOutput) Index(i pulumi.IntInput) Circ
 }
 
 // CircadianKeyframe pins an absolute (Brightness, ColorTempK) pair to a
-// point in the solar day - Anchor plus OffsetMinutes, not a wall-clock
-// time, so the schedule keeps making sense across the seasons as sunrise/
-// sunset drift. Unlike SceneLightState's pointer fields (which mean "leave
+// point in the day - Anchor plus OffsetMinutes. Solar anchors keep the
+// schedule making sense across the seasons as sunrise/sunset drift; a
+// clockTime anchor is for the things that don't follow the sun at all
+// (e.g. "dim to 20% at 22:30 every night"), and the two can be mixed
+// freely in one schedule. Unlike SceneLightState's pointer fields (which mean "leave
 // this untouched"), Brightness/ColorTempK here are required: a continuous
 // curve needs a value defined at every keyframe, not a sparse override -
 // there's no "untouched" between two points on a curve.
 type CircadianScheduleSpecKeyframesPatch struct {
-	// Anchor is the solar event this keyframe is offset from.
+	// Anchor is the solar event (or clockTime) this keyframe is offset
+	// from.
 	Anchor *string `pulumi:"anchor"`
 	// Brightness at this keyframe, 0-100.
 	Brightness *int `pulumi:"brightness"`
@@ -817,6 +1288,13 @@ type CircadianScheduleSpecKeyframesPatch struct {
 	// interpolated/blended between two keyframes the way Brightness/
 	// ColorTempK are.
 	On *string `pulumi:"on"`
+	// Time is the local wall-clock time, "HH:MM" in the schedule's
+	// TimeZone, a clockTime Anchor resolves to - required for, and only
+	// used by, that anchor. On a DST transition day a Time that doesn't
+	// exist (skipped by spring-forward) or exists twice (repeated by
+	// fall-back) resolves to whichever instant Go's time.Date picks, never
+	// an error - off by at most the DST shift for one night a year.
+	Time *string `pulumi:"time"`
 }
 
 // CircadianScheduleSpecKeyframesPatchInput is an input type that accepts CircadianScheduleSpecKeyframesPatchArgs and CircadianScheduleSpecKeyframesPatchOutput values.
@@ -831,14 +1309,17 @@ type CircadianScheduleSpecKeyframesPatchInput interface {
 }
 
 // CircadianKeyframe pins an absolute (Brightness, ColorTempK) pair to a
-// point in the solar day - Anchor plus OffsetMinutes, not a wall-clock
-// time, so the schedule keeps making sense across the seasons as sunrise/
-// sunset drift. Unlike SceneLightState's pointer fields (which mean "leave
+// point in the day - Anchor plus OffsetMinutes. Solar anchors keep the
+// schedule making sense across the seasons as sunrise/sunset drift; a
+// clockTime anchor is for the things that don't follow the sun at all
+// (e.g. "dim to 20% at 22:30 every night"), and the two can be mixed
+// freely in one schedule. Unlike SceneLightState's pointer fields (which mean "leave
 // this untouched"), Brightness/ColorTempK here are required: a continuous
 // curve needs a value defined at every keyframe, not a sparse override -
 // there's no "untouched" between two points on a curve.
 type CircadianScheduleSpecKeyframesPatchArgs struct {
-	// Anchor is the solar event this keyframe is offset from.
+	// Anchor is the solar event (or clockTime) this keyframe is offset
+	// from.
 	Anchor pulumi.StringPtrInput `pulumi:"anchor"`
 	// Brightness at this keyframe, 0-100.
 	Brightness pulumi.IntPtrInput `pulumi:"brightness"`
@@ -858,6 +1339,13 @@ type CircadianScheduleSpecKeyframesPatchArgs struct {
 	// interpolated/blended between two keyframes the way Brightness/
 	// ColorTempK are.
 	On pulumi.StringPtrInput `pulumi:"on"`
+	// Time is the local wall-clock time, "HH:MM" in the schedule's
+	// TimeZone, a clockTime Anchor resolves to - required for, and only
+	// used by, that anchor. On a DST transition day a Time that doesn't
+	// exist (skipped by spring-forward) or exists twice (repeated by
+	// fall-back) resolves to whichever instant Go's time.Date picks, never
+	// an error - off by at most the DST shift for one night a year.
+	Time pulumi.StringPtrInput `pulumi:"time"`
 }
 
 func (CircadianScheduleSpecKeyframesPatchArgs) ElementType() reflect.Type {
@@ -898,9 +1386,11 @@ func (i CircadianScheduleSpecKeyframesPatchArray) ToCircadianScheduleSpecKeyfram
 }
 
 // CircadianKeyframe pins an absolute (Brightness, ColorTempK) pair to a
-// point in the solar day - Anchor plus OffsetMinutes, not a wall-clock
-// time, so the schedule keeps making sense across the seasons as sunrise/
-// sunset drift. Unlike SceneLightState's pointer fields (which mean "leave
+// point in the day - Anchor plus OffsetMinutes. Solar anchors keep the
+// schedule making sense across the seasons as sunrise/sunset drift; a
+// clockTime anchor is for the things that don't follow the sun at all
+// (e.g. "dim to 20% at 22:30 every night"), and the two can be mixed
+// freely in one schedule. Unlike SceneLightState's pointer fields (which mean "leave
 // this untouched"), Brightness/ColorTempK here are required: a continuous
 // curve needs a value defined at every keyframe, not a sparse override -
 // there's no "untouched" between two points on a curve.
@@ -918,7 +1408,8 @@ func (o CircadianScheduleSpecKeyframesPatchOutput) ToCircadianScheduleSpecKeyfra
 	return o
 }
 
-// Anchor is the solar event this keyframe is offset from.
+// Anchor is the solar event (or clockTime) this keyframe is offset
+// from.
 func (o CircadianScheduleSpecKeyframesPatchOutput) Anchor() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v CircadianScheduleSpecKeyframesPatch) *string { return v.Anchor }).(pulumi.StringPtrOutput)
 }
@@ -953,6 +1444,16 @@ func (o CircadianScheduleSpecKeyframesPatchOutput) On() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v CircadianScheduleSpecKeyframesPatch) *string { return v.On }).(pulumi.StringPtrOutput)
 }
 
+// Time is the local wall-clock time, "HH:MM" in the schedule's
+// TimeZone, a clockTime Anchor resolves to - required for, and only
+// used by, that anchor. On a DST transition day a Time that doesn't
+// exist (skipped by spring-forward) or exists twice (repeated by
+// fall-back) resolves to whichever instant Go's time.Date picks, never
+// an error - off by at most the DST shift for one night a year.
+func (o CircadianScheduleSpecKeyframesPatchOutput) Time() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecKeyframesPatch) *string { return v.Time }).(pulumi.StringPtrOutput)
+}
+
 type CircadianScheduleSpecKeyframesPatchArrayOutput struct{ *pulumi.OutputState }
 
 func (CircadianScheduleSpecKeyframesPatchArrayOutput) ElementType() reflect.Type {
@@ -975,24 +1476,34 @@ func (o CircadianScheduleSpecKeyframesPatchArrayOutput) Index(i pulumi.IntInput)
 
 // CircadianScheduleSpec declares a continuous brightness/colorTempK curve
 // for all of a Group's lights, anchored to sun position rather than fixed
-// times. Unlike Scene, there's no per-light Lights list - a circadian
-// curve is one uniform "ambient tone" applied to every light in Group, not
-// per-fixture state. A CircadianSchedule does no enactment itself -
-// internal/groupcontroller.Reconciler applies the current interpolated
-// value onto each target Light.Spec when the owning Group's
-// Spec.ActiveScene references this schedule (Kind: CircadianSchedule),
-// reusing the same per-light capability-sentinel skip Scene enactment
-// already uses.
+// times - either via keyframes pinned to solar events, or directly via the
+// sun's elevation angle (see Mode). Unlike Scene, there's no per-light
+// Lights list - a circadian curve is one uniform "ambient tone" applied to
+// every light in Group, not per-fixture state. A CircadianSchedule does no
+// enactment itself - internal/groupcontroller.Reconciler applies the
+// current interpolated value onto each target Light.Spec when the owning
+// Group's Spec.ActiveScene references this schedule (Kind:
+// CircadianSchedule), reusing the same per-light capability-sentinel skip
+// Scene enactment already uses.
 type CircadianScheduleSpecPatch struct {
+	// Elevation defines the curve in "elevation" mode, at least 2 points,
+	// interpolated against the sun's elevation right now by
+	// internal/circadian.InterpolateElevation - clamped to the first/last
+	// point outside the table's range. Order in this list doesn't matter.
+	// Elevation mode never manages on/off (there's no per-point On), since
+	// a step function keyed on elevation would switch at the same angle
+	// morning and evening.
+	Elevation []CircadianScheduleSpecElevationPatch `pulumi:"elevation"`
 	// Group is the name of the Group this schedule applies to. A Group's
 	// Spec.ActiveScene must reference this schedule's own metadata.name
 	// for it to ever be enacted - same reciprocal-match convention as
 	// SceneSpec.Group.
 	Group *string `pulumi:"group"`
-	// Keyframes define the curve, at least 2, resolved and interpolated
-	// against "now" by internal/circadian.Interpolate. Order in this list
-	// doesn't matter - they're sorted by resolved instant before
-	// interpolating.
+	// Keyframes define the curve in "keyframes" mode, at least 2, resolved
+	// and interpolated against "now" by internal/circadian.Interpolate.
+	// Order in this list doesn't matter - they're sorted by resolved
+	// instant before interpolating. Used on every day no Variant lists -
+	// may be left empty only if Variants cover the whole week.
 	Keyframes []CircadianScheduleSpecKeyframesPatch `pulumi:"keyframes"`
 	// Latitude of the location Keyframes are anchored to, decimal degrees
 	// positive north. Required, not defaulted: 0 is a real location (Null
@@ -1005,6 +1516,20 @@ type CircadianScheduleSpecPatch struct {
 	// Longitude of the location Keyframes are anchored to, decimal degrees
 	// positive east. Required - see Latitude's doc comment for why.
 	Longitude *float64 `pulumi:"longitude"`
+	// Mode selects which of Keyframes/Elevation defines the curve - the
+	// other is ignored. Defaults to "keyframes".
+	Mode *string `pulumi:"mode"`
+	// TimeZone is the IANA time zone name (e.g. "Europe/London") clockTime
+	// keyframes' Time and Variants' Days are read in. Required if any
+	// keyframe uses clockTime or any Variants are set - deliberately not
+	// defaulted to UTC, for the same "a silently wrong place is worse than
+	// a validation error" reason as Latitude.
+	TimeZone *string `pulumi:"timeZone"`
+	// Variants swap in a different keyframe set on particular days of
+	// the week (in TimeZone), e.g. a later start at the weekend - see
+	// internal/circadian.InterpolateVariants for how the day boundary is
+	// smoothed over. Only used in "keyframes" mode.
+	Variants []CircadianScheduleSpecVariantsPatch `pulumi:"variants"`
 }
 
 // CircadianScheduleSpecPatchInput is an input type that accepts CircadianScheduleSpecPatchArgs and CircadianScheduleSpecPatchOutput values.
@@ -1020,24 +1545,34 @@ type CircadianScheduleSpecPatchInput interface {
 
 // CircadianScheduleSpec declares a continuous brightness/colorTempK curve
 // for all of a Group's lights, anchored to sun position rather than fixed
-// times. Unlike Scene, there's no per-light Lights list - a circadian
-// curve is one uniform "ambient tone" applied to every light in Group, not
-// per-fixture state. A CircadianSchedule does no enactment itself -
-// internal/groupcontroller.Reconciler applies the current interpolated
-// value onto each target Light.Spec when the owning Group's
-// Spec.ActiveScene references this schedule (Kind: CircadianSchedule),
-// reusing the same per-light capability-sentinel skip Scene enactment
-// already uses.
+// times - either via keyframes pinned to solar events, or directly via the
+// sun's elevation angle (see Mode). Unlike Scene, there's no per-light
+// Lights list - a circadian curve is one uniform "ambient tone" applied to
+// every light in Group, not per-fixture state. A CircadianSchedule does no
+// enactment itself - internal/groupcontroller.Reconciler applies the
+// current interpolated value onto each target Light.Spec when the owning
+// Group's Spec.ActiveScene references this schedule (Kind:
+// CircadianSchedule), reusing the same per-light capability-sentinel skip
+// Scene enactment already uses.
 type CircadianScheduleSpecPatchArgs struct {
+	// Elevation defines the curve in "elevation" mode, at least 2 points,
+	// interpolated against the sun's elevation right now by
+	// internal/circadian.InterpolateElevation - clamped to the first/last
+	// point outside the table's range. Order in this list doesn't matter.
+	// Elevation mode never manages on/off (there's no per-point On), since
+	// a step function keyed on elevation would switch at the same angle
+	// morning and evening.
+	Elevation CircadianScheduleSpecElevationPatchArrayInput `pulumi:"elevation"`
 	// Group is the name of the Group this schedule applies to. A Group's
 	// Spec.ActiveScene must reference this schedule's own metadata.name
 	// for it to ever be enacted - same reciprocal-match convention as
 	// SceneSpec.Group.
 	Group pulumi.StringPtrInput `pulumi:"group"`
-	// Keyframes define the curve, at least 2, resolved and interpolated
-	// against "now" by internal/circadian.Interpolate. Order in this list
-	// doesn't matter - they're sorted by resolved instant before
-	// interpolating.
+	// Keyframes define the curve in "keyframes" mode, at least 2, resolved
+	// and interpolated against "now" by internal/circadian.Interpolate.
+	// Order in this list doesn't matter - they're sorted by resolved
+	// instant before interpolating. Used on every day no Variant lists -
+	// may be left empty only if Variants cover the whole week.
 	Keyframes CircadianScheduleSpecKeyframesPatchArrayInput `pulumi:"keyframes"`
 	// Latitude of the location Keyframes are anchored to, decimal degrees
 	// positive north. Required, not defaulted: 0 is a real location (Null
@@ -1050,6 +1585,20 @@ type CircadianScheduleSpecPatchArgs struct {
 	// Longitude of the location Keyframes are anchored to, decimal degrees
 	// positive east. Required - see Latitude's doc comment for why.
 	Longitude pulumi.Float64PtrInput `pulumi:"longitude"`
+	// Mode selects which of Keyframes/Elevation defines the curve - the
+	// other is ignored. Defaults to "keyframes".
+	Mode pulumi.StringPtrInput `pulumi:"mode"`
+	// TimeZone is the IANA time zone name (e.g. "Europe/London") clockTime
+	// keyframes' Time and Variants' Days are read in. Required if any
+	// keyframe uses clockTime or any Variants are set - deliberately not
+	// defaulted to UTC, for the same "a silently wrong place is worse than
+	// a validation error" reason as Latitude.
+	TimeZone pulumi.StringPtrInput `pulumi:"timeZone"`
+	// Variants swap in a different keyframe set on particular days of
+	// the week (in TimeZone), e.g. a later start at the weekend - see
+	// internal/circadian.InterpolateVariants for how the day boundary is
+	// smoothed over. Only used in "keyframes" mode.
+	Variants CircadianScheduleSpecVariantsPatchArrayInput `pulumi:"variants"`
 }
 
 func (CircadianScheduleSpecPatchArgs) ElementType() reflect.Type {
@@ -1107,14 +1656,15 @@ func (i *circadianScheduleSpecPatchPtrType) ToCircadianScheduleSpecPatchPtrOutpu
 
 // CircadianScheduleSpec declares a continuous brightness/colorTempK curve
 // for all of a Group's lights, anchored to sun position rather than fixed
-// times. Unlike Scene, there's no per-light Lights list - a circadian
-// curve is one uniform "ambient tone" applied to every light in Group, not
-// per-fixture state. A CircadianSchedule does no enactment itself -
-// internal/groupcontroller.Reconciler applies the current interpolated
-// value onto each target Light.Spec when the owning Group's
-// Spec.ActiveScene references this schedule (Kind: CircadianSchedule),
-// reusing the same per-light capability-sentinel skip Scene enactment
-// already uses.
+// times - either via keyframes pinned to solar events, or directly via the
+// sun's elevation angle (see Mode). Unlike Scene, there's no per-light
+// Lights list - a circadian curve is one uniform "ambient tone" applied to
+// every light in Group, not per-fixture state. A CircadianSchedule does no
+// enactment itself - internal/groupcontroller.Reconciler applies the
+// current interpolated value onto each target Light.Spec when the owning
+// Group's Spec.ActiveScene references this schedule (Kind:
+// CircadianSchedule), reusing the same per-light capability-sentinel skip
+// Scene enactment already uses.
 type CircadianScheduleSpecPatchOutput struct{ *pulumi.OutputState }
 
 func (CircadianScheduleSpecPatchOutput) ElementType() reflect.Type {
@@ -1139,114 +1689,894 @@ func (o CircadianScheduleSpecPatchOutput) ToCircadianScheduleSpecPatchPtrOutputW
 	}).(CircadianScheduleSpecPatchPtrOutput)
 }
 
-// Group is the name of the Group this schedule applies to. A Group's
-// Spec.ActiveScene must reference this schedule's own metadata.name
-// for it to ever be enacted - same reciprocal-match convention as
-// SceneSpec.Group.
-func (o CircadianScheduleSpecPatchOutput) Group() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v CircadianScheduleSpecPatch) *string { return v.Group }).(pulumi.StringPtrOutput)
+// Elevation defines the curve in "elevation" mode, at least 2 points,
+// interpolated against the sun's elevation right now by
+// internal/circadian.InterpolateElevation - clamped to the first/last
+// point outside the table's range. Order in this list doesn't matter.
+// Elevation mode never manages on/off (there's no per-point On), since
+// a step function keyed on elevation would switch at the same angle
+// morning and evening.
+func (o CircadianScheduleSpecPatchOutput) Elevation() CircadianScheduleSpecElevationPatchArrayOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecPatch) []CircadianScheduleSpecElevationPatch { return v.Elevation }).(CircadianScheduleSpecElevationPatchArrayOutput)
+}
+
+// Group is the name of the Group this schedule applies to. A Group's
+// Spec.ActiveScene must reference this schedule's own metadata.name
+// for it to ever be enacted - same reciprocal-match convention as
+// SceneSpec.Group.
+func (o CircadianScheduleSpecPatchOutput) Group() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecPatch) *string { return v.Group }).(pulumi.StringPtrOutput)
+}
+
+// Keyframes define the curve in "keyframes" mode, at least 2, resolved
+// and interpolated against "now" by internal/circadian.Interpolate.
+// Order in this list doesn't matter - they're sorted by resolved
+// instant before interpolating. Used on every day no Variant lists -
+// may be left empty only if Variants cover the whole week.
+func (o CircadianScheduleSpecPatchOutput) Keyframes() CircadianScheduleSpecKeyframesPatchArrayOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecPatch) []CircadianScheduleSpecKeyframesPatch { return v.Keyframes }).(CircadianScheduleSpecKeyframesPatchArrayOutput)
+}
+
+// Latitude of the location Keyframes are anchored to, decimal degrees
+// positive north. Required, not defaulted: 0 is a real location (Null
+// Island, off the coast of Ghana) rather than a safe "unset" sentinel,
+// so a schedule created without one would silently compute sun times
+// for the wrong place instead of failing validation - confirmed live,
+// this is exactly what happened before Latitude/Longitude moved from a
+// global --latitude/--longitude flag onto this Spec.
+func (o CircadianScheduleSpecPatchOutput) Latitude() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecPatch) *float64 { return v.Latitude }).(pulumi.Float64PtrOutput)
+}
+
+// Longitude of the location Keyframes are anchored to, decimal degrees
+// positive east. Required - see Latitude's doc comment for why.
+func (o CircadianScheduleSpecPatchOutput) Longitude() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecPatch) *float64 { return v.Longitude }).(pulumi.Float64PtrOutput)
+}
+
+// Mode selects which of Keyframes/Elevation defines the curve - the
+// other is ignored. Defaults to "keyframes".
+func (o CircadianScheduleSpecPatchOutput) Mode() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecPatch) *string { return v.Mode }).(pulumi.StringPtrOutput)
+}
+
+// TimeZone is the IANA time zone name (e.g. "Europe/London") clockTime
+// keyframes' Time and Variants' Days are read in. Required if any
+// keyframe uses clockTime or any Variants are set - deliberately not
+// defaulted to UTC, for the same "a silently wrong place is worse than
+// a validation error" reason as Latitude.
+func (o CircadianScheduleSpecPatchOutput) TimeZone() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecPatch) *string { return v.TimeZone }).(pulumi.StringPtrOutput)
+}
+
+// Variants swap in a different keyframe set on particular days of
+// the week (in TimeZone), e.g. a later start at the weekend - see
+// internal/circadian.InterpolateVariants for how the day boundary is
+// smoothed over. Only used in "keyframes" mode.
+func (o CircadianScheduleSpecPatchOutput) Variants() CircadianScheduleSpecVariantsPatchArrayOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecPatch) []CircadianScheduleSpecVariantsPatch { return v.Variants }).(CircadianScheduleSpecVariantsPatchArrayOutput)
+}
+
+type CircadianScheduleSpecPatchPtrOutput struct{ *pulumi.OutputState }
+
+func (CircadianScheduleSpecPatchPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**CircadianScheduleSpecPatch)(nil)).Elem()
+}
+
+func (o CircadianScheduleSpecPatchPtrOutput) ToCircadianScheduleSpecPatchPtrOutput() CircadianScheduleSpecPatchPtrOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecPatchPtrOutput) ToCircadianScheduleSpecPatchPtrOutputWithContext(ctx context.Context) CircadianScheduleSpecPatchPtrOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecPatchPtrOutput) Elem() CircadianScheduleSpecPatchOutput {
+	return o.ApplyT(func(v *CircadianScheduleSpecPatch) CircadianScheduleSpecPatch {
+		if v != nil {
+			return *v
+		}
+		var ret CircadianScheduleSpecPatch
+		return ret
+	}).(CircadianScheduleSpecPatchOutput)
+}
+
+// Elevation defines the curve in "elevation" mode, at least 2 points,
+// interpolated against the sun's elevation right now by
+// internal/circadian.InterpolateElevation - clamped to the first/last
+// point outside the table's range. Order in this list doesn't matter.
+// Elevation mode never manages on/off (there's no per-point On), since
+// a step function keyed on elevation would switch at the same angle
+// morning and evening.
+func (o CircadianScheduleSpecPatchPtrOutput) Elevation() CircadianScheduleSpecElevationPatchArrayOutput {
+	return o.ApplyT(func(v *CircadianScheduleSpecPatch) []CircadianScheduleSpecElevationPatch {
+		if v == nil {
+			return nil
+		}
+		return v.Elevation
+	}).(CircadianScheduleSpecElevationPatchArrayOutput)
+}
+
+// Group is the name of the Group this schedule applies to. A Group's
+// Spec.ActiveScene must reference this schedule's own metadata.name
+// for it to ever be enacted - same reciprocal-match convention as
+// SceneSpec.Group.
+func (o CircadianScheduleSpecPatchPtrOutput) Group() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CircadianScheduleSpecPatch) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Group
+	}).(pulumi.StringPtrOutput)
+}
+
+// Keyframes define the curve in "keyframes" mode, at least 2, resolved
+// and interpolated against "now" by internal/circadian.Interpolate.
+// Order in this list doesn't matter - they're sorted by resolved
+// instant before interpolating. Used on every day no Variant lists -
+// may be left empty only if Variants cover the whole week.
+func (o CircadianScheduleSpecPatchPtrOutput) Keyframes() CircadianScheduleSpecKeyframesPatchArrayOutput {
+	return o.ApplyT(func(v *CircadianScheduleSpecPatch) []CircadianScheduleSpecKeyframesPatch {
+		if v == nil {
+			return nil
+		}
+		return v.Keyframes
+	}).(CircadianScheduleSpecKeyframesPatchArrayOutput)
+}
+
+// Latitude of the location Keyframes are anchored to, decimal degrees
+// positive north. Required, not defaulted: 0 is a real location (Null
+// Island, off the coast of Ghana) rather than a safe "unset" sentinel,
+// so a schedule created without one would silently compute sun times
+// for the wrong place instead of failing validation - confirmed live,
+// this is exactly what happened before Latitude/Longitude moved from a
+// global --latitude/--longitude flag onto this Spec.
+func (o CircadianScheduleSpecPatchPtrOutput) Latitude() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v *CircadianScheduleSpecPatch) *float64 {
+		if v == nil {
+			return nil
+		}
+		return v.Latitude
+	}).(pulumi.Float64PtrOutput)
+}
+
+// Longitude of the location Keyframes are anchored to, decimal degrees
+// positive east. Required - see Latitude's doc comment for why.
+func (o CircadianScheduleSpecPatchPtrOutput) Longitude() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v *CircadianScheduleSpecPatch) *float64 {
+		if v == nil {
+			return nil
+		}
+		return v.Longitude
+	}).(pulumi.Float64PtrOutput)
+}
+
+// Mode selects which of Keyframes/Elevation defines the curve - the
+// other is ignored. Defaults to "keyframes".
+func (o CircadianScheduleSpecPatchPtrOutput) Mode() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CircadianScheduleSpecPatch) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Mode
+	}).(pulumi.StringPtrOutput)
+}
+
+// TimeZone is the IANA time zone name (e.g. "Europe/London") clockTime
+// keyframes' Time and Variants' Days are read in. Required if any
+// keyframe uses clockTime or any Variants are set - deliberately not
+// defaulted to UTC, for the same "a silently wrong place is worse than
+// a validation error" reason as Latitude.
+func (o CircadianScheduleSpecPatchPtrOutput) TimeZone() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CircadianScheduleSpecPatch) *string {
+		if v == nil {
+			return nil
+		}
+		return v.TimeZone
+	}).(pulumi.StringPtrOutput)
+}
+
+// Variants swap in a different keyframe set on particular days of
+// the week (in TimeZone), e.g. a later start at the weekend - see
+// internal/circadian.InterpolateVariants for how the day boundary is
+// smoothed over. Only used in "keyframes" mode.
+func (o CircadianScheduleSpecPatchPtrOutput) Variants() CircadianScheduleSpecVariantsPatchArrayOutput {
+	return o.ApplyT(func(v *CircadianScheduleSpecPatch) []CircadianScheduleSpecVariantsPatch {
+		if v == nil {
+			return nil
+		}
+		return v.Variants
+	}).(CircadianScheduleSpecVariantsPatchArrayOutput)
+}
+
+// CircadianVariant replaces a CircadianSchedule's Keyframes on the listed
+// days - e.g. a weekend variant that gets up two hours later. A variant is
+// a complete keyframe set for its days, not a patch over the default one:
+// merging two sets keyframe-by-keyframe would need an identity for each
+// keyframe that CircadianKeyframe doesn't have.
+type CircadianScheduleSpecVariants struct {
+	// Days this variant applies on. A day may only be listed by one
+	// variant.
+	Days []string `pulumi:"days"`
+	// Keyframes used on Days instead of the schedule's own - same rules
+	// as CircadianScheduleSpec.Keyframes.
+	Keyframes []CircadianScheduleSpecVariantsKeyframes `pulumi:"keyframes"`
+}
+
+// CircadianScheduleSpecVariantsInput is an input type that accepts CircadianScheduleSpecVariantsArgs and CircadianScheduleSpecVariantsOutput values.
+// You can construct a concrete instance of `CircadianScheduleSpecVariantsInput` via:
+//
+//	CircadianScheduleSpecVariantsArgs{...}
+type CircadianScheduleSpecVariantsInput interface {
+	pulumi.Input
+
+	ToCircadianScheduleSpecVariantsOutput() CircadianScheduleSpecVariantsOutput
+	ToCircadianScheduleSpecVariantsOutputWithContext(context.Context) CircadianScheduleSpecVariantsOutput
+}
+
+// CircadianVariant replaces a CircadianSchedule's Keyframes on the listed
+// days - e.g. a weekend variant that gets up two hours later. A variant is
+// a complete keyframe set for its days, not a patch over the default one:
+// merging two sets keyframe-by-keyframe would need an identity for each
+// keyframe that CircadianKeyframe doesn't have.
+type CircadianScheduleSpecVariantsArgs struct {
+	// Days this variant applies on. A day may only be listed by one
+	// variant.
+	Days pulumi.StringArrayInput `pulumi:"days"`
+	// Keyframes used on Days instead of the schedule's own - same rules
+	// as CircadianScheduleSpec.Keyframes.
+	Keyframes CircadianScheduleSpecVariantsKeyframesArrayInput `pulumi:"keyframes"`
+}
+
+func (CircadianScheduleSpecVariantsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CircadianScheduleSpecVariants)(nil)).Elem()
+}
+
+func (i CircadianScheduleSpecVariantsArgs) ToCircadianScheduleSpecVariantsOutput() CircadianScheduleSpecVariantsOutput {
+	return i.ToCircadianScheduleSpecVariantsOutputWithContext(context.Background())
+}
+
+func (i CircadianScheduleSpecVariantsArgs) ToCircadianScheduleSpecVariantsOutputWithContext(ctx context.Context) CircadianScheduleSpecVariantsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CircadianScheduleSpecVariantsOutput)
+}
+
+// CircadianScheduleSpecVariantsArrayInput is an input type that accepts CircadianScheduleSpecVariantsArray and CircadianScheduleSpecVariantsArrayOutput values.
+// You can construct a concrete instance of `CircadianScheduleSpecVariantsArrayInput` via:
+//
+//	CircadianScheduleSpecVariantsArray{ CircadianScheduleSpecVariantsArgs{...} }
+type CircadianScheduleSpecVariantsArrayInput interface {
+	pulumi.Input
+
+	ToCircadianScheduleSpecVariantsArrayOutput() CircadianScheduleSpecVariantsArrayOutput
+	ToCircadianScheduleSpecVariantsArrayOutputWithContext(context.Context) CircadianScheduleSpecVariantsArrayOutput
+}
+
+type CircadianScheduleSpecVariantsArray []CircadianScheduleSpecVariantsInput
+
+func (CircadianScheduleSpecVariantsArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CircadianScheduleSpecVariants)(nil)).Elem()
+}
+
+func (i CircadianScheduleSpecVariantsArray) ToCircadianScheduleSpecVariantsArrayOutput() CircadianScheduleSpecVariantsArrayOutput {
+	return i.ToCircadianScheduleSpecVariantsArrayOutputWithContext(context.Background())
+}
+
+func (i CircadianScheduleSpecVariantsArray) ToCircadianScheduleSpecVariantsArrayOutputWithContext(ctx context.Context) CircadianScheduleSpecVariantsArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CircadianScheduleSpecVariantsArrayOutput)
+}
+
+// CircadianVariant replaces a CircadianSchedule's Keyframes on the listed
+// days - e.g. a weekend variant that gets up two hours later. A variant is
+// a complete keyframe set for its days, not a patch over the default one:
+// merging two sets keyframe-by-keyframe would need an identity for each
+// keyframe that CircadianKeyframe doesn't have.
+type CircadianScheduleSpecVariantsOutput struct{ *pulumi.OutputState }
+
+func (CircadianScheduleSpecVariantsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CircadianScheduleSpecVariants)(nil)).Elem()
+}
+
+func (o CircadianScheduleSpecVariantsOutput) ToCircadianScheduleSpecVariantsOutput() CircadianScheduleSpecVariantsOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecVariantsOutput) ToCircadianScheduleSpecVariantsOutputWithContext(ctx context.Context) CircadianScheduleSpecVariantsOutput {
+	return o
+}
+
+// Days this variant applies on. A day may only be listed by one
+// variant.
+func (o CircadianScheduleSpecVariantsOutput) Days() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecVariants) []string { return v.Days }).(pulumi.StringArrayOutput)
+}
+
+// Keyframes used on Days instead of the schedule's own - same rules
+// as CircadianScheduleSpec.Keyframes.
+func (o CircadianScheduleSpecVariantsOutput) Keyframes() CircadianScheduleSpecVariantsKeyframesArrayOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecVariants) []CircadianScheduleSpecVariantsKeyframes { return v.Keyframes }).(CircadianScheduleSpecVariantsKeyframesArrayOutput)
+}
+
+type CircadianScheduleSpecVariantsArrayOutput struct{ *pulumi.OutputState }
+
+func (CircadianScheduleSpecVariantsArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CircadianScheduleSpecVariants)(nil)).Elem()
+}
+
+func (o CircadianScheduleSpecVariantsArrayOutput) ToCircadianScheduleSpecVariantsArrayOutput() CircadianScheduleSpecVariantsArrayOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecVariantsArrayOutput) ToCircadianScheduleSpecVariantsArrayOutputWithContext(ctx context.Context) CircadianScheduleSpecVariantsArrayOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecVariantsArrayOutput) Index(i pulumi.IntInput) CircadianScheduleSpecVariantsOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) CircadianScheduleSpecVariants {
+		return vs[0].([]CircadianScheduleSpecVariants)[vs[1].(int)]
+	}).(CircadianScheduleSpecVariantsOutput)
+}
+
+// CircadianKeyframe pins an absolute (Brightness, ColorTempK) pair to a
+// point in the day - Anchor plus OffsetMinutes. Solar anchors keep the
+// schedule making sense across the seasons as sunrise/sunset drift; a
+// clockTime anchor is for the things that don't follow the sun at all
+// (e.g. "dim to 20% at 22:30 every night"), and the two can be mixed
+// freely in one schedule. Unlike SceneLightState's pointer fields (which mean "leave
+// this untouched"), Brightness/ColorTempK here are required: a continuous
+// curve needs a value defined at every keyframe, not a sparse override -
+// there's no "untouched" between two points on a curve.
+type CircadianScheduleSpecVariantsKeyframes struct {
+	// Anchor is the solar event (or clockTime) this keyframe is offset
+	// from.
+	Anchor *string `pulumi:"anchor"`
+	// Brightness at this keyframe, 0-100.
+	Brightness *int `pulumi:"brightness"`
+	// ColorTempK at this keyframe, in Kelvin.
+	ColorTempK *int `pulumi:"colorTempK"`
+	// OffsetMinutes shifts this keyframe from Anchor, positive is later.
+	// Bounded to +/-12h so internal/circadian.Interpolate's search window
+	// (the day before/of/after "now") is always sufficient to resolve it.
+	OffsetMinutes *int `pulumi:"offsetMinutes"`
+	// On explicitly turns the group's lights on or off at this keyframe, or
+	// leaves on/off state unchanged (the default) - unlike Brightness/
+	// ColorTempK, on/off isn't a continuous curve, so this is a sparse
+	// override, not a required per-keyframe value. internal/
+	// circadian.Interpolate resolves the effective value for "now" as the
+	// nearest keyframe at-or-before now (searching backward, wrapping
+	// across days) whose On isn't "unchanged" - a step function, not
+	// interpolated/blended between two keyframes the way Brightness/
+	// ColorTempK are.
+	On *string `pulumi:"on"`
+	// Time is the local wall-clock time, "HH:MM" in the schedule's
+	// TimeZone, a clockTime Anchor resolves to - required for, and only
+	// used by, that anchor. On a DST transition day a Time that doesn't
+	// exist (skipped by spring-forward) or exists twice (repeated by
+	// fall-back) resolves to whichever instant Go's time.Date picks, never
+	// an error - off by at most the DST shift for one night a year.
+	Time *string `pulumi:"time"`
+}
+
+// CircadianScheduleSpecVariantsKeyframesInput is an input type that accepts CircadianScheduleSpecVariantsKeyframesArgs and CircadianScheduleSpecVariantsKeyframesOutput values.
+// You can construct a concrete instance of `CircadianScheduleSpecVariantsKeyframesInput` via:
+//
+//	CircadianScheduleSpecVariantsKeyframesArgs{...}
+type CircadianScheduleSpecVariantsKeyframesInput interface {
+	pulumi.Input
+
+	ToCircadianScheduleSpecVariantsKeyframesOutput() CircadianScheduleSpecVariantsKeyframesOutput
+	ToCircadianScheduleSpecVariantsKeyframesOutputWithContext(context.Context) CircadianScheduleSpecVariantsKeyframesOutput
+}
+
+// CircadianKeyframe pins an absolute (Brightness, ColorTempK) pair to a
+// point in the day - Anchor plus OffsetMinutes. Solar anchors keep the
+// schedule making sense across the seasons as sunrise/sunset drift; a
+// clockTime anchor is for the things that don't follow the sun at all
+// (e.g. "dim to 20% at 22:30 every night"), and the two can be mixed
+// freely in one schedule. Unlike SceneLightState's pointer fields (which mean "leave
+// this untouched"), Brightness/ColorTempK here are required: a continuous
+// curve needs a value defined at every keyframe, not a sparse override -
+// there's no "untouched" between two points on a curve.
+type CircadianScheduleSpecVariantsKeyframesArgs struct {
+	// Anchor is the solar event (or clockTime) this keyframe is offset
+	// from.
+	Anchor pulumi.StringPtrInput `pulumi:"anchor"`
+	// Brightness at this keyframe, 0-100.
+	Brightness pulumi.IntPtrInput `pulumi:"brightness"`
+	// ColorTempK at this keyframe, in Kelvin.
+	ColorTempK pulumi.IntPtrInput `pulumi:"colorTempK"`
+	// OffsetMinutes shifts this keyframe from Anchor, positive is later.
+	// Bounded to +/-12h so internal/circadian.Interpolate's search window
+	// (the day before/of/after "now") is always sufficient to resolve it.
+	OffsetMinutes pulumi.IntPtrInput `pulumi:"offsetMinutes"`
+	// On explicitly turns the group's lights on or off at this keyframe, or
+	// leaves on/off state unchanged (the default) - unlike Brightness/
+	// ColorTempK, on/off isn't a continuous curve, so this is a sparse
+	// override, not a required per-keyframe value. internal/
+	// circadian.Interpolate resolves the effective value for "now" as the
+	// nearest keyframe at-or-before now (searching backward, wrapping
+	// across days) whose On isn't "unchanged" - a step function, not
+	// interpolated/blended between two keyframes the way Brightness/
+	// ColorTempK are.
+	On pulumi.StringPtrInput `pulumi:"on"`
+	// Time is the local wall-clock time, "HH:MM" in the schedule's
+	// TimeZone, a clockTime Anchor resolves to - required for, and only
+	// used by, that anchor. On a DST transition day a Time that doesn't
+	// exist (skipped by spring-forward) or exists twice (repeated by
+	// fall-back) resolves to whichever instant Go's time.Date picks, never
+	// an error - off by at most the DST shift for one night a year.
+	Time pulumi.StringPtrInput `pulumi:"time"`
+}
+
+func (CircadianScheduleSpecVariantsKeyframesArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CircadianScheduleSpecVariantsKeyframes)(nil)).Elem()
+}
+
+func (i CircadianScheduleSpecVariantsKeyframesArgs) ToCircadianScheduleSpecVariantsKeyframesOutput() CircadianScheduleSpecVariantsKeyframesOutput {
+	return i.ToCircadianScheduleSpecVariantsKeyframesOutputWithContext(context.Background())
+}
+
+func (i CircadianScheduleSpecVariantsKeyframesArgs) ToCircadianScheduleSpecVariantsKeyframesOutputWithContext(ctx context.Context) CircadianScheduleSpecVariantsKeyframesOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CircadianScheduleSpecVariantsKeyframesOutput)
+}
+
+// CircadianScheduleSpecVariantsKeyframesArrayInput is an input type that accepts CircadianScheduleSpecVariantsKeyframesArray and CircadianScheduleSpecVariantsKeyframesArrayOutput values.
+// You can construct a concrete instance of `CircadianScheduleSpecVariantsKeyframesArrayInput` via:
+//
+//	CircadianScheduleSpecVariantsKeyframesArray{ CircadianScheduleSpecVariantsKeyframesArgs{...} }
+type CircadianScheduleSpecVariantsKeyframesArrayInput interface {
+	pulumi.Input
+
+	ToCircadianScheduleSpecVariantsKeyframesArrayOutput() CircadianScheduleSpecVariantsKeyframesArrayOutput
+	ToCircadianScheduleSpecVariantsKeyframesArrayOutputWithContext(context.Context) CircadianScheduleSpecVariantsKeyframesArrayOutput
+}
+
+type CircadianScheduleSpecVariantsKeyframesArray []CircadianScheduleSpecVariantsKeyframesInput
+
+func (CircadianScheduleSpecVariantsKeyframesArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CircadianScheduleSpecVariantsKeyframes)(nil)).Elem()
+}
+
+func (i CircadianScheduleSpecVariantsKeyframesArray) ToCircadianScheduleSpecVariantsKeyframesArrayOutput() CircadianScheduleSpecVariantsKeyframesArrayOutput {
+	return i.ToCircadianScheduleSpecVariantsKeyframesArrayOutputWithContext(context.Background())
+}
+
+func (i CircadianScheduleSpecVariantsKeyframesArray) ToCircadianScheduleSpecVariantsKeyframesArrayOutputWithContext(ctx context.Context) CircadianScheduleSpecVariantsKeyframesArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CircadianScheduleSpecVariantsKeyframesArrayOutput)
+}
+
+// CircadianKeyframe pins an absolute (Brightness, ColorTempK) pair to a
+// point in the day - Anchor plus OffsetMinutes. Solar anchors keep the
+// schedule making sense across the seasons as sunrise/sunset drift; a
+// clockTime anchor is for the things that don't follow the sun at all
+// (e.g. "dim to 20% at 22:30 every night"), and the two can be mixed
+// freely in one schedule. Unlike SceneLightState's pointer fields (which mean "leave
+// this untouched"), Brightness/ColorTempK here are required: a continuous
+// curve needs a value defined at every keyframe, not a sparse override -
+// there's no "untouched" between two points on a curve.
+type CircadianScheduleSpecVariantsKeyframesOutput struct{ *pulumi.OutputState }
+
+func (CircadianScheduleSpecVariantsKeyframesOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CircadianScheduleSpecVariantsKeyframes)(nil)).Elem()
+}
+
+func (o CircadianScheduleSpecVariantsKeyframesOutput) ToCircadianScheduleSpecVariantsKeyframesOutput() CircadianScheduleSpecVariantsKeyframesOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecVariantsKeyframesOutput) ToCircadianScheduleSpecVariantsKeyframesOutputWithContext(ctx context.Context) CircadianScheduleSpecVariantsKeyframesOutput {
+	return o
+}
+
+// Anchor is the solar event (or clockTime) this keyframe is offset
+// from.
+func (o CircadianScheduleSpecVariantsKeyframesOutput) Anchor() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecVariantsKeyframes) *string { return v.Anchor }).(pulumi.StringPtrOutput)
+}
+
+// Brightness at this keyframe, 0-100.
+func (o CircadianScheduleSpecVariantsKeyframesOutput) Brightness() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecVariantsKeyframes) *int { return v.Brightness }).(pulumi.IntPtrOutput)
+}
+
+// ColorTempK at this keyframe, in Kelvin.
+func (o CircadianScheduleSpecVariantsKeyframesOutput) ColorTempK() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecVariantsKeyframes) *int { return v.ColorTempK }).(pulumi.IntPtrOutput)
+}
+
+// OffsetMinutes shifts this keyframe from Anchor, positive is later.
+// Bounded to +/-12h so internal/circadian.Interpolate's search window
+// (the day before/of/after "now") is always sufficient to resolve it.
+func (o CircadianScheduleSpecVariantsKeyframesOutput) OffsetMinutes() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecVariantsKeyframes) *int { return v.OffsetMinutes }).(pulumi.IntPtrOutput)
+}
+
+// On explicitly turns the group's lights on or off at this keyframe, or
+// leaves on/off state unchanged (the default) - unlike Brightness/
+// ColorTempK, on/off isn't a continuous curve, so this is a sparse
+// override, not a required per-keyframe value. internal/
+// circadian.Interpolate resolves the effective value for "now" as the
+// nearest keyframe at-or-before now (searching backward, wrapping
+// across days) whose On isn't "unchanged" - a step function, not
+// interpolated/blended between two keyframes the way Brightness/
+// ColorTempK are.
+func (o CircadianScheduleSpecVariantsKeyframesOutput) On() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecVariantsKeyframes) *string { return v.On }).(pulumi.StringPtrOutput)
+}
+
+// Time is the local wall-clock time, "HH:MM" in the schedule's
+// TimeZone, a clockTime Anchor resolves to - required for, and only
+// used by, that anchor. On a DST transition day a Time that doesn't
+// exist (skipped by spring-forward) or exists twice (repeated by
+// fall-back) resolves to whichever instant Go's time.Date picks, never
+// an error - off by at most the DST shift for one night a year.
+func (o CircadianScheduleSpecVariantsKeyframesOutput) Time() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecVariantsKeyframes) *string { return v.Time }).(pulumi.StringPtrOutput)
+}
+
+type CircadianScheduleSpecVariantsKeyframesArrayOutput struct{ *pulumi.OutputState }
+
+func (CircadianScheduleSpecVariantsKeyframesArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CircadianScheduleSpecVariantsKeyframes)(nil)).Elem()
+}
+
+func (o CircadianScheduleSpecVariantsKeyframesArrayOutput) ToCircadianScheduleSpecVariantsKeyframesArrayOutput() CircadianScheduleSpecVariantsKeyframesArrayOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecVariantsKeyframesArrayOutput) ToCircadianScheduleSpecVariantsKeyframesArrayOutputWithContext(ctx context.Context) CircadianScheduleSpecVariantsKeyframesArrayOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecVariantsKeyframesArrayOutput) Index(i pulumi.IntInput) CircadianScheduleSpecVariantsKeyframesOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) CircadianScheduleSpecVariantsKeyframes {
+		return vs[0].([]CircadianScheduleSpecVariantsKeyframes)[vs[1].(int)]
+	}).(CircadianScheduleSpecVariantsKeyframesOutput)
+}
+
+// CircadianKeyframe pins an absolute (Brightness, ColorTempK) pair to a
+// point in the day - Anchor plus OffsetMinutes. Solar anchors keep the
+// schedule making sense across the seasons as sunrise/sunset drift; a
+// clockTime anchor is for the things that don't follow the sun at all
+// (e.g. "dim to 20% at 22:30 every night"), and the two can be mixed
+// freely in one schedule. Unlike SceneLightState's pointer fields (which mean "leave
+// this untouched"), Brightness/ColorTempK here are required: a continuous
+// curve needs a value defined at every keyframe, not a sparse override -
+// there's no "untouched" between two points on a curve.
+type CircadianScheduleSpecVariantsKeyframesPatch struct {
+	// Anchor is the solar event (or clockTime) this keyframe is offset
+	// from.
+	Anchor *string `pulumi:"anchor"`
+	// Brightness at this keyframe, 0-100.
+	Brightness *int `pulumi:"brightness"`
+	// ColorTempK at this keyframe, in Kelvin.
+	ColorTempK *int `pulumi:"colorTempK"`
+	// OffsetMinutes shifts this keyframe from Anchor, positive is later.
+	// Bounded to +/-12h so internal/circadian.Interpolate's search window
+	// (the day before/of/after "now") is always sufficient to resolve it.
+	OffsetMinutes *int `pulumi:"offsetMinutes"`
+	// On explicitly turns the group's lights on or off at this keyframe, or
+	// leaves on/off state unchanged (the default) - unlike Brightness/
+	// ColorTempK, on/off isn't a continuous curve, so this is a sparse
+	// override, not a required per-keyframe value. internal/
+	// circadian.Interpolate resolves the effective value for "now" as the
+	// nearest keyframe at-or-before now (searching backward, wrapping
+	// across days) whose On isn't "unchanged" - a step function, not
+	// interpolated/blended between two keyframes the way Brightness/
+	// ColorTempK are.
+	On *string `pulumi:"on"`
+	// Time is the local wall-clock time, "HH:MM" in the schedule's
+	// TimeZone, a clockTime Anchor resolves to - required for, and only
+	// used by, that anchor. On a DST transition day a Time that doesn't
+	// exist (skipped by spring-forward) or exists twice (repeated by
+	// fall-back) resolves to whichever instant Go's time.Date picks, never
+	// an error - off by at most the DST shift for one night a year.
+	Time *string `pulumi:"time"`
+}
+
+// CircadianScheduleSpecVariantsKeyframesPatchInput is an input type that accepts CircadianScheduleSpecVariantsKeyframesPatchArgs and CircadianScheduleSpecVariantsKeyframesPatchOutput values.
+// You can construct a concrete instance of `CircadianScheduleSpecVariantsKeyframesPatchInput` via:
+//
+//	CircadianScheduleSpecVariantsKeyframesPatchArgs{...}
+type CircadianScheduleSpecVariantsKeyframesPatchInput interface {
+	pulumi.Input
+
+	ToCircadianScheduleSpecVariantsKeyframesPatchOutput() CircadianScheduleSpecVariantsKeyframesPatchOutput
+	ToCircadianScheduleSpecVariantsKeyframesPatchOutputWithContext(context.Context) CircadianScheduleSpecVariantsKeyframesPatchOutput
+}
+
+// CircadianKeyframe pins an absolute (Brightness, ColorTempK) pair to a
+// point in the day - Anchor plus OffsetMinutes. Solar anchors keep the
+// schedule making sense across the seasons as sunrise/sunset drift; a
+// clockTime anchor is for the things that don't follow the sun at all
+// (e.g. "dim to 20% at 22:30 every night"), and the two can be mixed
+// freely in one schedule. Unlike SceneLightState's pointer fields (which mean "leave
+// this untouched"), Brightness/ColorTempK here are required: a continuous
+// curve needs a value defined at every keyframe, not a sparse override -
+// there's no "untouched" between two points on a curve.
+type CircadianScheduleSpecVariantsKeyframesPatchArgs struct {
+	// Anchor is the solar event (or clockTime) this keyframe is offset
+	// from.
+	Anchor pulumi.StringPtrInput `pulumi:"anchor"`
+	// Brightness at this keyframe, 0-100.
+	Brightness pulumi.IntPtrInput `pulumi:"brightness"`
+	// ColorTempK at this keyframe, in Kelvin.
+	ColorTempK pulumi.IntPtrInput `pulumi:"colorTempK"`
+	// OffsetMinutes shifts this keyframe from Anchor, positive is later.
+	// Bounded to +/-12h so internal/circadian.Interpolate's search window
+	// (the day before/of/after "now") is always sufficient to resolve it.
+	OffsetMinutes pulumi.IntPtrInput `pulumi:"offsetMinutes"`
+	// On explicitly turns the group's lights on or off at this keyframe, or
+	// leaves on/off state unchanged (the default) - unlike Brightness/
+	// ColorTempK, on/off isn't a continuous curve, so this is a sparse
+	// override, not a required per-keyframe value. internal/
+	// circadian.Interpolate resolves the effective value for "now" as the
+	// nearest keyframe at-or-before now (searching backward, wrapping
+	// across days) whose On isn't "unchanged" - a step function, not
+	// interpolated/blended between two keyframes the way Brightness/
+	// ColorTempK are.
+	On pulumi.StringPtrInput `pulumi:"on"`
+	// Time is the local wall-clock time, "HH:MM" in the schedule's
+	// TimeZone, a clockTime Anchor resolves to - required for, and only
+	// used by, that anchor. On a DST transition day a Time that doesn't
+	// exist (skipped by spring-forward) or exists twice (repeated by
+	// fall-back) resolves to whichever instant Go's time.Date picks, never
+	// an error - off by at most the DST shift for one night a year.
+	Time pulumi.StringPtrInput `pulumi:"time"`
+}
+
+func (CircadianScheduleSpecVariantsKeyframesPatchArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CircadianScheduleSpecVariantsKeyframesPatch)(nil)).Elem()
+}
+
+func (i CircadianScheduleSpecVariantsKeyframesPatchArgs) ToCircadianScheduleSpecVariantsKeyframesPatchOutput() CircadianScheduleSpecVariantsKeyframesPatchOutput {
+	return i.ToCircadianScheduleSpecVariantsKeyframesPatchOutputWithContext(context.Background())
+}
+
+func (i CircadianScheduleSpecVariantsKeyframesPatchArgs) ToCircadianScheduleSpecVariantsKeyframesPatchOutputWithContext(ctx context.Context) CircadianScheduleSpecVariantsKeyframesPatchOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CircadianScheduleSpecVariantsKeyframesPatchOutput)
+}
+
+// CircadianScheduleSpecVariantsKeyframesPatchArrayInput is an input type that accepts CircadianScheduleSpecVariantsKeyframesPatchArray and CircadianScheduleSpecVariantsKeyframesPatchArrayOutput values.
+// You can construct a concrete instance of `CircadianScheduleSpecVariantsKeyframesPatchArrayInput` via:
+//
+//	CircadianScheduleSpecVariantsKeyframesPatchArray{ CircadianScheduleSpecVariantsKeyframesPatchArgs{...} }
+type CircadianScheduleSpecVariantsKeyframesPatchArrayInput interface {
+	pulumi.Input
+
+	ToCircadianScheduleSpecVariantsKeyframesPatchArrayOutput() CircadianScheduleSpecVariantsKeyframesPatchArrayOutput
+	ToCircadianScheduleSpecVariantsKeyframesPatchArrayOutputWithContext(context.Context) CircadianScheduleSpecVariantsKeyframesPatchArrayOutput
+}
+
+type CircadianScheduleSpecVariantsKeyframesPatchArray []CircadianScheduleSpecVariantsKeyframesPatchInput
+
+func (CircadianScheduleSpecVariantsKeyframesPatchArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CircadianScheduleSpecVariantsKeyframesPatch)(nil)).Elem()
+}
+
+func (i CircadianScheduleSpecVariantsKeyframesPatchArray) ToCircadianScheduleSpecVariantsKeyframesPatchArrayOutput() CircadianScheduleSpecVariantsKeyframesPatchArrayOutput {
+	return i.ToCircadianScheduleSpecVariantsKeyframesPatchArrayOutputWithContext(context.Background())
+}
+
+func (i CircadianScheduleSpecVariantsKeyframesPatchArray) ToCircadianScheduleSpecVariantsKeyframesPatchArrayOutputWithContext(ctx context.Context) CircadianScheduleSpecVariantsKeyframesPatchArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CircadianScheduleSpecVariantsKeyframesPatchArrayOutput)
+}
+
+// CircadianKeyframe pins an absolute (Brightness, ColorTempK) pair to a
+// point in the day - Anchor plus OffsetMinutes. Solar anchors keep the
+// schedule making sense across the seasons as sunrise/sunset drift; a
+// clockTime anchor is for the things that don't follow the sun at all
+// (e.g. "dim to 20% at 22:30 every night"), and the two can be mixed
+// freely in one schedule. Unlike SceneLightState's pointer fields (which mean "leave
+// this untouched"), Brightness/ColorTempK here are required: a continuous
+// curve needs a value defined at every keyframe, not a sparse override -
+// there's no "untouched" between two points on a curve.
+type CircadianScheduleSpecVariantsKeyframesPatchOutput struct{ *pulumi.OutputState }
+
+func (CircadianScheduleSpecVariantsKeyframesPatchOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CircadianScheduleSpecVariantsKeyframesPatch)(nil)).Elem()
+}
+
+func (o CircadianScheduleSpecVariantsKeyframesPatchOutput) ToCircadianScheduleSpecVariantsKeyframesPatchOutput() CircadianScheduleSpecVariantsKeyframesPatchOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecVariantsKeyframesPatchOutput) ToCircadianScheduleSpecVariantsKeyframesPatchOutputWithContext(ctx context.Context) CircadianScheduleSpecVariantsKeyframesPatchOutput {
+	return o
+}
+
+// Anchor is the solar event (or clockTime) this keyframe is offset
+// from.
+func (o CircadianScheduleSpecVariantsKeyframesPatchOutput) Anchor() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecVariantsKeyframesPatch) *string { return v.Anchor }).(pulumi.StringPtrOutput)
+}
+
+// Brightness at this keyframe, 0-100.
+func (o CircadianScheduleSpecVariantsKeyframesPatchOutput) Brightness() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecVariantsKeyframesPatch) *int { return v.Brightness }).(pulumi.IntPtrOutput)
+}
+
+// ColorTempK at this keyframe, in Kelvin.
+func (o CircadianScheduleSpecVariantsKeyframesPatchOutput) ColorTempK() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecVariantsKeyframesPatch) *int { return v.ColorTempK }).(pulumi.IntPtrOutput)
+}
+
+// OffsetMinutes shifts this keyframe from Anchor, positive is later.
+// Bounded to +/-12h so internal/circadian.Interpolate's search window
+// (the day before/of/after "now") is always sufficient to resolve it.
+func (o CircadianScheduleSpecVariantsKeyframesPatchOutput) OffsetMinutes() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecVariantsKeyframesPatch) *int { return v.OffsetMinutes }).(pulumi.IntPtrOutput)
+}
+
+// On explicitly turns the group's lights on or off at this keyframe, or
+// leaves on/off state unchanged (the default) - unlike Brightness/
+// ColorTempK, on/off isn't a continuous curve, so this is a sparse
+// override, not a required per-keyframe value. internal/
+// circadian.Interpolate resolves the effective value for "now" as the
+// nearest keyframe at-or-before now (searching backward, wrapping
+// across days) whose On isn't "unchanged" - a step function, not
+// interpolated/blended between two keyframes the way Brightness/
+// ColorTempK are.
+func (o CircadianScheduleSpecVariantsKeyframesPatchOutput) On() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecVariantsKeyframesPatch) *string { return v.On }).(pulumi.StringPtrOutput)
+}
+
+// Time is the local wall-clock time, "HH:MM" in the schedule's
+// TimeZone, a clockTime Anchor resolves to - required for, and only
+// used by, that anchor. On a DST transition day a Time that doesn't
+// exist (skipped by spring-forward) or exists twice (repeated by
+// fall-back) resolves to whichever instant Go's time.Date picks, never
+// an error - off by at most the DST shift for one night a year.
+func (o CircadianScheduleSpecVariantsKeyframesPatchOutput) Time() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecVariantsKeyframesPatch) *string { return v.Time }).(pulumi.StringPtrOutput)
+}
+
+type CircadianScheduleSpecVariantsKeyframesPatchArrayOutput struct{ *pulumi.OutputState }
+
+func (CircadianScheduleSpecVariantsKeyframesPatchArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CircadianScheduleSpecVariantsKeyframesPatch)(nil)).Elem()
+}
+
+func (o CircadianScheduleSpecVariantsKeyframesPatchArrayOutput) ToCircadianScheduleSpecVariantsKeyframesPatchArrayOutput() CircadianScheduleSpecVariantsKeyframesPatchArrayOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecVariantsKeyframesPatchArrayOutput) ToCircadianScheduleSpecVariantsKeyframesPatchArrayOutputWithContext(ctx context.Context) CircadianScheduleSpecVariantsKeyframesPatchArrayOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecVariantsKeyframesPatchArrayOutput) Index(i pulumi.IntInput) CircadianScheduleSpecVariantsKeyframesPatchOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) CircadianScheduleSpecVariantsKeyframesPatch {
+		return vs[0].([]CircadianScheduleSpecVariantsKeyframesPatch)[vs[1].(int)]
+	}).(CircadianScheduleSpecVariantsKeyframesPatchOutput)
+}
+
+// CircadianVariant replaces a CircadianSchedule's Keyframes on the listed
+// days - e.g. a weekend variant that gets up two hours later. A variant is
+// a complete keyframe set for its days, not a patch over the default one:
+// merging two sets keyframe-by-keyframe would need an identity for each
+// keyframe that CircadianKeyframe doesn't have.
+type CircadianScheduleSpecVariantsPatch struct {
+	// Days this variant applies on. A day may only be listed by one
+	// variant.
+	Days []string `pulumi:"days"`
+	// Keyframes used on Days instead of the schedule's own - same rules
+	// as CircadianScheduleSpec.Keyframes.
+	Keyframes []CircadianScheduleSpecVariantsKeyframesPatch `pulumi:"keyframes"`
+}
+
+// CircadianScheduleSpecVariantsPatchInput is an input type that accepts CircadianScheduleSpecVariantsPatchArgs and CircadianScheduleSpecVariantsPatchOutput values.
+// You can construct a concrete instance of `CircadianScheduleSpecVariantsPatchInput` via:
+//
+//	CircadianScheduleSpecVariantsPatchArgs{...}
+type CircadianScheduleSpecVariantsPatchInput interface {
+	pulumi.Input
+
+	ToCircadianScheduleSpecVariantsPatchOutput() CircadianScheduleSpecVariantsPatchOutput
+	ToCircadianScheduleSpecVariantsPatchOutputWithContext(context.Context) CircadianScheduleSpecVariantsPatchOutput
+}
+
+// CircadianVariant replaces a CircadianSchedule's Keyframes on the listed
+// days - e.g. a weekend variant that gets up two hours later. A variant is
+// a complete keyframe set for its days, not a patch over the default one:
+// merging two sets keyframe-by-keyframe would need an identity for each
+// keyframe that CircadianKeyframe doesn't have.
+type CircadianScheduleSpecVariantsPatchArgs struct {
+	// Days this variant applies on. A day may only be listed by one
+	// variant.
+	Days pulumi.StringArrayInput `pulumi:"days"`
+	// Keyframes used on Days instead of the schedule's own - same rules
+	// as CircadianScheduleSpec.Keyframes.
+	Keyframes CircadianScheduleSpecVariantsKeyframesPatchArrayInput `pulumi:"keyframes"`
+}
+
+func (CircadianScheduleSpecVariantsPatchArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CircadianScheduleSpecVariantsPatch)(nil)).Elem()
+}
+
+func (i CircadianScheduleSpecVariantsPatchArgs) ToCircadianScheduleSpecVariantsPatchOutput() CircadianScheduleSpecVariantsPatchOutput {
+	return i.ToCircadianScheduleSpecVariantsPatchOutputWithContext(context.Background())
+}
+
+func (i CircadianScheduleSpecVariantsPatchArgs) ToCircadianScheduleSpecVariantsPatchOutputWithContext(ctx context.Context) CircadianScheduleSpecVariantsPatchOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CircadianScheduleSpecVariantsPatchOutput)
+}
+
+// CircadianScheduleSpecVariantsPatchArrayInput is an input type that accepts CircadianScheduleSpecVariantsPatchArray and CircadianScheduleSpecVariantsPatchArrayOutput values.
+// You can construct a concrete instance of `CircadianScheduleSpecVariantsPatchArrayInput` via:
+//
+//	CircadianScheduleSpecVariantsPatchArray{ CircadianScheduleSpecVariantsPatchArgs{...} }
+type CircadianScheduleSpecVariantsPatchArrayInput interface {
+	pulumi.Input
+
+	ToCircadianScheduleSpecVariantsPatchArrayOutput() CircadianScheduleSpecVariantsPatchArrayOutput
+	ToCircadianScheduleSpecVariantsPatchArrayOutputWithContext(context.Context) CircadianScheduleSpecVariantsPatchArrayOutput
 }
 
-// Keyframes define the curve, at least 2, resolved and interpolated
-// against "now" by internal/circadian.Interpolate. Order in this list
-// doesn't matter - they're sorted by resolved instant before
-// interpolating.
-func (o CircadianScheduleSpecPatchOutput) Keyframes() CircadianScheduleSpecKeyframesPatchArrayOutput {
-	return o.ApplyT(func(v CircadianScheduleSpecPatch) []CircadianScheduleSpecKeyframesPatch { return v.Keyframes }).(CircadianScheduleSpecKeyframesPatchArrayOutput)
+type CircadianScheduleSpecVariantsPatchArray []CircadianScheduleSpecVariantsPatchInput
+
+func (CircadianScheduleSpecVariantsPatchArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CircadianScheduleSpecVariantsPatch)(nil)).Elem()
 }
 
-// Latitude of the location Keyframes are anchored to, decimal degrees
-// positive north. Required, not defaulted: 0 is a real location (Null
-// Island, off the coast of Ghana) rather than a safe "unset" sentinel,
-// so a schedule created without one would silently compute sun times
-// for the wrong place instead of failing validation - confirmed live,
-// this is exactly what happened before Latitude/Longitude moved from a
-// global --latitude/--longitude flag onto this Spec.
-func (o CircadianScheduleSpecPatchOutput) Latitude() pulumi.Float64PtrOutput {
-	return o.ApplyT(func(v CircadianScheduleSpecPatch) *float64 { return v.Latitude }).(pulumi.Float64PtrOutput)
+func (i CircadianScheduleSpecVariantsPatchArray) ToCircadianScheduleSpecVariantsPatchArrayOutput() CircadianScheduleSpecVariantsPatchArrayOutput {
+	return i.ToCircadianScheduleSpecVariantsPatchArrayOutputWithContext(context.Background())
 }
 
-// Longitude of the location Keyframes are anchored to, decimal degrees
-// positive east. Required - see Latitude's doc comment for why.
-func (o CircadianScheduleSpecPatchOutput) Longitude() pulumi.Float64PtrOutput {
-	return o.ApplyT(func(v CircadianScheduleSpecPatch) *float64 { return v.Longitude }).(pulumi.Float64PtrOutput)
+func (i CircadianScheduleSpecVariantsPatchArray) ToCircadianScheduleSpecVariantsPatchArrayOutputWithContext(ctx context.Context) CircadianScheduleSpecVariantsPatchArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CircadianScheduleSpecVariantsPatchArrayOutput)
 }
 
-type CircadianScheduleSpecPatchPtrOutput struct{ *pulumi.OutputState }
+// CircadianVariant replaces a CircadianSchedule's Keyframes on the listed
+// days - e.g. a weekend variant that gets up two hours later. A variant is
+// a complete keyframe set for its days, not a patch over the default one:
+// merging two sets keyframe-by-keyframe would need an identity for each
+// keyframe that CircadianKeyframe doesn't have.
+type CircadianScheduleSpecVariantsPatchOutput struct{ *pulumi.OutputState }
 
-func (CircadianScheduleSpecPatchPtrOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**CircadianScheduleSpecPatch)(nil)).Elem()
+func (CircadianScheduleSpecVariantsPatchOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CircadianScheduleSpecVariantsPatch)(nil)).Elem()
 }
 
-func (o CircadianScheduleSpecPatchPtrOutput) ToCircadianScheduleSpecPatchPtrOutput() CircadianScheduleSpecPatchPtrOutput {
+func (o CircadianScheduleSpecVariantsPatchOutput) ToCircadianScheduleSpecVariantsPatchOutput() CircadianScheduleSpecVariantsPatchOutput {
 	return o
 }
 
-func (o CircadianScheduleSpecPatchPtrOutput) ToCircadianScheduleSpecPatchPtrOutputWithContext(ctx context.Context) CircadianScheduleSpecPatchPtrOutput {
+func (o CircadianScheduleSpecVariantsPatchOutput) ToCircadianScheduleSpecVariantsPatchOutputWithContext(ctx context.Context) CircadianScheduleSpecVariantsPatchOutput {
 	return o
 }
 
-func (o CircadianScheduleSpecPatchPtrOutput) Elem() CircadianScheduleSpecPatchOutput {
-	return o.ApplyT(func(v *CircadianScheduleSpecPatch) CircadianScheduleSpecPatch {
-		if v != nil {
-			return *v
-		}
-		var ret CircadianScheduleSpecPatch
-		return ret
-	}).(CircadianScheduleSpecPatchOutput)
+// Days this variant applies on. A day may only be listed by one
+// variant.
+func (o CircadianScheduleSpecVariantsPatchOutput) Days() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecVariantsPatch) []string { return v.Days }).(pulumi.StringArrayOutput)
 }
 
-// Group is the name of the Group this schedule applies to. A Group's
-// Spec.ActiveScene must reference this schedule's own metadata.name
-// for it to ever be enacted - same reciprocal-match convention as
-// SceneSpec.Group.
-func (o CircadianScheduleSpecPatchPtrOutput) Group() pulumi.StringPtrOutput {
-	return o.ApplyT(func(v *CircadianScheduleSpecPatch) *string {
-		if v == nil {
-			return nil
-		}
-		return v.Group
-	}).(pulumi.StringPtrOutput)
+// Keyframes used on Days instead of the schedule's own - same rules
+// as CircadianScheduleSpec.Keyframes.
+func (o CircadianScheduleSpecVariantsPatchOutput) Keyframes() CircadianScheduleSpecVariantsKeyframesPatchArrayOutput {
+	return o.ApplyT(func(v CircadianScheduleSpecVariantsPatch) []CircadianScheduleSpecVariantsKeyframesPatch {
+		return v.Keyframes
+	}).(CircadianScheduleSpecVariantsKeyframesPatchArrayOutput)
 }
 
-// Keyframes define the curve, at least 2, resolved and interpolated
-// against "now" by internal/circadian.Interpolate. Order in this list
-// doesn't matter - they're sorted by resolved instant before
-// interpolating.
-func (o CircadianScheduleSpecPatchPtrOutput) Keyframes() CircadianScheduleSpecKeyframesPatchArrayOutput {
-	return o.ApplyT(func(v *CircadianScheduleSpecPatch) []CircadianScheduleSpecKeyframesPatch {
-		if v == nil {
-			return nil
-		}
-		return v.Keyframes
-	}).(CircadianScheduleSpecKeyframesPatchArrayOutput)
+type CircadianScheduleSpecVariantsPatchArrayOutput struct{ *pulumi.OutputState }
+
+func (CircadianScheduleSpecVariantsPatchArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CircadianScheduleSpecVariantsPatch)(nil)).Elem()
 }
 
-// Latitude of the location Keyframes are anchored to, decimal degrees
-// positive north. Required, not defaulted: 0 is a real location (Null
-// Island, off the coast of Ghana) rather than a safe "unset" sentinel,
-// so a schedule created without one would silently compute sun times
-// for the wrong place instead of failing validation - confirmed live,
-// this is exactly what happened before Latitude/Longitude moved from a
-// global --latitude/--longitude flag onto this Spec.
-func (o CircadianScheduleSpecPatchPtrOutput) Latitude() pulumi.Float64PtrOutput {
-	return o.ApplyT(func(v *CircadianScheduleSpecPatch) *float64 {
-		if v == nil {
-			return nil
-		}
-		return v.Latitude
-	}).(pulumi.Float64PtrOutput)
+func (o CircadianScheduleSpecVariantsPatchArrayOutput) ToCircadianScheduleSpecVariantsPatchArrayOutput() CircadianScheduleSpecVariantsPatchArrayOutput {
+	return o
 }
 
-// Longitude of the location Keyframes are anchored to, decimal degrees
-// positive east. Required - see Latitude's doc comment for why.
-func (o CircadianScheduleSpecPatchPtrOutput) Longitude() pulumi.Float64PtrOutput {
-	return o.ApplyT(func(v *CircadianScheduleSpecPatch) *float64 {
-		if v == nil {
-			return nil
-		}
-		return v.Longitude
-	}).(pulumi.Float64PtrOutput)
+func (o CircadianScheduleSpecVariantsPatchArrayOutput) ToCircadianScheduleSpecVariantsPatchArrayOutputWithContext(ctx context.Context) CircadianScheduleSpecVariantsPatchArrayOutput {
+	return o
+}
+
+func (o CircadianScheduleSpecVariantsPatchArrayOutput) Index(i pulumi.IntInput) CircadianScheduleSpecVariantsPatchOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) CircadianScheduleSpecVariantsPatch {
+		return vs[0].([]CircadianScheduleSpecVariantsPatch)[vs[1].(int)]
+	}).(CircadianScheduleSpecVariantsPatchOutput)
 }
 
 // CircadianScheduleStatus reports the schedule's live-computed output,
@@ -1254,11 +2584,11 @@ func (o CircadianScheduleSpecPatchPtrOutput) Longitude() pulumi.Float64PtrOutput
 // Spec.ActiveScene - useful for tuning Keyframes via `kubectl get` before
 // wiring it live.
 type CircadianScheduleStatus struct {
-	// CurrentBrightness is what Spec.Keyframes interpolates to right now,
-	// nil if ValidationError is set.
+	// CurrentBrightness is what Spec.Keyframes (or Spec.Elevation)
+	// interpolates to right now, nil if ValidationError is set.
 	CurrentBrightness *int `pulumi:"currentBrightness"`
-	// CurrentColorTempK is what Spec.Keyframes interpolates to right now,
-	// nil if ValidationError is set.
+	// CurrentColorTempK is what Spec.Keyframes (or Spec.Elevation)
+	// interpolates to right now, nil if ValidationError is set.
 	CurrentColorTempK *int `pulumi:"currentColorTempK"`
 	// LastSynced is when this status was last recomputed.
 	LastSynced *string `pulumi:"lastSynced"`
@@ -1285,11 +2615,11 @@ type CircadianScheduleStatusInput interface {
 // Spec.ActiveScene - useful for tuning Keyframes via `kubectl get` before
 // wiring it live.
 type CircadianScheduleStatusArgs struct {
-	// CurrentBrightness is what Spec.Keyframes interpolates to right now,
-	// nil if ValidationError is set.
+	// CurrentBrightness is what Spec.Keyframes (or Spec.Elevation)
+	// interpolates to right now, nil if ValidationError is set.
 	CurrentBrightness pulumi.IntPtrInput `pulumi:"currentBrightness"`
-	// CurrentColorTempK is what Spec.Keyframes interpolates to right now,
-	// nil if ValidationError is set.
+	// CurrentColorTempK is what Spec.Keyframes (or Spec.Elevation)
+	// interpolates to right now, nil if ValidationError is set.
 	CurrentColorTempK pulumi.IntPtrInput `pulumi:"currentColorTempK"`
 	// LastSynced is when this status was last recomputed.
 	LastSynced pulumi.StringPtrInput `pulumi:"lastSynced"`
@@ -1381,14 +2711,14 @@ func (o CircadianScheduleStatusOutput) ToCircadianScheduleStatusPtrOutputWithCon
 	}).(CircadianScheduleStatusPtrOutput)
 }
 
-// CurrentBrightness is what Spec.Keyframes interpolates to right now,
-// nil if ValidationError is set.
+// CurrentBrightness is what Spec.Keyframes (or Spec.Elevation)
+// interpolates to right now, nil if ValidationError is set.
 func (o CircadianScheduleStatusOutput) CurrentBrightness() pulumi.IntPtrOutput {
 	return o.ApplyT(func(v CircadianScheduleStatus) *int { return v.CurrentBrightness }).(pulumi.IntPtrOutput)
 }
 
-// CurrentColorTempK is what Spec.Keyframes interpolates to right now,
-// nil if ValidationError is set.
+// CurrentColorTempK is what Spec.Keyframes (or Spec.Elevation)
+// interpolates to right now, nil if ValidationError is set.
 func (o CircadianScheduleStatusOutput) CurrentColorTempK() pulumi.IntPtrOutput {
 	return o.ApplyT(func(v CircadianScheduleStatus) *int { return v.CurrentColorTempK }).(pulumi.IntPtrOutput)
 }
@@ -1430,8 +2760,8 @@ func (o CircadianScheduleStatusPtrOutput) Elem() CircadianScheduleStatusOutput {
 	}).(CircadianScheduleStatusOutput)
 }
 
-// CurrentBrightness is what Spec.Keyframes interpolates to right now,
-// nil if ValidationError is set.
+// CurrentBrightness is what Spec.Keyframes (or Spec.Elevation)
+// interpolates to right now, nil if ValidationError is set.
 func (o CircadianScheduleStatusPtrOutput) CurrentBrightness() pulumi.IntPtrOutput {
 	return o.ApplyT(func(v *CircadianScheduleStatus) *int {
 		if v == nil {
@@ -1441,8 +2771,8 @@ func (o CircadianScheduleStatusPtrOutput) CurrentBrightness() pulumi.IntPtrOutpu
 	}).(pulumi.IntPtrOutput)
 }
 
-// CurrentColorTempK is what Spec.Keyframes interpolates to right now,
-// nil if ValidationError is set.
+// CurrentColorTempK is what Spec.Keyframes (or Spec.Elevation)
+// interpolates to right now, nil if ValidationError is set.
 func (o CircadianScheduleStatusPtrOutput) CurrentColorTempK() pulumi.IntPtrOutput {
 	return o.ApplyT(func(v *CircadianScheduleStatus) *int {
 		if v == nil {
@@ -1480,11 +2810,11 @@ func (o CircadianScheduleStatusPtrOutput) ValidationError() pulumi.StringPtrOutp
 // Spec.ActiveScene - useful for tuning Keyframes via `kubectl get` before
 // wiring it live.
 type CircadianScheduleStatusPatch struct {
-	// CurrentBrightness is what Spec.Keyframes interpolates to right now,
-	// nil if ValidationError is set.
+	// CurrentBrightness is what Spec.Keyframes (or Spec.Elevation)
+	// interpolates to right now, nil if ValidationError is set.
 	CurrentBrightness *int `pulumi:"currentBrightness"`
-	// CurrentColorTempK is what Spec.Keyframes interpolates to right now,
-	// nil if ValidationError is set.
+	// CurrentColorTempK is what Spec.Keyframes (or Spec.Elevation)
+	// interpolates to right now, nil if ValidationError is set.
 	CurrentColorTempK *int `pulumi:"currentColorTempK"`
 	// LastSynced is when this status was last recomputed.
 	LastSynced *string `pulumi:"lastSynced"`
@@ -1511,11 +2841,11 @@ type CircadianScheduleStatusPatchInput interface {
 // Spec.ActiveScene - useful for tuning Keyframes via `kubectl get` before
 // wiring it live.
 type CircadianScheduleStatusPatchArgs struct {
-	// CurrentBrightness is what Spec.Keyframes interpolates to right now,
-	// nil if ValidationError is set.
+	// CurrentBrightness is what Spec.Keyframes (or Spec.Elevation)
+	// interpolates to right now, nil if ValidationError is set.
 	CurrentBrightness pulumi.IntPtrInput `pulumi:"currentBrightness"`
-	// CurrentColorTempK is what Spec.Keyframes interpolates to right now,
-	// nil if ValidationError is set.
+	// CurrentColorTempK is what Spec.Keyframes (or Spec.Elevation)
+	// interpolates to right now, nil if ValidationError is set.
 	CurrentColorTempK pulumi.IntPtrInput `pulumi:"currentColorTempK"`
 	// LastSynced is when this status was last recomputed.
 	LastSynced pulumi.StringPtrInput `pulumi:"lastSynced"`
@@ -1607,14 +2937,14 @@ func (o CircadianScheduleStatusPatchOutput) ToCircadianScheduleStatusPatchPtrOut
 	}).(CircadianScheduleStatusPatchPtrOutput)
 }
 
-// CurrentBrightness is what Spec.Keyframes interpolates to right now,
-// nil if ValidationError is set.
+// CurrentBrightness is what Spec.Keyframes (or Spec.Elevation)
+// interpolates to right now, nil if ValidationError is set.
 func (o CircadianScheduleStatusPatchOutput) CurrentBrightness() pulumi.IntPtrOutput {
 	return o.ApplyT(func(v CircadianScheduleStatusPatch) *int { return v.CurrentBrightness }).(pulumi.IntPtrOutput)
 }
 
-// CurrentColorTempK is what Spec.Keyframes interpolates to right now,
-// nil if ValidationError is set.
+// CurrentColorTempK is what Spec.Keyframes (or Spec.Elevation)
+// interpolates to right now, nil if ValidationError is set.
 func (o CircadianScheduleStatusPatchOutput) CurrentColorTempK() pulumi.IntPtrOutput {
 	return o.ApplyT(func(v CircadianScheduleStatusPatch) *int { return v.CurrentColorTempK }).(pulumi.IntPtrOutput)
 }
@@ -1656,8 +2986,8 @@ func (o CircadianScheduleStatusPatchPtrOutput) Elem() CircadianScheduleStatusPat
 	}).(CircadianScheduleStatusPatchOutput)
 }
 
-// CurrentBrightness is what Spec.Keyframes interpolates to right now,
-// nil if ValidationError is set.
+// CurrentBrightness is what Spec.Keyframes (or Spec.Elevation)
+// interpolates to right now, nil if ValidationError is set.
 func (o CircadianScheduleStatusPatchPtrOutput) CurrentBrightness() pulumi.IntPtrOutput {
 	return o.ApplyT(func(v *CircadianScheduleStatusPatch) *int {
 		if v == nil {
@@ -1667,8 +2997,8 @@ func (o CircadianScheduleStatusPatchPtrOutput) CurrentBrightness() pulumi.IntPtr
 	}).(pulumi.IntPtrOutput)
 }
 
-// CurrentColorTempK is what Spec.Keyframes interpolates to right now,
-// nil if ValidationError is set.
+// CurrentColorTempK is what Spec.Keyframes (or Spec.Elevation)
+// interpolates to right now, nil if ValidationError is set.
 func (o CircadianScheduleStatusPatchPtrOutput) CurrentColorTempK() pulumi.IntPtrOutput {
 	return o.ApplyT(func(v *CircadianScheduleStatusPatch) *int {
 		if v == nil {
@@ -2025,6 +3355,7 @@ func (o GroupPatchTypeOutput) Status() GroupStatusPatchPtrOutput {
 // GroupSpec is the user-declared list of Lights belonging to this group.
 type GroupSpec struct {
 	ActiveScene *GroupSpecActiveScene `pulumi:"activeScene"`
+	Away        *GroupSpecAway        `pulumi:"away"`
 	// Lights are the names of Light CRs that belong to this group.
 	Lights []string `pulumi:"lights"`
 }
@@ -2043,6 +3374,7 @@ type GroupSpecInput interface {
 // GroupSpec is the user-declared list of Lights belonging to this group.
 type GroupSpecArgs struct {
 	ActiveScene GroupSpecActiveScenePtrInput `pulumi:"activeScene"`
+	Away        GroupSpecAwayPtrInput        `pulumi:"away"`
 	// Lights are the names of Light CRs that belong to this group.
 	Lights pulumi.StringArrayInput `pulumi:"lights"`
 }
@@ -2129,6 +3461,10 @@ func (o GroupSpecOutput) ActiveScene() GroupSpecActiveScenePtrOutput {
 	return o.ApplyT(func(v GroupSpec) *GroupSpecActiveScene { return v.ActiveScene }).(GroupSpecActiveScenePtrOutput)
 }
 
+func (o GroupSpecOutput) Away() GroupSpecAwayPtrOutput {
+	return o.ApplyT(func(v GroupSpec) *GroupSpecAway { return v.Away }).(GroupSpecAwayPtrOutput)
+}
+
 // Lights are the names of Light CRs that belong to this group.
 func (o GroupSpecOutput) Lights() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v GroupSpec) []string { return v.Lights }).(pulumi.StringArrayOutput)
@@ -2167,6 +3503,15 @@ func (o GroupSpecPtrOutput) ActiveScene() GroupSpecActiveScenePtrOutput {
 	}).(GroupSpecActiveScenePtrOutput)
 }
 
+func (o GroupSpecPtrOutput) Away() GroupSpecAwayPtrOutput {
+	return o.ApplyT(func(v *GroupSpec) *GroupSpecAway {
+		if v == nil {
+			return nil
+		}
+		return v.Away
+	}).(GroupSpecAwayPtrOutput)
+}
+
 // Lights are the names of Light CRs that belong to this group.
 func (o GroupSpecPtrOutput) Lights() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *GroupSpec) []string {
@@ -2623,9 +3968,428 @@ func (o GroupSpecActiveScenePatchPtrOutput) Name() pulumi.StringPtrOutput {
 	}).(pulumi.StringPtrOutput)
 }
 
+// Away, while Enabled, overrides ActiveScene with presence simulation:
+// every light in Spec.Lights is switched on and off together, replaying
+// a day drawn from Status.OnHistory with a random shift (see
+// internal/away.Replay). Only On is touched - brightness/color are left
+// at whatever ActiveScene last set. ActiveScene itself is left alone,
+// so disabling Away hands straight back to it on the next reconcile,
+// same as any other manual override being corrected. The group's
+// on/off state from just before Away was enabled (Status.PreAwayOn)
+// is restored first, so an ActiveScene that doesn't set On itself - a
+// CircadianSchedule with no On keyframes, or none at all - doesn't
+// leave the lights wherever the replay last put them.
+type GroupSpecAway struct {
+	// Enabled turns presence simulation on. Toggled by the web UI's
+	// GroupService.SetAway as well as by editing the Group directly.
+	Enabled *bool `pulumi:"enabled"`
+	// JitterMinutes bounds how far each day's replay is randomly shifted
+	// from the recorded pattern it's drawn from, in either direction - so
+	// the house doesn't switch on at exactly the same minute a watcher
+	// could have seen a week ago. At least 1: with omitempty, an explicit
+	// 0 would be indistinguishable from unset and defaulted anyway.
+	JitterMinutes *int `pulumi:"jitterMinutes"`
+}
+
+// GroupSpecAwayInput is an input type that accepts GroupSpecAwayArgs and GroupSpecAwayOutput values.
+// You can construct a concrete instance of `GroupSpecAwayInput` via:
+//
+//	GroupSpecAwayArgs{...}
+type GroupSpecAwayInput interface {
+	pulumi.Input
+
+	ToGroupSpecAwayOutput() GroupSpecAwayOutput
+	ToGroupSpecAwayOutputWithContext(context.Context) GroupSpecAwayOutput
+}
+
+// Away, while Enabled, overrides ActiveScene with presence simulation:
+// every light in Spec.Lights is switched on and off together, replaying
+// a day drawn from Status.OnHistory with a random shift (see
+// internal/away.Replay). Only On is touched - brightness/color are left
+// at whatever ActiveScene last set. ActiveScene itself is left alone,
+// so disabling Away hands straight back to it on the next reconcile,
+// same as any other manual override being corrected. The group's
+// on/off state from just before Away was enabled (Status.PreAwayOn)
+// is restored first, so an ActiveScene that doesn't set On itself - a
+// CircadianSchedule with no On keyframes, or none at all - doesn't
+// leave the lights wherever the replay last put them.
+type GroupSpecAwayArgs struct {
+	// Enabled turns presence simulation on. Toggled by the web UI's
+	// GroupService.SetAway as well as by editing the Group directly.
+	Enabled pulumi.BoolPtrInput `pulumi:"enabled"`
+	// JitterMinutes bounds how far each day's replay is randomly shifted
+	// from the recorded pattern it's drawn from, in either direction - so
+	// the house doesn't switch on at exactly the same minute a watcher
+	// could have seen a week ago. At least 1: with omitempty, an explicit
+	// 0 would be indistinguishable from unset and defaulted anyway.
+	JitterMinutes pulumi.IntPtrInput `pulumi:"jitterMinutes"`
+}
+
+func (GroupSpecAwayArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GroupSpecAway)(nil)).Elem()
+}
+
+func (i GroupSpecAwayArgs) ToGroupSpecAwayOutput() GroupSpecAwayOutput {
+	return i.ToGroupSpecAwayOutputWithContext(context.Background())
+}
+
+func (i GroupSpecAwayArgs) ToGroupSpecAwayOutputWithContext(ctx context.Context) GroupSpecAwayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GroupSpecAwayOutput)
+}
+
+func (i GroupSpecAwayArgs) ToGroupSpecAwayPtrOutput() GroupSpecAwayPtrOutput {
+	return i.ToGroupSpecAwayPtrOutputWithContext(context.Background())
+}
+
+func (i GroupSpecAwayArgs) ToGroupSpecAwayPtrOutputWithContext(ctx context.Context) GroupSpecAwayPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GroupSpecAwayOutput).ToGroupSpecAwayPtrOutputWithContext(ctx)
+}
+
+// GroupSpecAwayPtrInput is an input type that accepts GroupSpecAwayArgs, GroupSpecAwayPtr and GroupSpecAwayPtrOutput values.
+// You can construct a concrete instance of `GroupSpecAwayPtrInput` via:
+//
+//	        GroupSpecAwayArgs{...}
+//
+//	or:
+//
+//	        nil
+type GroupSpecAwayPtrInput interface {
+	pulumi.Input
+
+	ToGroupSpecAwayPtrOutput() GroupSpecAwayPtrOutput
+	ToGroupSpecAwayPtrOutputWithContext(context.Context) GroupSpecAwayPtrOutput
+}
+
+type groupSpecAwayPtrType GroupSpecAwayArgs
+
+func GroupSpecAwayPtr(v *GroupSpecAwayArgs) GroupSpecAwayPtrInput {
+	return (*groupSpecAwayPtrType)(v)
+}
+
+func (*groupSpecAwayPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**GroupSpecAway)(nil)).Elem()
+}
+
+func (i *groupSpecAwayPtrType) ToGroupSpecAwayPtrOutput() GroupSpecAwayPtrOutput {
+	return i.ToGroupSpecAwayPtrOutputWithContext(context.Background())
+}
+
+func (i *groupSpecAwayPtrType) ToGroupSpecAwayPtrOutputWithContext(ctx context.Context) GroupSpecAwayPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GroupSpecAwayPtrOutput)
+}
+
+// Away, while Enabled, overrides ActiveScene with presence simulation:
+// every light in Spec.Lights is switched on and off together, replaying
+// a day drawn from Status.OnHistory with a random shift (see
+// internal/away.Replay). Only On is touched - brightness/color are left
+// at whatever ActiveScene last set. ActiveScene itself is left alone,
+// so disabling Away hands straight back to it on the next reconcile,
+// same as any other manual override being corrected. The group's
+// on/off state from just before Away was enabled (Status.PreAwayOn)
+// is restored first, so an ActiveScene that doesn't set On itself - a
+// CircadianSchedule with no On keyframes, or none at all - doesn't
+// leave the lights wherever the replay last put them.
+type GroupSpecAwayOutput struct{ *pulumi.OutputState }
+
+func (GroupSpecAwayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GroupSpecAway)(nil)).Elem()
+}
+
+func (o GroupSpecAwayOutput) ToGroupSpecAwayOutput() GroupSpecAwayOutput {
+	return o
+}
+
+func (o GroupSpecAwayOutput) ToGroupSpecAwayOutputWithContext(ctx context.Context) GroupSpecAwayOutput {
+	return o
+}
+
+func (o GroupSpecAwayOutput) ToGroupSpecAwayPtrOutput() GroupSpecAwayPtrOutput {
+	return o.ToGroupSpecAwayPtrOutputWithContext(context.Background())
+}
+
+func (o GroupSpecAwayOutput) ToGroupSpecAwayPtrOutputWithContext(ctx context.Context) GroupSpecAwayPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v GroupSpecAway) *GroupSpecAway {
+		return &v
+	}).(GroupSpecAwayPtrOutput)
+}
+
+// Enabled turns presence simulation on. Toggled by the web UI's
+// GroupService.SetAway as well as by editing the Group directly.
+func (o GroupSpecAwayOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v GroupSpecAway) *bool { return v.Enabled }).(pulumi.BoolPtrOutput)
+}
+
+// JitterMinutes bounds how far each day's replay is randomly shifted
+// from the recorded pattern it's drawn from, in either direction - so
+// the house doesn't switch on at exactly the same minute a watcher
+// could have seen a week ago. At least 1: with omitempty, an explicit
+// 0 would be indistinguishable from unset and defaulted anyway.
+func (o GroupSpecAwayOutput) JitterMinutes() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v GroupSpecAway) *int { return v.JitterMinutes }).(pulumi.IntPtrOutput)
+}
+
+type GroupSpecAwayPtrOutput struct{ *pulumi.OutputState }
+
+func (GroupSpecAwayPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**GroupSpecAway)(nil)).Elem()
+}
+
+func (o GroupSpecAwayPtrOutput) ToGroupSpecAwayPtrOutput() GroupSpecAwayPtrOutput {
+	return o
+}
+
+func (o GroupSpecAwayPtrOutput) ToGroupSpecAwayPtrOutputWithContext(ctx context.Context) GroupSpecAwayPtrOutput {
+	return o
+}
+
+func (o GroupSpecAwayPtrOutput) Elem() GroupSpecAwayOutput {
+	return o.ApplyT(func(v *GroupSpecAway) GroupSpecAway {
+		if v != nil {
+			return *v
+		}
+		var ret GroupSpecAway
+		return ret
+	}).(GroupSpecAwayOutput)
+}
+
+// Enabled turns presence simulation on. Toggled by the web UI's
+// GroupService.SetAway as well as by editing the Group directly.
+func (o GroupSpecAwayPtrOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *GroupSpecAway) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Enabled
+	}).(pulumi.BoolPtrOutput)
+}
+
+// JitterMinutes bounds how far each day's replay is randomly shifted
+// from the recorded pattern it's drawn from, in either direction - so
+// the house doesn't switch on at exactly the same minute a watcher
+// could have seen a week ago. At least 1: with omitempty, an explicit
+// 0 would be indistinguishable from unset and defaulted anyway.
+func (o GroupSpecAwayPtrOutput) JitterMinutes() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *GroupSpecAway) *int {
+		if v == nil {
+			return nil
+		}
+		return v.JitterMinutes
+	}).(pulumi.IntPtrOutput)
+}
+
+// Away, while Enabled, overrides ActiveScene with presence simulation:
+// every light in Spec.Lights is switched on and off together, replaying
+// a day drawn from Status.OnHistory with a random shift (see
+// internal/away.Replay). Only On is touched - brightness/color are left
+// at whatever ActiveScene last set. ActiveScene itself is left alone,
+// so disabling Away hands straight back to it on the next reconcile,
+// same as any other manual override being corrected. The group's
+// on/off state from just before Away was enabled (Status.PreAwayOn)
+// is restored first, so an ActiveScene that doesn't set On itself - a
+// CircadianSchedule with no On keyframes, or none at all - doesn't
+// leave the lights wherever the replay last put them.
+type GroupSpecAwayPatch struct {
+	// Enabled turns presence simulation on. Toggled by the web UI's
+	// GroupService.SetAway as well as by editing the Group directly.
+	Enabled *bool `pulumi:"enabled"`
+	// JitterMinutes bounds how far each day's replay is randomly shifted
+	// from the recorded pattern it's drawn from, in either direction - so
+	// the house doesn't switch on at exactly the same minute a watcher
+	// could have seen a week ago. At least 1: with omitempty, an explicit
+	// 0 would be indistinguishable from unset and defaulted anyway.
+	JitterMinutes *int `pulumi:"jitterMinutes"`
+}
+
+// GroupSpecAwayPatchInput is an input type that accepts GroupSpecAwayPatchArgs and GroupSpecAwayPatchOutput values.
+// You can construct a concrete instance of `GroupSpecAwayPatchInput` via:
+//
+//	GroupSpecAwayPatchArgs{...}
+type GroupSpecAwayPatchInput interface {
+	pulumi.Input
+
+	ToGroupSpecAwayPatchOutput() GroupSpecAwayPatchOutput
+	ToGroupSpecAwayPatchOutputWithContext(context.Context) GroupSpecAwayPatchOutput
+}
+
+// Away, while Enabled, overrides ActiveScene with presence simulation:
+// every light in Spec.Lights is switched on and off together, replaying
+// a day drawn from Status.OnHistory with a random shift (see
+// internal/away.Replay). Only On is touched - brightness/color are left
+// at whatever ActiveScene last set. ActiveScene itself is left alone,
+// so disabling Away hands straight back to it on the next reconcile,
+// same as any other manual override being corrected. The group's
+// on/off state from just before Away was enabled (Status.PreAwayOn)
+// is restored first, so an ActiveScene that doesn't set On itself - a
+// CircadianSchedule with no On keyframes, or none at all - doesn't
+// leave the lights wherever the replay last put them.
+type GroupSpecAwayPatchArgs struct {
+	// Enabled turns presence simulation on. Toggled by the web UI's
+	// GroupService.SetAway as well as by editing the Group directly.
+	Enabled pulumi.BoolPtrInput `pulumi:"enabled"`
+	// JitterMinutes bounds how far each day's replay is randomly shifted
+	// from the recorded pattern it's drawn from, in either direction - so
+	// the house doesn't switch on at exactly the same minute a watcher
+	// could have seen a week ago. At least 1: with omitempty, an explicit
+	// 0 would be indistinguishable from unset and defaulted anyway.
+	JitterMinutes pulumi.IntPtrInput `pulumi:"jitterMinutes"`
+}
+
+func (GroupSpecAwayPatchArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GroupSpecAwayPatch)(nil)).Elem()
+}
+
+func (i GroupSpecAwayPatchArgs) ToGroupSpecAwayPatchOutput() GroupSpecAwayPatchOutput {
+	return i.ToGroupSpecAwayPatchOutputWithContext(context.Background())
+}
+
+func (i GroupSpecAwayPatchArgs) ToGroupSpecAwayPatchOutputWithContext(ctx context.Context) GroupSpecAwayPatchOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GroupSpecAwayPatchOutput)
+}
+
+func (i GroupSpecAwayPatchArgs) ToGroupSpecAwayPatchPtrOutput() GroupSpecAwayPatchPtrOutput {
+	return i.ToGroupSpecAwayPatchPtrOutputWithContext(context.Background())
+}
+
+func (i GroupSpecAwayPatchArgs) ToGroupSpecAwayPatchPtrOutputWithContext(ctx context.Context) GroupSpecAwayPatchPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GroupSpecAwayPatchOutput).ToGroupSpecAwayPatchPtrOutputWithContext(ctx)
+}
+
+// GroupSpecAwayPatchPtrInput is an input type that accepts GroupSpecAwayPatchArgs, GroupSpecAwayPatchPtr and GroupSpecAwayPatchPtrOutput values.
+// You can construct a concrete instance of `GroupSpecAwayPatchPtrInput` via:
+//
+//	        GroupSpecAwayPatchArgs{...}
+//
+//	or:
+//
+//	        nil
+type GroupSpecAwayPatchPtrInput interface {
+	pulumi.Input
+
+	ToGroupSpecAwayPatchPtrOutput() GroupSpecAwayPatchPtrOutput
+	ToGroupSpecAwayPatchPtrOutputWithContext(context.Context) GroupSpecAwayPatchPtrOutput
+}
+
+type groupSpecAwayPatchPtrType GroupSpecAwayPatchArgs
+
+func GroupSpecAwayPatchPtr(v *GroupSpecAwayPatchArgs) GroupSpecAwayPatchPtrInput {
+	return (*groupSpecAwayPatchPtrType)(v)
+}
+
+func (*groupSpecAwayPatchPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**GroupSpecAwayPatch)(nil)).Elem()
+}
+
+func (i *groupSpecAwayPatchPtrType) ToGroupSpecAwayPatchPtrOutput() GroupSpecAwayPatchPtrOutput {
+	return i.ToGroupSpecAwayPatchPtrOutputWithContext(context.Background())
+}
+
+func (i *groupSpecAwayPatchPtrType) ToGroupSpecAwayPatchPtrOutputWithContext(ctx context.Context) GroupSpecAwayPatchPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GroupSpecAwayPatchPtrOutput)
+}
+
+// Away, while Enabled, overrides ActiveScene with presence simulation:
+// every light in Spec.Lights is switched on and off together, replaying
+// a day drawn from Status.OnHistory with a random shift (see
+// internal/away.Replay). Only On is touched - brightness/color are left
+// at whatever ActiveScene last set. ActiveScene itself is left alone,
+// so disabling Away hands straight back to it on the next reconcile,
+// same as any other manual override being corrected. The group's
+// on/off state from just before Away was enabled (Status.PreAwayOn)
+// is restored first, so an ActiveScene that doesn't set On itself - a
+// CircadianSchedule with no On keyframes, or none at all - doesn't
+// leave the lights wherever the replay last put them.
+type GroupSpecAwayPatchOutput struct{ *pulumi.OutputState }
+
+func (GroupSpecAwayPatchOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GroupSpecAwayPatch)(nil)).Elem()
+}
+
+func (o GroupSpecAwayPatchOutput) ToGroupSpecAwayPatchOutput() GroupSpecAwayPatchOutput {
+	return o
+}
+
+func (o GroupSpecAwayPatchOutput) ToGroupSpecAwayPatchOutputWithContext(ctx context.Context) GroupSpecAwayPatchOutput {
+	return o
+}
+
+func (o GroupSpecAwayPatchOutput) ToGroupSpecAwayPatchPtrOutput() GroupSpecAwayPatchPtrOutput {
+	return o.ToGroupSpecAwayPatchPtrOutputWithContext(context.Background())
+}
+
+func (o GroupSpecAwayPatchOutput) ToGroupSpecAwayPatchPtrOutputWithContext(ctx context.Context) GroupSpecAwayPatchPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v GroupSpecAwayPatch) *GroupSpecAwayPatch {
+		return &v
+	}).(GroupSpecAwayPatchPtrOutput)
+}
+
+// Enabled turns presence simulation on. Toggled by the web UI's
+// GroupService.SetAway as well as by editing the Group directly.
+func (o GroupSpecAwayPatchOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v GroupSpecAwayPatch) *bool { return v.Enabled }).(pulumi.BoolPtrOutput)
+}
+
+// JitterMinutes bounds how far each day's replay is randomly shifted
+// from the recorded pattern it's drawn from, in either direction - so
+// the house doesn't switch on at exactly the same minute a watcher
+// could have seen a week ago. At least 1: with omitempty, an explicit
+// 0 would be indistinguishable from unset and defaulted anyway.
+func (o GroupSpecAwayPatchOutput) JitterMinutes() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v GroupSpecAwayPatch) *int { return v.JitterMinutes }).(pulumi.IntPtrOutput)
+}
+
+type GroupSpecAwayPatchPtrOutput struct{ *pulumi.OutputState }
+
+func (GroupSpecAwayPatchPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**GroupSpecAwayPatch)(nil)).Elem()
+}
+
+func (o GroupSpecAwayPatchPtrOutput) ToGroupSpecAwayPatchPtrOutput() GroupSpecAwayPatchPtrOutput {
+	return o
+}
+
+func (o GroupSpecAwayPatchPtrOutput) ToGroupSpecAwayPatchPtrOutputWithContext(ctx context.Context) GroupSpecAwayPatchPtrOutput {
+	return o
+}
+
+func (o GroupSpecAwayPatchPtrOutput) Elem() GroupSpecAwayPatchOutput {
+	return o.ApplyT(func(v *GroupSpecAwayPatch) GroupSpecAwayPatch {
+		if v != nil {
+			return *v
+		}
+		var ret GroupSpecAwayPatch
+		return ret
+	}).(GroupSpecAwayPatchOutput)
+}
+
+// Enabled turns presence simulation on. Toggled by the web UI's
+// GroupService.SetAway as well as by editing the Group directly.
+func (o GroupSpecAwayPatchPtrOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *GroupSpecAwayPatch) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Enabled
+	}).(pulumi.BoolPtrOutput)
+}
+
+// JitterMinutes bounds how far each day's replay is randomly shifted
+// from the recorded pattern it's drawn from, in either direction - so
+// the house doesn't switch on at exactly the same minute a watcher
+// could have seen a week ago. At least 1: with omitempty, an explicit
+// 0 would be indistinguishable from unset and defaulted anyway.
+func (o GroupSpecAwayPatchPtrOutput) JitterMinutes() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *GroupSpecAwayPatch) *int {
+		if v == nil {
+			return nil
+		}
+		return v.JitterMinutes
+	}).(pulumi.IntPtrOutput)
+}
+
 // GroupSpec is the user-declared list of Lights belonging to this group.
 type GroupSpecPatch struct {
 	ActiveScene *GroupSpecActiveScenePatch `pulumi:"activeScene"`
+	Away        *GroupSpecAwayPatch        `pulumi:"away"`
 	// Lights are the names of Light CRs that belong to this group.
 	Lights []string `pulumi:"lights"`
 }
@@ -2644,6 +4408,7 @@ type GroupSpecPatchInput interface {
 // GroupSpec is the user-declared list of Lights belonging to this group.
 type GroupSpecPatchArgs struct {
 	ActiveScene GroupSpecActiveScenePatchPtrInput `pulumi:"activeScene"`
+	Away        GroupSpecAwayPatchPtrInput        `pulumi:"away"`
 	// Lights are the names of Light CRs that belong to this group.
 	Lights pulumi.StringArrayInput `pulumi:"lights"`
 }
@@ -2730,6 +4495,10 @@ func (o GroupSpecPatchOutput) ActiveScene() GroupSpecActiveScenePatchPtrOutput {
 	return o.ApplyT(func(v GroupSpecPatch) *GroupSpecActiveScenePatch { return v.ActiveScene }).(GroupSpecActiveScenePatchPtrOutput)
 }
 
+func (o GroupSpecPatchOutput) Away() GroupSpecAwayPatchPtrOutput {
+	return o.ApplyT(func(v GroupSpecPatch) *GroupSpecAwayPatch { return v.Away }).(GroupSpecAwayPatchPtrOutput)
+}
+
 // Lights are the names of Light CRs that belong to this group.
 func (o GroupSpecPatchOutput) Lights() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v GroupSpecPatch) []string { return v.Lights }).(pulumi.StringArrayOutput)
@@ -2768,6 +4537,15 @@ func (o GroupSpecPatchPtrOutput) ActiveScene() GroupSpecActiveScenePatchPtrOutpu
 	}).(GroupSpecActiveScenePatchPtrOutput)
 }
 
+func (o GroupSpecPatchPtrOutput) Away() GroupSpecAwayPatchPtrOutput {
+	return o.ApplyT(func(v *GroupSpecPatch) *GroupSpecAwayPatch {
+		if v == nil {
+			return nil
+		}
+		return v.Away
+	}).(GroupSpecAwayPatchPtrOutput)
+}
+
 // Lights are the names of Light CRs that belong to this group.
 func (o GroupSpecPatchPtrOutput) Lights() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *GroupSpecPatch) []string {
@@ -2798,6 +4576,16 @@ type GroupStatus struct {
 	// MissingLights are entries in Spec.Lights that don't currently match
 	// any Light CR name.
 	MissingLights []string `pulumi:"missingLights"`
+	// OnHistory records when this group's lights went on and off (on
+	// meaning any reachable light in Spec.Lights is on), oldest first,
+	// covering the last week - the pattern Spec.Away replays. Sampled from
+	// each Light's Status on every reconcile, and not recorded at all while
+	// Away is enabled so the simulation never learns from itself.
+	OnHistory []GroupStatusOnHistory `pulumi:"onHistory"`
+	// PreAwayOn is the group's on/off state when Spec.Away was enabled,
+	// captured before the first replay and restored once Away is disabled
+	// again (see GroupSpec.Away). Nil whenever Away isn't in effect.
+	PreAwayOn *bool `pulumi:"preAwayOn"`
 }
 
 // GroupStatusInput is an input type that accepts GroupStatusArgs and GroupStatusOutput values.
@@ -2831,6 +4619,16 @@ type GroupStatusArgs struct {
 	// MissingLights are entries in Spec.Lights that don't currently match
 	// any Light CR name.
 	MissingLights pulumi.StringArrayInput `pulumi:"missingLights"`
+	// OnHistory records when this group's lights went on and off (on
+	// meaning any reachable light in Spec.Lights is on), oldest first,
+	// covering the last week - the pattern Spec.Away replays. Sampled from
+	// each Light's Status on every reconcile, and not recorded at all while
+	// Away is enabled so the simulation never learns from itself.
+	OnHistory GroupStatusOnHistoryArrayInput `pulumi:"onHistory"`
+	// PreAwayOn is the group's on/off state when Spec.Away was enabled,
+	// captured before the first replay and restored once Away is disabled
+	// again (see GroupSpec.Away). Nil whenever Away isn't in effect.
+	PreAwayOn pulumi.BoolPtrInput `pulumi:"preAwayOn"`
 }
 
 func (GroupStatusArgs) ElementType() reflect.Type {
@@ -2941,6 +4739,22 @@ func (o GroupStatusOutput) MissingLights() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v GroupStatus) []string { return v.MissingLights }).(pulumi.StringArrayOutput)
 }
 
+// OnHistory records when this group's lights went on and off (on
+// meaning any reachable light in Spec.Lights is on), oldest first,
+// covering the last week - the pattern Spec.Away replays. Sampled from
+// each Light's Status on every reconcile, and not recorded at all while
+// Away is enabled so the simulation never learns from itself.
+func (o GroupStatusOutput) OnHistory() GroupStatusOnHistoryArrayOutput {
+	return o.ApplyT(func(v GroupStatus) []GroupStatusOnHistory { return v.OnHistory }).(GroupStatusOnHistoryArrayOutput)
+}
+
+// PreAwayOn is the group's on/off state when Spec.Away was enabled,
+// captured before the first replay and restored once Away is disabled
+// again (see GroupSpec.Away). Nil whenever Away isn't in effect.
+func (o GroupStatusOutput) PreAwayOn() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v GroupStatus) *bool { return v.PreAwayOn }).(pulumi.BoolPtrOutput)
+}
+
 type GroupStatusPtrOutput struct{ *pulumi.OutputState }
 
 func (GroupStatusPtrOutput) ElementType() reflect.Type {
@@ -3014,6 +4828,256 @@ func (o GroupStatusPtrOutput) MissingLights() pulumi.StringArrayOutput {
 	}).(pulumi.StringArrayOutput)
 }
 
+// OnHistory records when this group's lights went on and off (on
+// meaning any reachable light in Spec.Lights is on), oldest first,
+// covering the last week - the pattern Spec.Away replays. Sampled from
+// each Light's Status on every reconcile, and not recorded at all while
+// Away is enabled so the simulation never learns from itself.
+func (o GroupStatusPtrOutput) OnHistory() GroupStatusOnHistoryArrayOutput {
+	return o.ApplyT(func(v *GroupStatus) []GroupStatusOnHistory {
+		if v == nil {
+			return nil
+		}
+		return v.OnHistory
+	}).(GroupStatusOnHistoryArrayOutput)
+}
+
+// PreAwayOn is the group's on/off state when Spec.Away was enabled,
+// captured before the first replay and restored once Away is disabled
+// again (see GroupSpec.Away). Nil whenever Away isn't in effect.
+func (o GroupStatusPtrOutput) PreAwayOn() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *GroupStatus) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.PreAwayOn
+	}).(pulumi.BoolPtrOutput)
+}
+
+// OnTransition is one recorded change of a Group's on/off state - see
+// GroupStatus.OnHistory.
+type GroupStatusOnHistory struct {
+	// At is when the change was observed.
+	At *string `pulumi:"at"`
+	// On is the group's state from At onwards.
+	On *bool `pulumi:"on"`
+}
+
+// GroupStatusOnHistoryInput is an input type that accepts GroupStatusOnHistoryArgs and GroupStatusOnHistoryOutput values.
+// You can construct a concrete instance of `GroupStatusOnHistoryInput` via:
+//
+//	GroupStatusOnHistoryArgs{...}
+type GroupStatusOnHistoryInput interface {
+	pulumi.Input
+
+	ToGroupStatusOnHistoryOutput() GroupStatusOnHistoryOutput
+	ToGroupStatusOnHistoryOutputWithContext(context.Context) GroupStatusOnHistoryOutput
+}
+
+// OnTransition is one recorded change of a Group's on/off state - see
+// GroupStatus.OnHistory.
+type GroupStatusOnHistoryArgs struct {
+	// At is when the change was observed.
+	At pulumi.StringPtrInput `pulumi:"at"`
+	// On is the group's state from At onwards.
+	On pulumi.BoolPtrInput `pulumi:"on"`
+}
+
+func (GroupStatusOnHistoryArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GroupStatusOnHistory)(nil)).Elem()
+}
+
+func (i GroupStatusOnHistoryArgs) ToGroupStatusOnHistoryOutput() GroupStatusOnHistoryOutput {
+	return i.ToGroupStatusOnHistoryOutputWithContext(context.Background())
+}
+
+func (i GroupStatusOnHistoryArgs) ToGroupStatusOnHistoryOutputWithContext(ctx context.Context) GroupStatusOnHistoryOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GroupStatusOnHistoryOutput)
+}
+
+// GroupStatusOnHistoryArrayInput is an input type that accepts GroupStatusOnHistoryArray and GroupStatusOnHistoryArrayOutput values.
+// You can construct a concrete instance of `GroupStatusOnHistoryArrayInput` via:
+//
+//	GroupStatusOnHistoryArray{ GroupStatusOnHistoryArgs{...} }
+type GroupStatusOnHistoryArrayInput interface {
+	pulumi.Input
+
+	ToGroupStatusOnHistoryArrayOutput() GroupStatusOnHistoryArrayOutput
+	ToGroupStatusOnHistoryArrayOutputWithContext(context.Context) GroupStatusOnHistoryArrayOutput
+}
+
+type GroupStatusOnHistoryArray []GroupStatusOnHistoryInput
+
+func (GroupStatusOnHistoryArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]GroupStatusOnHistory)(nil)).Elem()
+}
+
+func (i GroupStatusOnHistoryArray) ToGroupStatusOnHistoryArrayOutput() GroupStatusOnHistoryArrayOutput {
+	return i.ToGroupStatusOnHistoryArrayOutputWithContext(context.Background())
+}
+
+func (i GroupStatusOnHistoryArray) ToGroupStatusOnHistoryArrayOutputWithContext(ctx context.Context) GroupStatusOnHistoryArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GroupStatusOnHistoryArrayOutput)
+}
+
+// OnTransition is one recorded change of a Group's on/off state - see
+// GroupStatus.OnHistory.
+type GroupStatusOnHistoryOutput struct{ *pulumi.OutputState }
+
+func (GroupStatusOnHistoryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GroupStatusOnHistory)(nil)).Elem()
+}
+
+func (o GroupStatusOnHistoryOutput) ToGroupStatusOnHistoryOutput() GroupStatusOnHistoryOutput {
+	return o
+}
+
+func (o GroupStatusOnHistoryOutput) ToGroupStatusOnHistoryOutputWithContext(ctx context.Context) GroupStatusOnHistoryOutput {
+	return o
+}
+
+// At is when the change was observed.
+func (o GroupStatusOnHistoryOutput) At() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GroupStatusOnHistory) *string { return v.At }).(pulumi.StringPtrOutput)
+}
+
+// On is the group's state from At onwards.
+func (o GroupStatusOnHistoryOutput) On() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v GroupStatusOnHistory) *bool { return v.On }).(pulumi.BoolPtrOutput)
+}
+
+type GroupStatusOnHistoryArrayOutput struct{ *pulumi.OutputState }
+
+func (GroupStatusOnHistoryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]GroupStatusOnHistory)(nil)).Elem()
+}
+
+func (o GroupStatusOnHistoryArrayOutput) ToGroupStatusOnHistoryArrayOutput() GroupStatusOnHistoryArrayOutput {
+	return o
+}
+
+func (o GroupStatusOnHistoryArrayOutput) ToGroupStatusOnHistoryArrayOutputWithContext(ctx context.Context) GroupStatusOnHistoryArrayOutput {
+	return o
+}
+
+func (o GroupStatusOnHistoryArrayOutput) Index(i pulumi.IntInput) GroupStatusOnHistoryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) GroupStatusOnHistory {
+		return vs[0].([]GroupStatusOnHistory)[vs[1].(int)]
+	}).(GroupStatusOnHistoryOutput)
+}
+
+// OnTransition is one recorded change of a Group's on/off state - see
+// GroupStatus.OnHistory.
+type GroupStatusOnHistoryPatch struct {
+	// At is when the change was observed.
+	At *string `pulumi:"at"`
+	// On is the group's state from At onwards.
+	On *bool `pulumi:"on"`
+}
+
+// GroupStatusOnHistoryPatchInput is an input type that accepts GroupStatusOnHistoryPatchArgs and GroupStatusOnHistoryPatchOutput values.
+// You can construct a concrete instance of `GroupStatusOnHistoryPatchInput` via:
+//
+//	GroupStatusOnHistoryPatchArgs{...}
+type GroupStatusOnHistoryPatchInput interface {
+	pulumi.Input
+
+	ToGroupStatusOnHistoryPatchOutput() GroupStatusOnHistoryPatchOutput
+	ToGroupStatusOnHistoryPatchOutputWithContext(context.Context) GroupStatusOnHistoryPatchOutput
+}
+
+// OnTransition is one recorded change of a Group's on/off state - see
+// GroupStatus.OnHistory.
+type GroupStatusOnHistoryPatchArgs struct {
+	// At is when the change was observed.
+	At pulumi.StringPtrInput `pulumi:"at"`
+	// On is the group's state from At onwards.
+	On pulumi.BoolPtrInput `pulumi:"on"`
+}
+
+func (GroupStatusOnHistoryPatchArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GroupStatusOnHistoryPatch)(nil)).Elem()
+}
+
+func (i GroupStatusOnHistoryPatchArgs) ToGroupStatusOnHistoryPatchOutput() GroupStatusOnHistoryPatchOutput {
+	return i.ToGroupStatusOnHistoryPatchOutputWithContext(context.Background())
+}
+
+func (i GroupStatusOnHistoryPatchArgs) ToGroupStatusOnHistoryPatchOutputWithContext(ctx context.Context) GroupStatusOnHistoryPatchOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GroupStatusOnHistoryPatchOutput)
+}
+
+// GroupStatusOnHistoryPatchArrayInput is an input type that accepts GroupStatusOnHistoryPatchArray and GroupStatusOnHistoryPatchArrayOutput values.
+// You can construct a concrete instance of `GroupStatusOnHistoryPatchArrayInput` via:
+//
+//	GroupStatusOnHistoryPatchArray{ GroupStatusOnHistoryPatchArgs{...} }
+type GroupStatusOnHistoryPatchArrayInput interface {
+	pulumi.Input
+
+	ToGroupStatusOnHistoryPatchArrayOutput() GroupStatusOnHistoryPatchArrayOutput
+	ToGroupStatusOnHistoryPatchArrayOutputWithContext(context.Context) GroupStatusOnHistoryPatchArrayOutput
+}
+
+type GroupStatusOnHistoryPatchArray []GroupStatusOnHistoryPatchInput
+
+func (GroupStatusOnHistoryPatchArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]GroupStatusOnHistoryPatch)(nil)).Elem()
+}
+
+func (i GroupStatusOnHistoryPatchArray) ToGroupStatusOnHistoryPatchArrayOutput() GroupStatusOnHistoryPatchArrayOutput {
+	return i.ToGroupStatusOnHistoryPatchArrayOutputWithContext(context.Background())
+}
+
+func (i GroupStatusOnHistoryPatchArray) ToGroupStatusOnHistoryPatchArrayOutputWithContext(ctx context.Context) GroupStatusOnHistoryPatchArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GroupStatusOnHistoryPatchArrayOutput)
+}
+
+// OnTransition is one recorded change of a Group's on/off state - see
+// GroupStatus.OnHistory.
+type GroupStatusOnHistoryPatchOutput struct{ *pulumi.OutputState }
+
+func (GroupStatusOnHistoryPatchOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GroupStatusOnHistoryPatch)(nil)).Elem()
+}
+
+func (o GroupStatusOnHistoryPatchOutput) ToGroupStatusOnHistoryPatchOutput() GroupStatusOnHistoryPatchOutput {
+	return o
+}
+
+func (o GroupStatusOnHistoryPatchOutput) ToGroupStatusOnHistoryPatchOutputWithContext(ctx context.Context) GroupStatusOnHistoryPatchOutput {
+	return o
+}
+
+// At is when the change was observed.
+func (o GroupStatusOnHistoryPatchOutput) At() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GroupStatusOnHistoryPatch) *string { return v.At }).(pulumi.StringPtrOutput)
+}
+
+// On is the group's state from At onwards.
+func (o GroupStatusOnHistoryPatchOutput) On() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v GroupStatusOnHistoryPatch) *bool { return v.On }).(pulumi.BoolPtrOutput)
+}
+
+type GroupStatusOnHistoryPatchArrayOutput struct{ *pulumi.OutputState }
+
+func (GroupStatusOnHistoryPatchArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]GroupStatusOnHistoryPatch)(nil)).Elem()
+}
+
+func (o GroupStatusOnHistoryPatchArrayOutput) ToGroupStatusOnHistoryPatchArrayOutput() GroupStatusOnHistoryPatchArrayOutput {
+	return o
+}
+
+func (o GroupStatusOnHistoryPatchArrayOutput) ToGroupStatusOnHistoryPatchArrayOutputWithContext(ctx context.Context) GroupStatusOnHistoryPatchArrayOutput {
+	return o
+}
+
+func (o GroupStatusOnHistoryPatchArrayOutput) Index(i pulumi.IntInput) GroupStatusOnHistoryPatchOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) GroupStatusOnHistoryPatch {
+		return vs[0].([]GroupStatusOnHistoryPatch)[vs[1].(int)]
+	}).(GroupStatusOnHistoryPatchOutput)
+}
+
 // GroupStatus reports which of Spec.Lights don't currently resolve to a
 // Light CR - a typo'd or since-deleted reference would otherwise be silent.
 type GroupStatusPatch struct {
@@ -3034,6 +5098,16 @@ type GroupStatusPatch struct {
 	// MissingLights are entries in Spec.Lights that don't currently match
 	// any Light CR name.
 	MissingLights []string `pulumi:"missingLights"`
+	// OnHistory records when this group's lights went on and off (on
+	// meaning any reachable light in Spec.Lights is on), oldest first,
+	// covering the last week - the pattern Spec.Away replays. Sampled from
+	// each Light's Status on every reconcile, and not recorded at all while
+	// Away is enabled so the simulation never learns from itself.
+	OnHistory []GroupStatusOnHistoryPatch `pulumi:"onHistory"`
+	// PreAwayOn is the group's on/off state when Spec.Away was enabled,
+	// captured before the first replay and restored once Away is disabled
+	// again (see GroupSpec.Away). Nil whenever Away isn't in effect.
+	PreAwayOn *bool `pulumi:"preAwayOn"`
 }
 
 // GroupStatusPatchInput is an input type that accepts GroupStatusPatchArgs and GroupStatusPatchOutput values.
@@ -3067,6 +5141,16 @@ type GroupStatusPatchArgs struct {
 	// MissingLights are entries in Spec.Lights that don't currently match
 	// any Light CR name.
 	MissingLights pulumi.StringArrayInput `pulumi:"missingLights"`
+	// OnHistory records when this group's lights went on and off (on
+	// meaning any reachable light in Spec.Lights is on), oldest first,
+	// covering the last week - the pattern Spec.Away replays. Sampled from
+	// each Light's Status on every reconcile, and not recorded at all while
+	// Away is enabled so the simulation never learns from itself.
+	OnHistory GroupStatusOnHistoryPatchArrayInput `pulumi:"onHistory"`
+	// PreAwayOn is the group's on/off state when Spec.Away was enabled,
+	// captured before the first replay and restored once Away is disabled
+	// again (see GroupSpec.Away). Nil whenever Away isn't in effect.
+	PreAwayOn pulumi.BoolPtrInput `pulumi:"preAwayOn"`
 }
 
 func (GroupStatusPatchArgs) ElementType() reflect.Type {
@@ -3177,6 +5261,22 @@ func (o GroupStatusPatchOutput) MissingLights() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v GroupStatusPatch) []string { return v.MissingLights }).(pulumi.StringArrayOutput)
 }
 
+// OnHistory records when this group's lights went on and off (on
+// meaning any reachable light in Spec.Lights is on), oldest first,
+// covering the last week - the pattern Spec.Away replays. Sampled from
+// each Light's Status on every reconcile, and not recorded at all while
+// Away is enabled so the simulation never learns from itself.
+func (o GroupStatusPatchOutput) OnHistory() GroupStatusOnHistoryPatchArrayOutput {
+	return o.ApplyT(func(v GroupStatusPatch) []GroupStatusOnHistoryPatch { return v.OnHistory }).(GroupStatusOnHistoryPatchArrayOutput)
+}
+
+// PreAwayOn is the group's on/off state when Spec.Away was enabled,
+// captured before the first replay and restored once Away is disabled
+// again (see GroupSpec.Away). Nil whenever Away isn't in effect.
+func (o GroupStatusPatchOutput) PreAwayOn() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v GroupStatusPatch) *bool { return v.PreAwayOn }).(pulumi.BoolPtrOutput)
+}
+
 type GroupStatusPatchPtrOutput struct{ *pulumi.OutputState }
 
 func (GroupStatusPatchPtrOutput) ElementType() reflect.Type {
@@ -3250,6 +5350,32 @@ func (o GroupStatusPatchPtrOutput) MissingLights() pulumi.StringArrayOutput {
 	}).(pulumi.StringArrayOutput)
 }
 
+// OnHistory records when this group's lights went on and off (on
+// meaning any reachable light in Spec.Lights is on), oldest first,
+// covering the last week - the pattern Spec.Away replays. Sampled from
+// each Light's Status on every reconcile, and not recorded at all while
+// Away is enabled so the simulation never learns from itself.
+func (o GroupStatusPatchPtrOutput) OnHistory() GroupStatusOnHistoryPatchArrayOutput {
+	return o.ApplyT(func(v *GroupStatusPatch) []GroupStatusOnHistoryPatch {
+		if v == nil {
+			return nil
+		}
+		return v.OnHistory
+	}).(GroupStatusOnHistoryPatchArrayOutput)
+}
+
+// PreAwayOn is the group's on/off state when Spec.Away was enabled,
+// captured before the first replay and restored once Away is disabled
+// again (see GroupSpec.Away). Nil whenever Away isn't in effect.
+func (o GroupStatusPatchPtrOutput) PreAwayOn() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *GroupStatusPatch) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.PreAwayOn
+	}).(pulumi.BoolPtrOutput)
+}
+
 // HueBridge represents a single Hue bridge's live-resolved network
 // location, maintained by hub-controller (see
 // applications/lumenetes/cmd/hub-controller) so lumenetes-controller
@@ -5270,6 +7396,14 @@ type LightStatus struct {
 	Name *string `pulumi:"name"`
 	// On is the light's last-observed on/off state.
 	On *bool `pulumi:"on"`
+	// PendingChanges is every field where Spec differed from the observed
+	// state above as of the controller's last reconcile, or empty if they
+	// agreed. Recorded in every mode, but mostly useful under --dry-run,
+	// where it's the only record of what the controller would have pushed
+	// to the bridge - so turning dry-run off can be a checked decision
+	// rather than a leap of faith. Never recorded for a Reactive light
+	// (see LightSpec.Reactive), whose Spec isn't enacted at all.
+	PendingChanges []LightStatusPendingChanges `pulumi:"pendingChanges"`
 	// Product is the owning device's product name, e.g. "Hue color lamp".
 	Product *string `pulumi:"product"`
 	// Reachable is false when the owning bridge failed to respond on the
@@ -5331,6 +7465,14 @@ type LightStatusArgs struct {
 	Name pulumi.StringPtrInput `pulumi:"name"`
 	// On is the light's last-observed on/off state.
 	On pulumi.BoolPtrInput `pulumi:"on"`
+	// PendingChanges is every field where Spec differed from the observed
+	// state above as of the controller's last reconcile, or empty if they
+	// agreed. Recorded in every mode, but mostly useful under --dry-run,
+	// where it's the only record of what the controller would have pushed
+	// to the bridge - so turning dry-run off can be a checked decision
+	// rather than a leap of faith. Never recorded for a Reactive light
+	// (see LightSpec.Reactive), whose Spec isn't enacted at all.
+	PendingChanges LightStatusPendingChangesArrayInput `pulumi:"pendingChanges"`
 	// Product is the owning device's product name, e.g. "Hue color lamp".
 	Product pulumi.StringPtrInput `pulumi:"product"`
 	// Reachable is false when the owning bridge failed to respond on the
@@ -5493,6 +7635,17 @@ func (o LightStatusOutput) On() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v LightStatus) *bool { return v.On }).(pulumi.BoolPtrOutput)
 }
 
+// PendingChanges is every field where Spec differed from the observed
+// state above as of the controller's last reconcile, or empty if they
+// agreed. Recorded in every mode, but mostly useful under --dry-run,
+// where it's the only record of what the controller would have pushed
+// to the bridge - so turning dry-run off can be a checked decision
+// rather than a leap of faith. Never recorded for a Reactive light
+// (see LightSpec.Reactive), whose Spec isn't enacted at all.
+func (o LightStatusOutput) PendingChanges() LightStatusPendingChangesArrayOutput {
+	return o.ApplyT(func(v LightStatus) []LightStatusPendingChanges { return v.PendingChanges }).(LightStatusPendingChangesArrayOutput)
+}
+
 // Product is the owning device's product name, e.g. "Hue color lamp".
 func (o LightStatusOutput) Product() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v LightStatus) *string { return v.Product }).(pulumi.StringPtrOutput)
@@ -5664,6 +7817,22 @@ func (o LightStatusPtrOutput) On() pulumi.BoolPtrOutput {
 	}).(pulumi.BoolPtrOutput)
 }
 
+// PendingChanges is every field where Spec differed from the observed
+// state above as of the controller's last reconcile, or empty if they
+// agreed. Recorded in every mode, but mostly useful under --dry-run,
+// where it's the only record of what the controller would have pushed
+// to the bridge - so turning dry-run off can be a checked decision
+// rather than a leap of faith. Never recorded for a Reactive light
+// (see LightSpec.Reactive), whose Spec isn't enacted at all.
+func (o LightStatusPtrOutput) PendingChanges() LightStatusPendingChangesArrayOutput {
+	return o.ApplyT(func(v *LightStatus) []LightStatusPendingChanges {
+		if v == nil {
+			return nil
+		}
+		return v.PendingChanges
+	}).(LightStatusPendingChangesArrayOutput)
+}
+
 // Product is the owning device's product name, e.g. "Hue color lamp".
 func (o LightStatusPtrOutput) Product() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *LightStatus) *string {
@@ -5728,6 +7897,14 @@ type LightStatusPatch struct {
 	Name *string `pulumi:"name"`
 	// On is the light's last-observed on/off state.
 	On *bool `pulumi:"on"`
+	// PendingChanges is every field where Spec differed from the observed
+	// state above as of the controller's last reconcile, or empty if they
+	// agreed. Recorded in every mode, but mostly useful under --dry-run,
+	// where it's the only record of what the controller would have pushed
+	// to the bridge - so turning dry-run off can be a checked decision
+	// rather than a leap of faith. Never recorded for a Reactive light
+	// (see LightSpec.Reactive), whose Spec isn't enacted at all.
+	PendingChanges []LightStatusPendingChangesPatch `pulumi:"pendingChanges"`
 	// Product is the owning device's product name, e.g. "Hue color lamp".
 	Product *string `pulumi:"product"`
 	// Reachable is false when the owning bridge failed to respond on the
@@ -5789,6 +7966,14 @@ type LightStatusPatchArgs struct {
 	Name pulumi.StringPtrInput `pulumi:"name"`
 	// On is the light's last-observed on/off state.
 	On pulumi.BoolPtrInput `pulumi:"on"`
+	// PendingChanges is every field where Spec differed from the observed
+	// state above as of the controller's last reconcile, or empty if they
+	// agreed. Recorded in every mode, but mostly useful under --dry-run,
+	// where it's the only record of what the controller would have pushed
+	// to the bridge - so turning dry-run off can be a checked decision
+	// rather than a leap of faith. Never recorded for a Reactive light
+	// (see LightSpec.Reactive), whose Spec isn't enacted at all.
+	PendingChanges LightStatusPendingChangesPatchArrayInput `pulumi:"pendingChanges"`
 	// Product is the owning device's product name, e.g. "Hue color lamp".
 	Product pulumi.StringPtrInput `pulumi:"product"`
 	// Reachable is false when the owning bridge failed to respond on the
@@ -5951,6 +8136,17 @@ func (o LightStatusPatchOutput) On() pulumi.BoolPtrOutput {
 	return o.ApplyT(func(v LightStatusPatch) *bool { return v.On }).(pulumi.BoolPtrOutput)
 }
 
+// PendingChanges is every field where Spec differed from the observed
+// state above as of the controller's last reconcile, or empty if they
+// agreed. Recorded in every mode, but mostly useful under --dry-run,
+// where it's the only record of what the controller would have pushed
+// to the bridge - so turning dry-run off can be a checked decision
+// rather than a leap of faith. Never recorded for a Reactive light
+// (see LightSpec.Reactive), whose Spec isn't enacted at all.
+func (o LightStatusPatchOutput) PendingChanges() LightStatusPendingChangesPatchArrayOutput {
+	return o.ApplyT(func(v LightStatusPatch) []LightStatusPendingChangesPatch { return v.PendingChanges }).(LightStatusPendingChangesPatchArrayOutput)
+}
+
 // Product is the owning device's product name, e.g. "Hue color lamp".
 func (o LightStatusPatchOutput) Product() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v LightStatusPatch) *string { return v.Product }).(pulumi.StringPtrOutput)
@@ -6122,6 +8318,22 @@ func (o LightStatusPatchPtrOutput) On() pulumi.BoolPtrOutput {
 	}).(pulumi.BoolPtrOutput)
 }
 
+// PendingChanges is every field where Spec differed from the observed
+// state above as of the controller's last reconcile, or empty if they
+// agreed. Recorded in every mode, but mostly useful under --dry-run,
+// where it's the only record of what the controller would have pushed
+// to the bridge - so turning dry-run off can be a checked decision
+// rather than a leap of faith. Never recorded for a Reactive light
+// (see LightSpec.Reactive), whose Spec isn't enacted at all.
+func (o LightStatusPatchPtrOutput) PendingChanges() LightStatusPendingChangesPatchArrayOutput {
+	return o.ApplyT(func(v *LightStatusPatch) []LightStatusPendingChangesPatch {
+		if v == nil {
+			return nil
+		}
+		return v.PendingChanges
+	}).(LightStatusPendingChangesPatchArrayOutput)
+}
+
 // Product is the owning device's product name, e.g. "Hue color lamp".
 func (o LightStatusPatchPtrOutput) Product() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *LightStatusPatch) *string {
@@ -6144,6 +8356,254 @@ func (o LightStatusPatchPtrOutput) Reachable() pulumi.BoolPtrOutput {
 	}).(pulumi.BoolPtrOutput)
 }
 
+// PendingChange is one field where a Light's Spec (desired) differs from
+// its Status (observed). Want/Have are rendered as strings rather than
+// typed values, since the field they describe varies from entry to entry.
+type LightStatusPendingChanges struct {
+	// Field is the LightSpec field's JSON name, e.g. "brightness".
+	Field *string `pulumi:"field"`
+	// Have is Status's value for Field.
+	Have *string `pulumi:"have"`
+	// Want is Spec's value for Field.
+	Want *string `pulumi:"want"`
+}
+
+// LightStatusPendingChangesInput is an input type that accepts LightStatusPendingChangesArgs and LightStatusPendingChangesOutput values.
+// You can construct a concrete instance of `LightStatusPendingChangesInput` via:
+//
+//	LightStatusPendingChangesArgs{...}
+type LightStatusPendingChangesInput interface {
+	pulumi.Input
+
+	ToLightStatusPendingChangesOutput() LightStatusPendingChangesOutput
+	ToLightStatusPendingChangesOutputWithContext(context.Context) LightStatusPendingChangesOutput
+}
+
+// PendingChange is one field where a Light's Spec (desired) differs from
+// its Status (observed). Want/Have are rendered as strings rather than
+// typed values, since the field they describe varies from entry to entry.
+type LightStatusPendingChangesArgs struct {
+	// Field is the LightSpec field's JSON name, e.g. "brightness".
+	Field pulumi.StringPtrInput `pulumi:"field"`
+	// Have is Status's value for Field.
+	Have pulumi.StringPtrInput `pulumi:"have"`
+	// Want is Spec's value for Field.
+	Want pulumi.StringPtrInput `pulumi:"want"`
+}
+
+func (LightStatusPendingChangesArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LightStatusPendingChanges)(nil)).Elem()
+}
+
+func (i LightStatusPendingChangesArgs) ToLightStatusPendingChangesOutput() LightStatusPendingChangesOutput {
+	return i.ToLightStatusPendingChangesOutputWithContext(context.Background())
+}
+
+func (i LightStatusPendingChangesArgs) ToLightStatusPendingChangesOutputWithContext(ctx context.Context) LightStatusPendingChangesOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LightStatusPendingChangesOutput)
+}
+
+// LightStatusPendingChangesArrayInput is an input type that accepts LightStatusPendingChangesArray and LightStatusPendingChangesArrayOutput values.
+// You can construct a concrete instance of `LightStatusPendingChangesArrayInput` via:
+//
+//	LightStatusPendingChangesArray{ LightStatusPendingChangesArgs{...} }
+type LightStatusPendingChangesArrayInput interface {
+	pulumi.Input
+
+	ToLightStatusPendingChangesArrayOutput() LightStatusPendingChangesArrayOutput
+	ToLightStatusPendingChangesArrayOutputWithContext(context.Context) LightStatusPendingChangesArrayOutput
+}
+
+type LightStatusPendingChangesArray []LightStatusPendingChangesInput
+
+func (LightStatusPendingChangesArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]LightStatusPendingChanges)(nil)).Elem()
+}
+
+func (i LightStatusPendingChangesArray) ToLightStatusPendingChangesArrayOutput() LightStatusPendingChangesArrayOutput {
+	return i.ToLightStatusPendingChangesArrayOutputWithContext(context.Background())
+}
+
+func (i LightStatusPendingChangesArray) ToLightStatusPendingChangesArrayOutputWithContext(ctx context.Context) LightStatusPendingChangesArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LightStatusPendingChangesArrayOutput)
+}
+
+// PendingChange is one field where a Light's Spec (desired) differs from
+// its Status (observed). Want/Have are rendered as strings rather than
+// typed values, since the field they describe varies from entry to entry.
+type LightStatusPendingChangesOutput struct{ *pulumi.OutputState }
+
+func (LightStatusPendingChangesOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LightStatusPendingChanges)(nil)).Elem()
+}
+
+func (o LightStatusPendingChangesOutput) ToLightStatusPendingChangesOutput() LightStatusPendingChangesOutput {
+	return o
+}
+
+func (o LightStatusPendingChangesOutput) ToLightStatusPendingChangesOutputWithContext(ctx context.Context) LightStatusPendingChangesOutput {
+	return o
+}
+
+// Field is the LightSpec field's JSON name, e.g. "brightness".
+func (o LightStatusPendingChangesOutput) Field() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LightStatusPendingChanges) *string { return v.Field }).(pulumi.StringPtrOutput)
+}
+
+// Have is Status's value for Field.
+func (o LightStatusPendingChangesOutput) Have() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LightStatusPendingChanges) *string { return v.Have }).(pulumi.StringPtrOutput)
+}
+
+// Want is Spec's value for Field.
+func (o LightStatusPendingChangesOutput) Want() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LightStatusPendingChanges) *string { return v.Want }).(pulumi.StringPtrOutput)
+}
+
+type LightStatusPendingChangesArrayOutput struct{ *pulumi.OutputState }
+
+func (LightStatusPendingChangesArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]LightStatusPendingChanges)(nil)).Elem()
+}
+
+func (o LightStatusPendingChangesArrayOutput) ToLightStatusPendingChangesArrayOutput() LightStatusPendingChangesArrayOutput {
+	return o
+}
+
+func (o LightStatusPendingChangesArrayOutput) ToLightStatusPendingChangesArrayOutputWithContext(ctx context.Context) LightStatusPendingChangesArrayOutput {
+	return o
+}
+
+func (o LightStatusPendingChangesArrayOutput) Index(i pulumi.IntInput) LightStatusPendingChangesOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) LightStatusPendingChanges {
+		return vs[0].([]LightStatusPendingChanges)[vs[1].(int)]
+	}).(LightStatusPendingChangesOutput)
+}
+
+// PendingChange is one field where a Light's Spec (desired) differs from
+// its Status (observed). Want/Have are rendered as strings rather than
+// typed values, since the field they describe varies from entry to entry.
+type LightStatusPendingChangesPatch struct {
+	// Field is the LightSpec field's JSON name, e.g. "brightness".
+	Field *string `pulumi:"field"`
+	// Have is Status's value for Field.
+	Have *string `pulumi:"have"`
+	// Want is Spec's value for Field.
+	Want *string `pulumi:"want"`
+}
+
+// LightStatusPendingChangesPatchInput is an input type that accepts LightStatusPendingChangesPatchArgs and LightStatusPendingChangesPatchOutput values.
+// You can construct a concrete instance of `LightStatusPendingChangesPatchInput` via:
+//
+//	LightStatusPendingChangesPatchArgs{...}
+type LightStatusPendingChangesPatchInput interface {
+	pulumi.Input
+
+	ToLightStatusPendingChangesPatchOutput() LightStatusPendingChangesPatchOutput
+	ToLightStatusPendingChangesPatchOutputWithContext(context.Context) LightStatusPendingChangesPatchOutput
+}
+
+// PendingChange is one field where a Light's Spec (desired) differs from
+// its Status (observed). Want/Have are rendered as strings rather than
+// typed values, since the field they describe varies from entry to entry.
+type LightStatusPendingChangesPatchArgs struct {
+	// Field is the LightSpec field's JSON name, e.g. "brightness".
+	Field pulumi.StringPtrInput `pulumi:"field"`
+	// Have is Status's value for Field.
+	Have pulumi.StringPtrInput `pulumi:"have"`
+	// Want is Spec's value for Field.
+	Want pulumi.StringPtrInput `pulumi:"want"`
+}
+
+func (LightStatusPendingChangesPatchArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LightStatusPendingChangesPatch)(nil)).Elem()
+}
+
+func (i LightStatusPendingChangesPatchArgs) ToLightStatusPendingChangesPatchOutput() LightStatusPendingChangesPatchOutput {
+	return i.ToLightStatusPendingChangesPatchOutputWithContext(context.Background())
+}
+
+func (i LightStatusPendingChangesPatchArgs) ToLightStatusPendingChangesPatchOutputWithContext(ctx context.Context) LightStatusPendingChangesPatchOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LightStatusPendingChangesPatchOutput)
+}
+
+// LightStatusPendingChangesPatchArrayInput is an input type that accepts LightStatusPendingChangesPatchArray and LightStatusPendingChangesPatchArrayOutput values.
+// You can construct a concrete instance of `LightStatusPendingChangesPatchArrayInput` via:
+//
+//	LightStatusPendingChangesPatchArray{ LightStatusPendingChangesPatchArgs{...} }
+type LightStatusPendingChangesPatchArrayInput interface {
+	pulumi.Input
+
+	ToLightStatusPendingChangesPatchArrayOutput() LightStatusPendingChangesPatchArrayOutput
+	ToLightStatusPendingChangesPatchArrayOutputWithContext(context.Context) LightStatusPendingChangesPatchArrayOutput
+}
+
+type LightStatusPendingChangesPatchArray []LightStatusPendingChangesPatchInput
+
+func (LightStatusPendingChangesPatchArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]LightStatusPendingChangesPatch)(nil)).Elem()
+}
+
+func (i LightStatusPendingChangesPatchArray) ToLightStatusPendingChangesPatchArrayOutput() LightStatusPendingChangesPatchArrayOutput {
+	return i.ToLightStatusPendingChangesPatchArrayOutputWithContext(context.Background())
+}
+
+func (i LightStatusPendingChangesPatchArray) ToLightStatusPendingChangesPatchArrayOutputWithContext(ctx context.Context) LightStatusPendingChangesPatchArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(LightStatusPendingChangesPatchArrayOutput)
+}
+
+// PendingChange is one field where a Light's Spec (desired) differs from
+// its Status (observed). Want/Have are rendered as strings rather than
+// typed values, since the field they describe varies from entry to entry.
+type LightStatusPendingChangesPatchOutput struct{ *pulumi.OutputState }
+
+func (LightStatusPendingChangesPatchOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LightStatusPendingChangesPatch)(nil)).Elem()
+}
+
+func (o LightStatusPendingChangesPatchOutput) ToLightStatusPendingChangesPatchOutput() LightStatusPendingChangesPatchOutput {
+	return o
+}
+
+func (o LightStatusPendingChangesPatchOutput) ToLightStatusPendingChangesPatchOutputWithContext(ctx context.Context) LightStatusPendingChangesPatchOutput {
+	return o
+}
+
+// Field is the LightSpec field's JSON name, e.g. "brightness".
+func (o LightStatusPendingChangesPatchOutput) Field() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LightStatusPendingChangesPatch) *string { return v.Field }).(pulumi.StringPtrOutput)
+}
+
+// Have is Status's value for Field.
+func (o LightStatusPendingChangesPatchOutput) Have() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LightStatusPendingChangesPatch) *string { return v.Have }).(pulumi.StringPtrOutput)
+}
+
+// Want is Spec's value for Field.
+func (o LightStatusPendingChangesPatchOutput) Want() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LightStatusPendingChangesPatch) *string { return v.Want }).(pulumi.StringPtrOutput)
+}
+
+type LightStatusPendingChangesPatchArrayOutput struct{ *pulumi.OutputState }
+
+func (LightStatusPendingChangesPatchArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]LightStatusPendingChangesPatch)(nil)).Elem()
+}
+
+func (o LightStatusPendingChangesPatchArrayOutput) ToLightStatusPendingChangesPatchArrayOutput() LightStatusPendingChangesPatchArrayOutput {
+	return o
+}
+
+func (o LightStatusPendingChangesPatchArrayOutput) ToLightStatusPendingChangesPatchArrayOutputWithContext(ctx context.Context) LightStatusPendingChangesPatchArrayOutput {
+	return o
+}
+
+func (o LightStatusPendingChangesPatchArrayOutput) Index(i pulumi.IntInput) LightStatusPendingChangesPatchOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) LightStatusPendingChangesPatch {
+		return vs[0].([]LightStatusPendingChangesPatch)[vs[1].(int)]
+	}).(LightStatusPendingChangesPatchOutput)
+}
+
 // Scene is a user-named, recallable lighting state for some or all of a
 // Group's lights. Cluster scoped, user-chosen name (e.g. "movie-night"),
 // same reasoning as Group - a Scene has no Hue-side identity of its own.
@@ -9659,12 +12119,24 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*CircadianSchedulePatchTypeInput)(nil)).Elem(), CircadianSchedulePatchTypeArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecInput)(nil)).Elem(), CircadianScheduleSpecArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecPtrInput)(nil)).Elem(), CircadianScheduleSpecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecElevationInput)(nil)).Elem(), CircadianScheduleSpecElevationArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecElevationArrayInput)(nil)).Elem(), CircadianScheduleSpecElevationArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecElevationPatchInput)(nil)).Elem(), CircadianScheduleSpecElevationPatchArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecElevationPatchArrayInput)(nil)).Elem(), CircadianScheduleSpecElevationPatchArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecKeyframesInput)(nil)).Elem(), CircadianScheduleSpecKeyframesArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecKeyframesArrayInput)(nil)).Elem(), CircadianScheduleSpecKeyframesArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecKeyframesPatchInput)(nil)).Elem(), CircadianScheduleSpecKeyframesPatchArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecKeyframesPatchArrayInput)(nil)).Elem(), CircadianScheduleSpecKeyframesPatchArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecPatchInput)(nil)).Elem(), CircadianScheduleSpecPatchArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecPatchPtrInput)(nil)).Elem(), CircadianScheduleSpecPatchArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecVariantsInput)(nil)).Elem(), CircadianScheduleSpecVariantsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecVariantsArrayInput)(nil)).Elem(), CircadianScheduleSpecVariantsArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecVariantsKeyframesInput)(nil)).Elem(), CircadianScheduleSpecVariantsKeyframesArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecVariantsKeyframesArrayInput)(nil)).Elem(), CircadianScheduleSpecVariantsKeyframesArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecVariantsKeyframesPatchInput)(nil)).Elem(), CircadianScheduleSpecVariantsKeyframesPatchArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecVariantsKeyframesPatchArrayInput)(nil)).Elem(), CircadianScheduleSpecVariantsKeyframesPatchArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecVariantsPatchInput)(nil)).Elem(), CircadianScheduleSpecVariantsPatchArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleSpecVariantsPatchArrayInput)(nil)).Elem(), CircadianScheduleSpecVariantsPatchArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleStatusInput)(nil)).Elem(), CircadianScheduleStatusArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleStatusPtrInput)(nil)).Elem(), CircadianScheduleStatusArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*CircadianScheduleStatusPatchInput)(nil)).Elem(), CircadianScheduleStatusPatchArgs{})
@@ -9679,10 +12151,18 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*GroupSpecActiveScenePtrInput)(nil)).Elem(), GroupSpecActiveSceneArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*GroupSpecActiveScenePatchInput)(nil)).Elem(), GroupSpecActiveScenePatchArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*GroupSpecActiveScenePatchPtrInput)(nil)).Elem(), GroupSpecActiveScenePatchArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*GroupSpecAwayInput)(nil)).Elem(), GroupSpecAwayArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*GroupSpecAwayPtrInput)(nil)).Elem(), GroupSpecAwayArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*GroupSpecAwayPatchInput)(nil)).Elem(), GroupSpecAwayPatchArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*GroupSpecAwayPatchPtrInput)(nil)).Elem(), GroupSpecAwayPatchArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*GroupSpecPatchInput)(nil)).Elem(), GroupSpecPatchArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*GroupSpecPatchPtrInput)(nil)).Elem(), GroupSpecPatchArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*GroupStatusInput)(nil)).Elem(), GroupStatusArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*GroupStatusPtrInput)(nil)).Elem(), GroupStatusArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*GroupStatusOnHistoryInput)(nil)).Elem(), GroupStatusOnHistoryArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*GroupStatusOnHistoryArrayInput)(nil)).Elem(), GroupStatusOnHistoryArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*GroupStatusOnHistoryPatchInput)(nil)).Elem(), GroupStatusOnHistoryPatchArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*GroupStatusOnHistoryPatchArrayInput)(nil)).Elem(), GroupStatusOnHistoryPatchArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*GroupStatusPatchInput)(nil)).Elem(), GroupStatusPatchArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*GroupStatusPatchPtrInput)(nil)).Elem(), GroupStatusPatchArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*HueBridgeTypeInput)(nil)).Elem(), HueBridgeTypeArgs{})
@@ -9705,6 +12185,10 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*LightStatusPtrInput)(nil)).Elem(), LightStatusArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*LightStatusPatchInput)(nil)).Elem(), LightStatusPatchArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*LightStatusPatchPtrInput)(nil)).Elem(), LightStatusPatchArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LightStatusPendingChangesInput)(nil)).Elem(), LightStatusPendingChangesArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LightStatusPendingChangesArrayInput)(nil)).Elem(), LightStatusPendingChangesArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LightStatusPendingChangesPatchInput)(nil)).Elem(), LightStatusPendingChangesPatchArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*LightStatusPendingChangesPatchArrayInput)(nil)).Elem(), LightStatusPendingChangesPatchArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*SceneTypeInput)(nil)).Elem(), SceneTypeArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*SceneTypeArrayInput)(nil)).Elem(), SceneTypeArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*SceneListTypeInput)(nil)).Elem(), SceneListTypeArgs{})
@@ -9747,12 +12231,24 @@ func init() {
 	pulumi.RegisterOutputType(CircadianSchedulePatchTypeOutput{})
 	pulumi.RegisterOutputType(CircadianScheduleSpecOutput{})
 	pulumi.RegisterOutputType(CircadianScheduleSpecPtrOutput{})
+	pulumi.RegisterOutputType(CircadianScheduleSpecElevationOutput{})
+	pulumi.RegisterOutputType(CircadianScheduleSpecElevationArrayOutput{})
+	pulumi.RegisterOutputType(CircadianScheduleSpecElevationPatchOutput{})
+	pulumi.RegisterOutputType(CircadianScheduleSpecElevationPatchArrayOutput{})
 	pulumi.RegisterOutputType(CircadianScheduleSpecKeyframesOutput{})
 	pulumi.RegisterOutputType(CircadianScheduleSpecKeyframesArrayOutput{})
 	pulumi.RegisterOutputType(CircadianScheduleSpecKeyframesPatchOutput{})
 	pulumi.RegisterOutputType(CircadianScheduleSpecKeyframesPatchArrayOutput{})
 	pulumi.RegisterOutputType(CircadianScheduleSpecPatchOutput{})
 	pulumi.RegisterOutputType(CircadianScheduleSpecPatchPtrOutput{})
+	pulumi.RegisterOutputType(CircadianScheduleSpecVariantsOutput{})
+	pulumi.RegisterOutputType(CircadianScheduleSpecVariantsArrayOutput{})
+	pulumi.RegisterOutputType(CircadianScheduleSpecVariantsKeyframesOutput{})
+	pulumi.RegisterOutputType(CircadianScheduleSpecVariantsKeyframesArrayOutput{})
+	pulumi.RegisterOutputType(CircadianScheduleSpecVariantsKeyframesPatchOutput{})
+	pulumi.RegisterOutputType(CircadianScheduleSpecVariantsKeyframesPatchArrayOutput{})
+	pulumi.RegisterOutputType(CircadianScheduleSpecVariantsPatchOutput{})
+	pulumi.RegisterOutputType(CircadianScheduleSpecVariantsPatchArrayOutput{})
 	pulumi.RegisterOutputType(CircadianScheduleStatusOutput{})
 	pulumi.RegisterOutputType(CircadianScheduleStatusPtrOutput{})
 	pulumi.RegisterOutputType(CircadianScheduleStatusPatchOutput{})
@@ -9767,10 +12263,18 @@ func init() {
 	pulumi.RegisterOutputType(GroupSpecActiveScenePtrOutput{})
 	pulumi.RegisterOutputType(GroupSpecActiveScenePatchOutput{})
 	pulumi.RegisterOutputType(GroupSpecActiveScenePatchPtrOutput{})
+	pulumi.RegisterOutputType(GroupSpecAwayOutput{})
+	pulumi.RegisterOutputType(GroupSpecAwayPtrOutput{})
+	pulumi.RegisterOutputType(GroupSpecAwayPatchOutput{})
+	pulumi.RegisterOutputType(GroupSpecAwayPatchPtrOutput{})
 	pulumi.RegisterOutputType(GroupSpecPatchOutput{})
 	pulumi.RegisterOutputType(GroupSpecPatchPtrOutput{})
 	pulumi.RegisterOutputType(GroupStatusOutput{})
 	pulumi.RegisterOutputType(GroupStatusPtrOutput{})
+	pulumi.RegisterOutputType(GroupStatusOnHistoryOutput{})
+	pulumi.RegisterOutputType(GroupStatusOnHistoryArrayOutput{})
+	pulumi.RegisterOutputType(GroupStatusOnHistoryPatchOutput{})
+	pulumi.RegisterOutputType(GroupStatusOnHistoryPatchArrayOutput{})
 	pulumi.RegisterOutputType(GroupStatusPatchOutput{})
 	pulumi.RegisterOutputType(GroupStatusPatchPtrOutput{})
 	pulumi.RegisterOutputType(HueBridgeTypeOutput{})
@@ -9793,6 +12297,10 @@ func init() {
 	pulumi.RegisterOutputType(LightStatusPtrOutput{})
 	pulumi.RegisterOutputType(LightStatusPatchOutput{})
 	pulumi.RegisterOutputType(LightStatusPatchPtrOutput{})
+	pulumi.RegisterOutputType(LightStatusPendingChangesOutput{})
+	pulumi.RegisterOutputType(LightStatusPendingChangesArrayOutput{})
+	pulumi.RegisterOutputType(LightStatusPendingChangesPatchOutput{})
+	pulumi.RegisterOutputType(LightStatusPendingChangesPatchArrayOutput{})
 	pulumi.RegisterOutputType(SceneTypeOutput{})
 	pulumi.RegisterOutputType(SceneTypeArrayOutput{})
 	pulumi.RegisterOutputType(SceneListTypeOutput{})
@@ -390,6 +390,7 @@ func Program(kubeconfig string, infraCfg *infraconfig.InfraConfig) pulumi.RunFun
 			KubeStateMetricsVersion:   versions.KubeStateMetrics,
 			AlertmanagerVersion:       versions.Alertmanager,
 			KubeRBACProxyVersion:      versions.KubeRBACProxy,
+			BusyboxVersion:            versions.Busybox,
 			StorageClassName:          storage.DefaultStorageClass,
 			// Data retention strategy: a size cap alongside the time-based
 			// one, so Prometheus proactively compacts away old blocks the
@@ -0,0 +1,431 @@
+package k3s
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// APIClient is a minimal Kubernetes API client over plain net/http,
+// authenticated with the client certificate embedded in a kubeconfig as
+// produced by ExtractKubeconfig - just the handful of node operations this
+// CLI needs (cordon, drain, readiness), rather than pulling client-go into
+// this module for them.
+type APIClient struct {
+	server string
+	http   *http.Client
+}
+
+// APIError is a non-2xx response from the API server.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("kubernetes API returned %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is a 404 from the API server.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// NewAPIClient returns an APIClient for kubeconfig's first cluster and
+// user.
+func NewAPIClient(kubeconfig string) (*APIClient, error) {
+	return newAPIClient(kubeconfig, 30*time.Second)
+}
+
+func newAPIClient(kubeconfig string, timeout time.Duration) (*APIClient, error) {
+	var cfg struct {
+		Clusters []struct {
+			Cluster struct {
+				Server                   string `yaml:"server"`
+				CertificateAuthorityData string `yaml:"certificate-authority-data"`
+			} `yaml:"cluster"`
+		} `yaml:"clusters"`
+		Users []struct {
+			User struct {
+				ClientCertificateData string `yaml:"client-certificate-data"`
+				ClientKeyData         string `yaml:"client-key-data"`
+			} `yaml:"user"`
+		} `yaml:"users"`
+	}
+
+	if err := yaml.Unmarshal([]byte(kubeconfig), &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+	if len(cfg.Clusters) == 0 || len(cfg.Users) == 0 {
+		return nil, fmt.Errorf("kubeconfig has no clusters or users")
+	}
+
+	caData, err := base64.StdEncoding.DecodeString(cfg.Clusters[0].Cluster.CertificateAuthorityData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode certificate-authority-data: %w", err)
+	}
+	certData, err := base64.StdEncoding.DecodeString(cfg.Users[0].User.ClientCertificateData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode client-certificate-data: %w", err)
+	}
+	keyData, err := base64.StdEncoding.DecodeString(cfg.Users[0].User.ClientKeyData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode client-key-data: %w", err)
+	}
+
+	cert, err := tls.X509KeyPair(certData, keyData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client certificate: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caData) {
+		return nil, fmt.Errorf("kubeconfig's certificate-authority-data has no usable certificates")
+	}
+
+	return &APIClient{
+		server: cfg.Clusters[0].Cluster.Server,
+		http: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					RootCAs:      pool,
+					Certificates: []tls.Certificate{cert},
+				},
+			},
+		},
+	}, nil
+}
+
+// do sends a request to path on the API server, JSON-encoding body (if
+// non-nil) with contentType, and JSON-decoding a 2xx response into out (if
+// non-nil). Any other status is returned as an *APIError.
+func (c *APIClient) do(ctx context.Context, method, path, contentType string, body, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.server+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var status struct {
+			Message string `json:"message"`
+		}
+		data, _ := io.ReadAll(resp.Body)
+		if json.Unmarshal(data, &status) != nil || status.Message == "" {
+			status.Message = string(data)
+		}
+		return &APIError{StatusCode: resp.StatusCode, Message: status.Message}
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// Livez reports whether the API server's /livez endpoint responds OK.
+func (c *APIClient) Livez(ctx context.Context) bool {
+	return c.do(ctx, http.MethodGet, "/livez", "", nil, nil) == nil
+}
+
+// Node is the subset of a Kubernetes Node object this package reads.
+type Node struct {
+	Name           string
+	Unschedulable  bool
+	Ready          bool
+	KubeletVersion string
+	Annotations    map[string]string
+}
+
+// GetNode returns the named Node.
+func (c *APIClient) GetNode(ctx context.Context, name string) (*Node, error) {
+	var obj struct {
+		Metadata struct {
+			Name        string            `json:"name"`
+			Annotations map[string]string `json:"annotations"`
+		} `json:"metadata"`
+		Spec struct {
+			Unschedulable bool `json:"unschedulable"`
+		} `json:"spec"`
+		Status struct {
+			Conditions []struct {
+				Type   string `json:"type"`
+				Status string `json:"status"`
+			} `json:"conditions"`
+			NodeInfo struct {
+				KubeletVersion string `json:"kubeletVersion"`
+			} `json:"nodeInfo"`
+		} `json:"status"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/nodes/"+url.PathEscape(name), "", nil, &obj); err != nil {
+		return nil, fmt.Errorf("failed to get node %s: %w", name, err)
+	}
+
+	node := &Node{
+		Name:           obj.Metadata.Name,
+		Unschedulable:  obj.Spec.Unschedulable,
+		KubeletVersion: obj.Status.NodeInfo.KubeletVersion,
+		Annotations:    obj.Metadata.Annotations,
+	}
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == "Ready" {
+			node.Ready = cond.Status == "True"
+		}
+	}
+	return node, nil
+}
+
+// SetUnschedulable cordons (true) or uncordons (false) the named Node -
+// the same spec.unschedulable patch `kubectl cordon`/`uncordon` sends.
+func (c *APIClient) SetUnschedulable(ctx context.Context, name string, unschedulable bool) error {
+	patch := map[string]any{"spec": map[string]any{"unschedulable": unschedulable}}
+	if err := c.do(ctx, http.MethodPatch, "/api/v1/nodes/"+url.PathEscape(name), "application/merge-patch+json", patch, nil); err != nil {
+		return fmt.Errorf("failed to set node %s unschedulable=%t: %w", name, unschedulable, err)
+	}
+	return nil
+}
+
+// AnnotateNode sets an annotation on the named Node.
+func (c *APIClient) AnnotateNode(ctx context.Context, name, key, value string) error {
+	patch := map[string]any{"metadata": map[string]any{"annotations": map[string]string{key: value}}}
+	if err := c.do(ctx, http.MethodPatch, "/api/v1/nodes/"+url.PathEscape(name), "application/merge-patch+json", patch, nil); err != nil {
+		return fmt.Errorf("failed to annotate node %s: %w", name, err)
+	}
+	return nil
+}
+
+// DeleteNode deletes the named Node object. A node that's already gone
+// isn't an error.
+func (c *APIClient) DeleteNode(ctx context.Context, name string) error {
+	err := c.do(ctx, http.MethodDelete, "/api/v1/nodes/"+url.PathEscape(name), "", nil, nil)
+	if err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete node %s: %w", name, err)
+	}
+	return nil
+}
+
+type pod struct {
+	Metadata struct {
+		Name            string            `json:"name"`
+		Namespace       string            `json:"namespace"`
+		UID             string            `json:"uid"`
+		Annotations     map[string]string `json:"annotations"`
+		OwnerReferences []struct {
+			Kind string `json:"kind"`
+		} `json:"ownerReferences"`
+	} `json:"metadata"`
+	Spec struct {
+		HostNetwork bool `json:"hostNetwork"`
+	} `json:"spec"`
+	Status struct {
+		Phase string `json:"phase"`
+	} `json:"status"`
+}
+
+// evictable reports whether Drain should evict p: not a static (mirror)
+// pod, which the API can't remove; not DaemonSet-owned, which would just be
+// recreated on the same node; and not already finished.
+func (p pod) evictable() bool {
+	if _, mirror := p.Metadata.Annotations["kubernetes.io/config.mirror"]; mirror {
+		return false
+	}
+	for _, owner := range p.Metadata.OwnerReferences {
+		if owner.Kind == "DaemonSet" {
+			return false
+		}
+	}
+	return p.Status.Phase != "Succeeded" && p.Status.Phase != "Failed"
+}
+
+// drainPollInterval is how often Drain retries a PodDisruptionBudget-
+// blocked eviction and re-checks whether evicted pods are gone.
+const drainPollInterval = 5 * time.Second
+
+// Drain evicts every pod on the named Node, equivalent to `kubectl drain
+// --ignore-daemonsets --delete-emptydir-data`, and waits for them to be
+// gone. Evictions go through the Eviction API, so PodDisruptionBudgets are
+// respected: a blocked eviction is retried until ctx is done rather than
+// forced. The node should already be cordoned (SetUnschedulable), or
+// evicted pods may be rescheduled straight back onto it.
+func (c *APIClient) Drain(ctx context.Context, nodeName string) error {
+	pending, err := c.evictablePods(ctx, nodeName)
+	if err != nil {
+		return err
+	}
+	slog.Info("Draining node", "node", nodeName, "pods", len(pending))
+
+	for _, p := range pending {
+		if err := c.evict(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range pending {
+		if err := c.waitPodGone(ctx, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CountEvictablePods returns how many pods Drain would evict from the
+// named Node.
+func (c *APIClient) CountEvictablePods(ctx context.Context, nodeName string) (int, error) {
+	pods, err := c.evictablePods(ctx, nodeName)
+	return len(pods), err
+}
+
+// ForceDeletePods deletes every pod Drain would evict from the named Node
+// with a zero grace period, without waiting on the kubelet. It's for a
+// node that's already dead: an eviction there is accepted, but the pod then
+// sits in Terminating forever since no kubelet is left to confirm it
+// stopped, and its controller won't replace it until it's gone.
+func (c *APIClient) ForceDeletePods(ctx context.Context, nodeName string) error {
+	pods, err := c.evictablePods(ctx, nodeName)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range pods {
+		path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s?gracePeriodSeconds=0", url.PathEscape(p.Metadata.Namespace), url.PathEscape(p.Metadata.Name))
+		if err := c.do(ctx, http.MethodDelete, path, "", nil, nil); err != nil && !IsNotFound(err) {
+			return fmt.Errorf("failed to delete %s/%s: %w", p.Metadata.Namespace, p.Metadata.Name, err)
+		}
+		slog.Info("Force-deleted pod", "namespace", p.Metadata.Namespace, "pod", p.Metadata.Name)
+	}
+	return nil
+}
+
+// RecreatePodNetworkPods deletes every controller-owned pod in the cluster
+// that uses the pod network, so each is replaced by one wired up by the
+// current CNI - the final step of moving off Flannel (see DisableFlannel).
+// Host-network pods don't touch the CNI and are left alone, as are bare
+// pods with no controller to replace them.
+func (c *APIClient) RecreatePodNetworkPods(ctx context.Context) error {
+	var list struct {
+		Items []pod `json:"items"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/pods", "", nil, &list); err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	for _, p := range list.Items {
+		if p.Spec.HostNetwork || len(p.Metadata.OwnerReferences) == 0 {
+			continue
+		}
+		path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s", url.PathEscape(p.Metadata.Namespace), url.PathEscape(p.Metadata.Name))
+		if err := c.do(ctx, http.MethodDelete, path, "", nil, nil); err != nil && !IsNotFound(err) {
+			return fmt.Errorf("failed to delete %s/%s: %w", p.Metadata.Namespace, p.Metadata.Name, err)
+		}
+		slog.Info("Recreating pod", "namespace", p.Metadata.Namespace, "pod", p.Metadata.Name)
+	}
+	return nil
+}
+
+// evictablePods lists the named Node's pods, filtered by pod.evictable.
+func (c *APIClient) evictablePods(ctx context.Context, nodeName string) ([]pod, error) {
+	var list struct {
+		Items []pod `json:"items"`
+	}
+	query := url.Values{"fieldSelector": {"spec.nodeName=" + nodeName}}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/pods?"+query.Encode(), "", nil, &list); err != nil {
+		return nil, fmt.Errorf("failed to list pods on %s: %w", nodeName, err)
+	}
+
+	var pods []pod
+	for _, p := range list.Items {
+		if p.evictable() {
+			pods = append(pods, p)
+		}
+	}
+	return pods, nil
+}
+
+// evict posts an Eviction for p, retrying while a PodDisruptionBudget
+// blocks it (429).
+func (c *APIClient) evict(ctx context.Context, p pod) error {
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/eviction", url.PathEscape(p.Metadata.Namespace), url.PathEscape(p.Metadata.Name))
+	eviction := map[string]any{
+		"apiVersion": "policy/v1",
+		"kind":       "Eviction",
+		"metadata":   map[string]any{"name": p.Metadata.Name, "namespace": p.Metadata.Namespace},
+	}
+
+	for {
+		err := c.do(ctx, http.MethodPost, path, "application/json", eviction, nil)
+		if err == nil || IsNotFound(err) {
+			slog.Info("Evicted pod", "namespace", p.Metadata.Namespace, "pod", p.Metadata.Name)
+			return nil
+		}
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+			return fmt.Errorf("failed to evict %s/%s: %w", p.Metadata.Namespace, p.Metadata.Name, err)
+		}
+		slog.Info("Eviction blocked by PodDisruptionBudget, retrying", "namespace", p.Metadata.Namespace, "pod", p.Metadata.Name, "reason", apiErr.Message)
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out evicting %s/%s: %w", p.Metadata.Namespace, p.Metadata.Name, ctx.Err())
+		case <-time.After(drainPollInterval):
+		}
+	}
+}
+
+// waitPodGone polls until p no longer exists - either deleted outright, or
+// replaced by a new pod of the same name (a StatefulSet's), told apart by
+// UID.
+func (c *APIClient) waitPodGone(ctx context.Context, p pod) error {
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s", url.PathEscape(p.Metadata.Namespace), url.PathEscape(p.Metadata.Name))
+	for {
+		var current pod
+		err := c.do(ctx, http.MethodGet, path, "", nil, &current)
+		if IsNotFound(err) || (err == nil && current.Metadata.UID != p.Metadata.UID) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to check %s/%s: %w", p.Metadata.Namespace, p.Metadata.Name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for %s/%s to terminate: %w", p.Metadata.Namespace, p.Metadata.Name, ctx.Err())
+		case <-time.After(drainPollInterval):
+		}
+	}
+}
@@ -2,6 +2,7 @@ package k3s
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/liamawhite/homelab/pkg/ssh"
 )
@@ -45,6 +46,27 @@ func DisableFlannel(client *ssh.Client) error {
 	return nil
 }
 
+// DisableFlannelBeforeInstall writes the same config.yaml override as
+// DisableFlannel, but ahead of k3s's install, so that the node's very first
+// k3s start comes up without Flannel. On a brand new cluster this makes
+// Cilium the first and only CNI, with no pods to recreate afterward.
+func DisableFlannelBeforeInstall(client *ssh.Client) error {
+	if _, _, err := client.ExecuteSudo("mkdir -p /etc/rancher/k3s"); err != nil {
+		return fmt.Errorf("failed to create /etc/rancher/k3s: %w", err)
+	}
+	if err := client.WriteFile("/etc/rancher/k3s/config.yaml", flannelDisabledConfig, true); err != nil {
+		return fmt.Errorf("failed to write /etc/rancher/k3s/config.yaml: %w", err)
+	}
+	return nil
+}
+
+// FlannelDisabled reports whether the node already has DisableFlannel's
+// config.yaml override in place.
+func FlannelDisabled(client *ssh.Client) bool {
+	current, err := client.ReadFile("/etc/rancher/k3s/config.yaml", true)
+	return err == nil && strings.TrimSpace(current) == strings.TrimSpace(flannelDisabledConfig)
+}
+
 // EnableFlannel reverts DisableFlannel: removes the config.yaml override so
 // K3s falls back to its default Flannel CNI on next start, then restarts
 // the node's k3s service. No config.yaml existed before DisableFlannel
@@ -68,9 +90,8 @@ func EnableFlannel(client *ssh.Client) error {
 }
 
 // detectServiceName returns "k3s" for a server node or "k3s-agent" for an
-// agent node, based on which systemd unit is actually present - every node
-// in this repo's infra.yaml is a server node today, but this stays correct
-// if an agent node is ever added.
+// agent node (see config.NodeRole), based on which systemd unit is
+// actually present rather than what infra.yaml says.
 func detectServiceName(client *ssh.Client) (string, error) {
 	if _, _, err := client.Execute("systemctl list-unit-files k3s.service --no-legend | grep -q k3s.service"); err == nil {
 		return "k3s", nil
@@ -0,0 +1,138 @@
+package k3s
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/liamawhite/homelab/pkg/ssh"
+)
+
+// cniNetworksDir is where the host-local IPAM plugin records each address
+// it's handed out: one file per IP, named after it and holding the ID of
+// the pod sandbox it went to.
+const cniNetworksDir = "/var/lib/cni/networks"
+
+// ServiceName returns the node's k3s systemd unit, "k3s" or "k3s-agent"
+// (see detectServiceName).
+func ServiceName(client *ssh.Client) (string, error) {
+	return detectServiceName(client)
+}
+
+// ServiceFailed reports whether the named unit is in systemd's failed
+// state - it crashed (or exhausted its restarts) rather than being stopped.
+func ServiceFailed(client *ssh.Client, service string) bool {
+	_, _, err := client.Execute("systemctl is-failed --quiet " + service)
+	return err == nil
+}
+
+// RestartService clears the named unit's failed state and starts it again.
+func RestartService(client *ssh.Client, service string) error {
+	if _, _, err := client.ExecuteSudo(fmt.Sprintf("sh -c 'systemctl reset-failed %[1]s; systemctl restart %[1]s'", service)); err != nil {
+		return fmt.Errorf("failed to restart %s: %w", service, err)
+	}
+	return nil
+}
+
+// StaleIPAMAllocations returns the host-local IPAM allocation files whose
+// pod sandbox no longer exists. They're left behind when pods die without
+// the CNI being told - an unclean shutdown, or a CNI swap - and once they
+// fill the range, every new pod on the node fails with "no IP addresses
+// available in range set" (https://github.com/k3s-io/k3s/issues/4682).
+//
+// Needs k3s running, to list the live sandboxes.
+func StaleIPAMAllocations(client *ssh.Client) ([]string, error) {
+	out, _, err := client.ExecuteSudo("k3s crictl pods -q")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pod sandboxes: %w", err)
+	}
+	live := strings.Fields(out)
+	if len(live) == 0 {
+		// A running node always has some pod - its CNI agent's, if nothing
+		// else - so an empty list means crictl couldn't see them, and
+		// every allocation would wrongly look stale.
+		return nil, fmt.Errorf("no pod sandboxes found, is k3s running?")
+	}
+
+	script := fmt.Sprintf(`for f in %s/*/*; do case "${f##*/}" in lock|last_reserved_ip*) continue;; esac; [ -f "$f" ] && echo "$f $(head -n 1 "$f")"; done; true`, cniNetworksDir)
+	out, _, err = client.ExecuteSudo("sh -c '" + script + "'")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", cniNetworksDir, err)
+	}
+
+	var stale []string
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		if !sandboxLive(live, fields[1]) {
+			stale = append(stale, fields[0])
+		}
+	}
+	return stale, nil
+}
+
+// sandboxLive reports whether id is one of live, allowing for either side
+// being a truncated ID.
+func sandboxLive(live []string, id string) bool {
+	for _, l := range live {
+		if strings.HasPrefix(l, id) || strings.HasPrefix(id, l) {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveIPAMAllocations deletes the given allocation files (as returned by
+// StaleIPAMAllocations), freeing their addresses.
+func RemoveIPAMAllocations(client *ssh.Client, files []string) error {
+	for _, f := range files {
+		if !strings.HasPrefix(f, cniNetworksDir+"/") {
+			return fmt.Errorf("refusing to remove %s: not under %s", f, cniNetworksDir)
+		}
+	}
+	if _, _, err := client.ExecuteSudo("rm -f -- " + strings.Join(files, " ")); err != nil {
+		return fmt.Errorf("failed to remove stale IPAM allocations: %w", err)
+	}
+	return nil
+}
+
+// CertExpiry is when one of k3s's certificates expires.
+type CertExpiry struct {
+	Path     string
+	NotAfter time.Time
+}
+
+// CertExpiries returns the expiry of every certificate k3s has issued
+// itself on the node - the server's under server/tls and the agent's
+// kubelet/client certificates - soonest first. k3s renews any that are
+// within 90 days of expiry when it starts, so a node that's been up
+// long enough can run out.
+func CertExpiries(client *ssh.Client) ([]CertExpiry, error) {
+	out, _, err := client.ExecuteSudo(`sh -c 'for f in /var/lib/rancher/k3s/server/tls/*.crt /var/lib/rancher/k3s/agent/*.crt; do [ -f "$f" ] && echo && echo "# $f" && cat "$f"; done; true'`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read k3s certificates: %w", err)
+	}
+
+	var expiries []CertExpiry
+	for _, section := range strings.Split("\n"+out, "\n# ")[1:] {
+		path, rest, _ := strings.Cut(section, "\n")
+		// A file can hold a chain; its leaf (first) certificate is the one
+		// that's presented.
+		block, _ := pem.Decode([]byte(rest))
+		if block == nil {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		expiries = append(expiries, CertExpiry{Path: path, NotAfter: cert.NotAfter})
+	}
+	sort.Slice(expiries, func(i, j int) bool { return expiries[i].NotAfter.Before(expiries[j].NotAfter) })
+	return expiries, nil
+}
@@ -0,0 +1,147 @@
+package k3s
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/liamawhite/homelab/pkg/ssh"
+)
+
+const (
+	// SnapshotDir is where k3s keeps etcd snapshots on a server.
+	SnapshotDir = "/var/lib/rancher/k3s/server/db/snapshots"
+
+	// SnapshotPrefix names the on-demand snapshots SaveSnapshot takes; k3s
+	// appends the node name and a Unix timestamp, so they sort by age and
+	// stay distinct from k3s's own scheduled "etcd-snapshot-*" ones.
+	SnapshotPrefix = "homelab"
+
+	// etcdDataDir is the embedded etcd member's data, wiped on the servers
+	// that rejoin after a restore. It's deliberately just etcd/ rather than
+	// the whole db/ dir, so each node's local snapshots survive.
+	etcdDataDir = "/var/lib/rancher/k3s/server/db/etcd"
+)
+
+// Annotations k3s's etcd controller watches on a server's Node object: set
+// etcdRemoveAnnotation and it removes that server's etcd member, recording
+// it in etcdRemovedAnnotation once done.
+const (
+	etcdRemoveAnnotation  = "etcd.k3s.cattle.io/remove"
+	etcdRemovedAnnotation = "etcd.k3s.cattle.io/removed-node-name"
+)
+
+// RequestEtcdMemberRemoval asks the cluster's remaining servers to remove
+// the named server's etcd member. Unlike the rest of this file it goes
+// through the Kubernetes API rather than SSH: k3s ships no etcdctl, and
+// its etcd controller - running on whichever healthy server leads - does
+// the removal itself.
+func RequestEtcdMemberRemoval(ctx context.Context, api *APIClient, nodeName string) error {
+	return api.AnnotateNode(ctx, nodeName, etcdRemoveAnnotation, "true")
+}
+
+// EtcdMemberRemoved reports whether k3s has finished removing the named
+// server's etcd member after RequestEtcdMemberRemoval.
+func EtcdMemberRemoved(ctx context.Context, api *APIClient, nodeName string) (bool, error) {
+	node, err := api.GetNode(ctx, nodeName)
+	if err != nil {
+		return false, err
+	}
+	return node.Annotations[etcdRemovedAnnotation] != "", nil
+}
+
+// EtcdReady reports whether the API server behind api can reach a healthy
+// etcd - which, with embedded etcd, needs a quorum of servers up.
+func EtcdReady(ctx context.Context, api *APIClient) bool {
+	return api.do(ctx, http.MethodGet, "/readyz/etcd", "", nil, nil) == nil
+}
+
+// SaveSnapshot takes an on-demand etcd snapshot on the server client is
+// connected to, returning its path on that node.
+func SaveSnapshot(client *ssh.Client) (string, error) {
+	slog.Info("Taking etcd snapshot")
+	if _, _, err := client.ExecuteSudo("k3s etcd-snapshot save --name " + SnapshotPrefix); err != nil {
+		return "", fmt.Errorf("k3s etcd-snapshot save failed: %w", err)
+	}
+
+	out, _, err := client.ExecuteSudo(fmt.Sprintf("sh -c 'ls -1t %s/%s-* | head -n 1'", SnapshotDir, SnapshotPrefix))
+	if err != nil {
+		return "", fmt.Errorf("failed to find the new snapshot: %w", err)
+	}
+	snapshot := strings.TrimSpace(out)
+	if snapshot == "" {
+		return "", fmt.Errorf("no %s-* snapshot found in %s after saving", SnapshotPrefix, SnapshotDir)
+	}
+
+	slog.Info("etcd snapshot saved", "path", snapshot)
+	return snapshot, nil
+}
+
+// PruneSnapshots deletes all but the newest keep of SaveSnapshot's
+// snapshots on the node, leaving k3s's scheduled ones to its own
+// --etcd-snapshot-retention.
+func PruneSnapshots(client *ssh.Client, keep int) error {
+	cmd := fmt.Sprintf("k3s etcd-snapshot prune --name %s --snapshot-retention %d", SnapshotPrefix, keep)
+	if _, _, err := client.ExecuteSudo(cmd); err != nil {
+		return fmt.Errorf("k3s etcd-snapshot prune failed: %w", err)
+	}
+	return nil
+}
+
+// InstalledWithClusterInit reports whether the server's k3s unit was
+// installed with --cluster-init. Such a node must not have its etcd data
+// wiped and be restarted as a joining member: with nothing to join, it
+// would initialize a brand new, empty cluster instead.
+func InstalledWithClusterInit(client *ssh.Client) bool {
+	_, _, err := client.Execute("grep -q -- --cluster-init /etc/systemd/system/k3s.service")
+	return err == nil
+}
+
+// StopServer stops k3s on a server node.
+func StopServer(client *ssh.Client) error {
+	if _, _, err := client.ExecuteSudo("systemctl stop k3s"); err != nil {
+		return fmt.Errorf("failed to stop k3s: %w", err)
+	}
+	return nil
+}
+
+// StartServer starts k3s on a server node.
+func StartServer(client *ssh.Client) error {
+	if _, _, err := client.ExecuteSudo("systemctl start k3s"); err != nil {
+		return fmt.Errorf("failed to start k3s: %w", err)
+	}
+	return nil
+}
+
+// ResetFromSnapshot restores the (stopped) server's etcd from the snapshot
+// at snapshot on the node, resetting membership to this node alone - k3s's
+// documented --cluster-reset restore. k3s exits once the reset is done;
+// StartServer brings the node back up as a single-member cluster that the
+// other servers can then rejoin (see WipeEtcd).
+func ResetFromSnapshot(client *ssh.Client, snapshot string) error {
+	slog.Info("Restoring etcd from snapshot", "snapshot", snapshot)
+	cmd := fmt.Sprintf("k3s server --cluster-reset --cluster-reset-restore-path=%s", ssh.ShellQuote(snapshot))
+	if _, _, err := client.ExecuteSudo(cmd); err != nil {
+		return fmt.Errorf("k3s cluster-reset failed: %w", err)
+	}
+	return nil
+}
+
+// WipeEtcd deletes a stopped server's etcd member data, so on its next
+// start it rejoins the cluster (via its unit's --server) and resyncs from
+// the restored member rather than resurrecting its own pre-restore state.
+func WipeEtcd(client *ssh.Client) error {
+	if _, _, err := client.ExecuteSudo("rm -rf " + etcdDataDir); err != nil {
+		return fmt.Errorf("failed to wipe %s: %w", etcdDataDir, err)
+	}
+	return nil
+}
+
+// SnapshotPath returns where a snapshot file named name is kept on a
+// server.
+func SnapshotPath(name string) string {
+	return path.Join(SnapshotDir, name)
+}
@@ -0,0 +1,69 @@
+package k3s
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// UnreadyPods returns the names of the pods in namespace matching
+// labelSelector whose Ready condition isn't true.
+func (c *APIClient) UnreadyPods(ctx context.Context, namespace, labelSelector string) ([]string, error) {
+	var list struct {
+		Items []struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+			Status struct {
+				Conditions []struct {
+					Type   string `json:"type"`
+					Status string `json:"status"`
+				} `json:"conditions"`
+			} `json:"status"`
+		} `json:"items"`
+	}
+	query := url.Values{"labelSelector": {labelSelector}}
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods?%s", url.PathEscape(namespace), query.Encode())
+	if err := c.do(ctx, http.MethodGet, path, "", nil, &list); err != nil {
+		return nil, fmt.Errorf("failed to list pods in %s: %w", namespace, err)
+	}
+
+	var unready []string
+	for _, p := range list.Items {
+		ready := false
+		for _, cond := range p.Status.Conditions {
+			if cond.Type == "Ready" {
+				ready = cond.Status == "True"
+			}
+		}
+		if !ready {
+			unready = append(unready, p.Metadata.Name)
+		}
+	}
+	return unready, nil
+}
+
+// DeletePod deletes a pod, for its controller to replace. A pod that's
+// already gone isn't an error.
+func (c *APIClient) DeletePod(ctx context.Context, namespace, name string) error {
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s", url.PathEscape(namespace), url.PathEscape(name))
+	if err := c.do(ctx, http.MethodDelete, path, "", nil, nil); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete %s/%s: %w", namespace, name, err)
+	}
+	return nil
+}
+
+// RestartDeployment rolls a Deployment's pods, the same pod template
+// annotation patch `kubectl rollout restart` sends.
+func (c *APIClient) RestartDeployment(ctx context.Context, namespace, name string) error {
+	patch := map[string]any{"spec": map[string]any{"template": map[string]any{"metadata": map[string]any{
+		"annotations": map[string]string{"kubectl.kubernetes.io/restartedAt": time.Now().Format(time.RFC3339)},
+	}}}}
+	path := fmt.Sprintf("/apis/apps/v1/namespaces/%s/deployments/%s", url.PathEscape(namespace), url.PathEscape(name))
+	if err := c.do(ctx, http.MethodPatch, path, "application/merge-patch+json", patch, nil); err != nil {
+		return fmt.Errorf("failed to restart deployment %s/%s: %w", namespace, name, err)
+	}
+	return nil
+}
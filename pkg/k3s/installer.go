@@ -6,25 +6,35 @@ import (
 	"log/slog"
 	"strings"
 
+	"github.com/liamawhite/homelab/pkg/config"
 	"github.com/liamawhite/homelab/pkg/ssh"
+	"github.com/liamawhite/homelab/pkg/versions"
 )
 
 type Installer struct {
 	sshClient *ssh.Client
+	role      config.NodeRole
 	sans      []string
 }
 
-// NewInstaller creates a new K3s installer
-func NewInstaller(client *ssh.Client, sans []string) *Installer {
+// NewInstaller creates a new K3s installer for a node with the given role.
+// sans only apply to servers.
+func NewInstaller(client *ssh.Client, role config.NodeRole, sans []string) *Installer {
 	return &Installer{
 		sshClient: client,
+		role:      role,
 		sans:      sans,
 	}
 }
 
-// InstallK3s installs K3s on the node
+// InstallK3s installs K3s on the node. An agent always joins, so it needs
+// serverURL and token and can't use clusterInit.
 func (i *Installer) InstallK3s(ctx context.Context, clusterInit bool, serverURL, token string) error {
-	slog.Info("Starting K3s installation")
+	slog.Info("Starting K3s installation", "role", i.role)
+
+	if i.role == config.RoleAgent && (clusterInit || serverURL == "" || token == "") {
+		return fmt.Errorf("an agent node must join an existing cluster with a server URL and token")
+	}
 
 	// Build and execute install command
 	installCmd := i.buildInstallCommand(clusterInit, serverURL, token)
@@ -40,6 +50,51 @@ func (i *Installer) InstallK3s(ctx context.Context, clusterInit bool, serverURL,
 	return nil
 }
 
+// UpgradeK3s re-runs the install script on an existing node, moving it to
+// versions.K3s. The script rewrites the k3s systemd unit from its
+// arguments, so the flags must stay the same as InstallK3s's. For a server
+// that means without --cluster-init/--server/--token: those only matter
+// the first time a server starts, and an existing node's etcd membership is
+// already in its data dir - so serverURL and token are ignored. An agent
+// can't be (re)installed without them. The script restarts k3s itself once
+// the new binary is in place.
+func (i *Installer) UpgradeK3s(ctx context.Context, serverURL, token string) error {
+	slog.Info("Upgrading K3s", "version", versions.K3s, "role", i.role)
+
+	if i.role == config.RoleAgent && (serverURL == "" || token == "") {
+		return fmt.Errorf("upgrading an agent node needs the server URL and cluster token")
+	}
+	if i.role != config.RoleAgent {
+		serverURL, token = "", ""
+	}
+
+	stdout, _, err := i.sshClient.Execute(i.buildInstallCommand(false, serverURL, token))
+	if err != nil {
+		slog.Error("K3s upgrade failed", "error", err, "output", stdout)
+		return fmt.Errorf("failed to upgrade K3s: %w", err)
+	}
+
+	slog.Info("K3s upgrade completed successfully", "version", versions.K3s)
+	return nil
+}
+
+// UninstallK3s runs the uninstall script the installer left on the node,
+// removing k3s, its data and its network interfaces.
+func (i *Installer) UninstallK3s(ctx context.Context) error {
+	script := "/usr/local/bin/k3s-uninstall.sh"
+	if i.role == config.RoleAgent {
+		script = "/usr/local/bin/k3s-agent-uninstall.sh"
+	}
+
+	slog.Info("Uninstalling K3s", "script", script)
+	stdout, _, err := i.sshClient.ExecuteSudo(script)
+	if err != nil {
+		slog.Error("K3s uninstall failed", "error", err, "output", stdout)
+		return fmt.Errorf("failed to uninstall K3s: %w", err)
+	}
+	return nil
+}
+
 // GetClusterToken retrieves the K3s cluster token
 func (i *Installer) GetClusterToken(ctx context.Context) (string, error) {
 	token, err := i.sshClient.ReadFile("/var/lib/rancher/k3s/server/token", true)
@@ -51,11 +106,19 @@ func (i *Installer) GetClusterToken(ctx context.Context) (string, error) {
 
 // buildInstallCommand builds the K3s install command based on configuration
 func (i *Installer) buildInstallCommand(clusterInit bool, serverURL, token string) string {
+	// Agents take no server flags: cluster-wide settings like the disabled
+	// kube-proxy come from the servers they join.
+	if i.role == config.RoleAgent {
+		return fmt.Sprintf("curl -sfL https://get.k3s.io | INSTALL_K3S_VERSION=%s K3S_URL=%s K3S_TOKEN=%s sh -s - agent", versions.K3s, serverURL, token)
+	}
+
 	// Base command. --disable-kube-proxy: Cilium's kubeProxyReplacement
 	// already handles all Service routing (pkg/components/cilium), so K3s's
 	// own embedded kube-proxy would otherwise run alongside it unused - two
 	// mechanisms programming the same nftables rules for no reason.
-	cmd := "curl -sfL https://get.k3s.io | sh -s - server --disable=traefik --disable=servicelb --disable-kube-proxy"
+	// INSTALL_K3S_VERSION pins the release rather than taking whatever
+	// get.k3s.io considers current on the day - see versions.K3s.
+	cmd := fmt.Sprintf("curl -sfL https://get.k3s.io | INSTALL_K3S_VERSION=%s sh -s - server --disable=traefik --disable=servicelb --disable-kube-proxy", versions.K3s)
 
 	// Add TLS SANs
 	for _, san := range i.sans {
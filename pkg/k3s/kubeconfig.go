@@ -2,13 +2,9 @@ package k3s
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"log/slog"
-	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -64,68 +60,11 @@ func ExtractKubeconfig(ctx context.Context, sshClient *ssh.Client, vip string) (
 // verify a specific node's own API server, as opposed to SSH or k3s-binary
 // presence which don't confirm the API is actually serving requests.
 func CheckAPIHealth(kubeconfig string) bool {
-	var cfg struct {
-		Clusters []struct {
-			Cluster struct {
-				Server                   string `yaml:"server"`
-				CertificateAuthorityData string `yaml:"certificate-authority-data"`
-			} `yaml:"cluster"`
-		} `yaml:"clusters"`
-		Users []struct {
-			User struct {
-				ClientCertificateData string `yaml:"client-certificate-data"`
-				ClientKeyData         string `yaml:"client-key-data"`
-			} `yaml:"user"`
-		} `yaml:"users"`
-	}
-
-	if err := yaml.Unmarshal([]byte(kubeconfig), &cfg); err != nil {
-		return false
-	}
-	if len(cfg.Clusters) == 0 || len(cfg.Users) == 0 {
-		return false
-	}
-
-	caData, err := base64.StdEncoding.DecodeString(cfg.Clusters[0].Cluster.CertificateAuthorityData)
+	client, err := newAPIClient(kubeconfig, 3*time.Second)
 	if err != nil {
 		return false
 	}
-	certData, err := base64.StdEncoding.DecodeString(cfg.Users[0].User.ClientCertificateData)
-	if err != nil {
-		return false
-	}
-	keyData, err := base64.StdEncoding.DecodeString(cfg.Users[0].User.ClientKeyData)
-	if err != nil {
-		return false
-	}
-
-	cert, err := tls.X509KeyPair(certData, keyData)
-	if err != nil {
-		return false
-	}
-
-	pool := x509.NewCertPool()
-	if !pool.AppendCertsFromPEM(caData) {
-		return false
-	}
-
-	client := &http.Client{
-		Timeout: 3 * time.Second,
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				RootCAs:      pool,
-				Certificates: []tls.Certificate{cert},
-			},
-		},
-	}
-
-	resp, err := client.Get(cfg.Clusters[0].Cluster.Server + "/livez")
-	if err != nil {
-		return false
-	}
-	defer resp.Body.Close()
-
-	return resp.StatusCode == http.StatusOK
+	return client.Livez(context.Background())
 }
 
 // WriteKubeconfig writes kubeconfig to a file with appropriate permissions
@@ -0,0 +1,179 @@
+package k3s
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// longhornNamespace is where Longhorn runs - deploy.LonghornSystemNamespace,
+// repeated here since this package can't import pkg/deploy.
+const longhornNamespace = "longhorn-system"
+
+func longhornPath(resource, name string) string {
+	p := fmt.Sprintf("/apis/longhorn.io/v1beta2/namespaces/%s/%s", longhornNamespace, resource)
+	if name != "" {
+		p += "/" + url.PathEscape(name)
+	}
+	return p
+}
+
+// RequestLonghornEviction disables scheduling on the named Longhorn node
+// and asks Longhorn to move its replicas elsewhere - the same as setting
+// "Eviction Requested" on the node in the Longhorn UI. Returns false
+// (without error) if Longhorn has no such node, e.g. because Longhorn
+// isn't installed.
+func (c *APIClient) RequestLonghornEviction(ctx context.Context, nodeName string) (bool, error) {
+	patch := map[string]any{"spec": map[string]any{"allowScheduling": false, "evictionRequested": true}}
+	err := c.do(ctx, http.MethodPatch, longhornPath("nodes", nodeName), "application/merge-patch+json", patch, nil)
+	if IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to request Longhorn eviction for %s: %w", nodeName, err)
+	}
+	return true, nil
+}
+
+// LonghornReplicasOnNode returns how many Longhorn volume replicas are
+// placed on the named node. Returns 0 if Longhorn isn't installed.
+func (c *APIClient) LonghornReplicasOnNode(ctx context.Context, nodeName string) (int, error) {
+	var list struct {
+		Items []struct {
+			Spec struct {
+				NodeID string `json:"nodeID"`
+			} `json:"spec"`
+		} `json:"items"`
+	}
+	err := c.do(ctx, http.MethodGet, longhornPath("replicas", ""), "", nil, &list)
+	if IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to list Longhorn replicas: %w", err)
+	}
+
+	n := 0
+	for _, r := range list.Items {
+		if r.Spec.NodeID == nodeName {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// DeleteLonghornNode removes the named node from Longhorn. Longhorn only
+// allows this once the Kubernetes Node is gone (or the node is down), so
+// call it after DeleteNode. A node Longhorn doesn't know isn't an error.
+func (c *APIClient) DeleteLonghornNode(ctx context.Context, nodeName string) error {
+	err := c.do(ctx, http.MethodDelete, longhornPath("nodes", nodeName), "", nil, nil)
+	if err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete Longhorn node %s: %w", nodeName, err)
+	}
+	return nil
+}
+
+// AttachedLonghornVolumes returns the names of the Longhorn volumes that
+// aren't detached yet. Returns nil if Longhorn isn't installed.
+func (c *APIClient) AttachedLonghornVolumes(ctx context.Context) ([]string, error) {
+	var list struct {
+		Items []struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+			Status struct {
+				State string `json:"state"`
+			} `json:"status"`
+		} `json:"items"`
+	}
+	err := c.do(ctx, http.MethodGet, longhornPath("volumes", ""), "", nil, &list)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Longhorn volumes: %w", err)
+	}
+
+	var attached []string
+	for _, v := range list.Items {
+		if v.Status.State != "detached" {
+			attached = append(attached, v.Metadata.Name)
+		}
+	}
+	return attached, nil
+}
+
+// UnreadyLonghornNodes returns the names of the Longhorn nodes whose Ready
+// condition isn't true - each one's longhorn-manager isn't up yet, so its
+// disks can't serve replicas. Returns nil if Longhorn isn't installed.
+func (c *APIClient) UnreadyLonghornNodes(ctx context.Context) ([]string, error) {
+	var list struct {
+		Items []struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+			Status struct {
+				Conditions []struct {
+					Type   string `json:"type"`
+					Status string `json:"status"`
+				} `json:"conditions"`
+			} `json:"status"`
+		} `json:"items"`
+	}
+	err := c.do(ctx, http.MethodGet, longhornPath("nodes", ""), "", nil, &list)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Longhorn nodes: %w", err)
+	}
+
+	var unready []string
+	for _, n := range list.Items {
+		ready := false
+		for _, cond := range n.Status.Conditions {
+			if cond.Type == "Ready" {
+				ready = cond.Status == "True"
+			}
+		}
+		if !ready {
+			unready = append(unready, n.Metadata.Name)
+		}
+	}
+	return unready, nil
+}
+
+// UnhealthyLonghornVolumes returns each attached Longhorn volume whose
+// robustness isn't healthy, mapped to that robustness: "degraded" (serving,
+// but short of replicas while Longhorn rebuilds them) or "faulted" (no
+// usable replica left). Detached volumes have no robustness to report.
+// Returns nil if Longhorn isn't installed.
+func (c *APIClient) UnhealthyLonghornVolumes(ctx context.Context) (map[string]string, error) {
+	var list struct {
+		Items []struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+			Status struct {
+				State      string `json:"state"`
+				Robustness string `json:"robustness"`
+			} `json:"status"`
+		} `json:"items"`
+	}
+	err := c.do(ctx, http.MethodGet, longhornPath("volumes", ""), "", nil, &list)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Longhorn volumes: %w", err)
+	}
+
+	unhealthy := map[string]string{}
+	for _, v := range list.Items {
+		if v.Status.State == "attached" && v.Status.Robustness != "healthy" {
+			unhealthy[v.Metadata.Name] = v.Status.Robustness
+		}
+	}
+	return unhealthy, nil
+}
@@ -11,41 +11,44 @@ import (
 
 // ResolveClusterEndpoint finds a reachable address to run Pulumi against:
 // the cluster VIP if it's already up (e.g. kube-vip is already deployed),
-// falling back to trying each node directly, in infra.yaml order - needed
-// for the very first "up", before kube-vip exists to serve the VIP.
+// falling back to trying each server node directly, in infra.yaml order -
+// needed for the very first "up", before kube-vip exists to serve the VIP.
+// Agents are never candidates: they have no API server or kubeconfig.
 //
 // Returns the working address along with SSH credentials for it. The VIP
-// is tried using the first node's credentials, since kube-vip routes it to
-// whichever node currently holds it (same convention as the "kubeconfig"
+// is tried using the first server's credentials, since kube-vip routes it
+// to whichever server currently holds it (same convention as the "kubeconfig"
 // command's default, VIP-based connection).
-func ResolveClusterEndpoint(ctx context.Context, infraCfg *config.InfraConfig) (address, sshUser, sshPassword string, err error) {
-	if len(infraCfg.Nodes) == 0 {
-		return "", "", "", fmt.Errorf("no nodes defined in infra.yaml")
+func ResolveClusterEndpoint(ctx context.Context, infraCfg *config.InfraConfig) (address string, creds ssh.Credentials, err error) {
+	servers := infraCfg.Servers()
+	if len(servers) == 0 {
+		return "", ssh.Credentials{}, fmt.Errorf("no server nodes defined in infra.yaml")
 	}
-	first := infraCfg.Nodes[0]
+	first := servers[0]
 
 	type candidate struct {
-		address, user, password string
+		address string
+		creds   ssh.Credentials
 	}
 
 	var candidates []candidate
 	if infraCfg.Cluster.VIP != "" {
-		candidates = append(candidates, candidate{infraCfg.Cluster.VIP, first.SSH.User, first.SSH.Password})
+		candidates = append(candidates, candidate{infraCfg.Cluster.VIP, first.SSH.Credentials()})
 	}
-	for _, node := range infraCfg.Nodes {
-		candidates = append(candidates, candidate{node.Address, node.SSH.User, node.SSH.Password})
+	for _, node := range servers {
+		candidates = append(candidates, candidate{node.Address, node.SSH.Credentials()})
 	}
 
 	var tried []string
 	for _, c := range candidates {
-		client := ssh.NewClientWithPassword(c.address, c.user, c.password)
+		client := ssh.NewClient(c.address, c.creds)
 		if connErr := client.Connect(ctx); connErr != nil {
 			tried = append(tried, c.address)
 			continue
 		}
 		client.Close()
-		return c.address, c.user, c.password, nil
+		return c.address, c.creds, nil
 	}
 
-	return "", "", "", fmt.Errorf("no reachable cluster endpoint found (tried: %s)", strings.Join(tried, ", "))
+	return "", ssh.Credentials{}, fmt.Errorf("no reachable cluster endpoint found (tried: %s)", strings.Join(tried, ", "))
 }
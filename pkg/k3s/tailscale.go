@@ -0,0 +1,76 @@
+package k3s
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// TailscaleNamespace is where the tailscale-operator and its proxies
+	// run - deploy.TailscaleNamespace, repeated here since this package
+	// can't import pkg/deploy.
+	TailscaleNamespace = "tailscale"
+	// TailscaleOperatorDeployment is the operator Deployment the
+	// tailscale-operator chart creates.
+	TailscaleOperatorDeployment = "operator"
+
+	// tailscaleIngressClass is tailscale.IngressClassName.
+	tailscaleIngressClass = "tailscale"
+)
+
+// MissingTailscaleProxies returns the "namespace/name" of each tailscale
+// Ingress that has no ready proxy: the operator creates one StatefulSet per
+// Ingress, labelled with its parent, and the Ingress isn't reachable on the
+// tailnet until that's up.
+func (c *APIClient) MissingTailscaleProxies(ctx context.Context) ([]string, error) {
+	var ingresses struct {
+		Items []struct {
+			Metadata struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"metadata"`
+			Spec struct {
+				IngressClassName string `json:"ingressClassName"`
+			} `json:"spec"`
+		} `json:"items"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/apis/networking.k8s.io/v1/ingresses", "", nil, &ingresses); err != nil {
+		return nil, fmt.Errorf("failed to list ingresses: %w", err)
+	}
+
+	var proxies struct {
+		Items []struct {
+			Metadata struct {
+				Labels map[string]string `json:"labels"`
+			} `json:"metadata"`
+			Status struct {
+				ReadyReplicas int `json:"readyReplicas"`
+			} `json:"status"`
+		} `json:"items"`
+	}
+	query := url.Values{"labelSelector": {"tailscale.com/managed=true,tailscale.com/parent-resource-type=ingress"}}
+	path := fmt.Sprintf("/apis/apps/v1/namespaces/%s/statefulsets?%s", TailscaleNamespace, query.Encode())
+	if err := c.do(ctx, http.MethodGet, path, "", nil, &proxies); err != nil {
+		return nil, fmt.Errorf("failed to list tailscale proxies: %w", err)
+	}
+
+	ready := map[string]bool{}
+	for _, p := range proxies.Items {
+		if p.Status.ReadyReplicas > 0 {
+			ready[p.Metadata.Labels["tailscale.com/parent-resource-ns"]+"/"+p.Metadata.Labels["tailscale.com/parent-resource"]] = true
+		}
+	}
+
+	var missing []string
+	for _, ing := range ingresses.Items {
+		if ing.Spec.IngressClassName != tailscaleIngressClass {
+			continue
+		}
+		if name := ing.Metadata.Namespace + "/" + ing.Metadata.Name; !ready[name] {
+			missing = append(missing, name)
+		}
+	}
+	return missing, nil
+}
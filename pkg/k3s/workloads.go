@@ -0,0 +1,122 @@
+package k3s
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+)
+
+// shutdownReplicasAnnotation records a workload's replica count while
+// ScaleDownWorkloads has it scaled to zero, so ScaleUpWorkloads can put it
+// back - kept on the object itself, so it survives the cluster being off.
+const shutdownReplicasAnnotation = "homelab.io/shutdown-replicas"
+
+// scalableKinds are the workload resources ScaleDownWorkloads scales.
+var scalableKinds = []string{"deployments", "statefulsets"}
+
+type workload struct {
+	Metadata struct {
+		Name        string            `json:"name"`
+		Namespace   string            `json:"namespace"`
+		Annotations map[string]string `json:"annotations"`
+	} `json:"metadata"`
+	Spec struct {
+		Replicas *int `json:"replicas"`
+	} `json:"spec"`
+}
+
+func (w workload) path(kind string) string {
+	return fmt.Sprintf("/apis/apps/v1/namespaces/%s/%s/%s", url.PathEscape(w.Metadata.Namespace), kind, url.PathEscape(w.Metadata.Name))
+}
+
+func (c *APIClient) listWorkloads(ctx context.Context, kind string) ([]workload, error) {
+	var list struct {
+		Items []workload `json:"items"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/apis/apps/v1/"+kind, "", nil, &list); err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", kind, err)
+	}
+	return list.Items, nil
+}
+
+// ScaleDownWorkloads scales every Deployment and StatefulSet outside
+// keepNamespaces to zero replicas, recording each one's replica count for
+// ScaleUpWorkloads. A workload already scaled down by an earlier call keeps
+// its original record, so it's safe to re-run. Returns how many workloads
+// were scaled down.
+func (c *APIClient) ScaleDownWorkloads(ctx context.Context, keepNamespaces []string) (int, error) {
+	n := 0
+	for _, kind := range scalableKinds {
+		workloads, err := c.listWorkloads(ctx, kind)
+		if err != nil {
+			return n, err
+		}
+
+		for _, w := range workloads {
+			if slices.Contains(keepNamespaces, w.Metadata.Namespace) {
+				continue
+			}
+			replicas := 1
+			if w.Spec.Replicas != nil {
+				replicas = *w.Spec.Replicas
+			}
+			if replicas == 0 {
+				continue
+			}
+
+			annotations := map[string]string{}
+			if _, recorded := w.Metadata.Annotations[shutdownReplicasAnnotation]; !recorded {
+				annotations[shutdownReplicasAnnotation] = strconv.Itoa(replicas)
+			}
+			patch := map[string]any{
+				"metadata": map[string]any{"annotations": annotations},
+				"spec":     map[string]any{"replicas": 0},
+			}
+			if err := c.do(ctx, http.MethodPatch, w.path(kind), "application/merge-patch+json", patch, nil); err != nil {
+				return n, fmt.Errorf("failed to scale down %s/%s: %w", w.Metadata.Namespace, w.Metadata.Name, err)
+			}
+			slog.Info("Scaled down", "kind", kind, "namespace", w.Metadata.Namespace, "name", w.Metadata.Name, "replicas", replicas)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// ScaleUpWorkloads restores every workload ScaleDownWorkloads scaled down
+// to its recorded replica count, removing the record. Returns how many
+// workloads were scaled up.
+func (c *APIClient) ScaleUpWorkloads(ctx context.Context) (int, error) {
+	n := 0
+	for _, kind := range scalableKinds {
+		workloads, err := c.listWorkloads(ctx, kind)
+		if err != nil {
+			return n, err
+		}
+
+		for _, w := range workloads {
+			recorded, ok := w.Metadata.Annotations[shutdownReplicasAnnotation]
+			if !ok {
+				continue
+			}
+			replicas, err := strconv.Atoi(recorded)
+			if err != nil {
+				return n, fmt.Errorf("%s/%s has an invalid %s annotation %q", w.Metadata.Namespace, w.Metadata.Name, shutdownReplicasAnnotation, recorded)
+			}
+
+			patch := map[string]any{
+				"metadata": map[string]any{"annotations": map[string]any{shutdownReplicasAnnotation: nil}},
+				"spec":     map[string]any{"replicas": replicas},
+			}
+			if err := c.do(ctx, http.MethodPatch, w.path(kind), "application/merge-patch+json", patch, nil); err != nil {
+				return n, fmt.Errorf("failed to scale up %s/%s: %w", w.Metadata.Namespace, w.Metadata.Name, err)
+			}
+			slog.Info("Scaled up", "kind", kind, "namespace", w.Metadata.Namespace, "name", w.Metadata.Name, "replicas", replicas)
+			n++
+		}
+	}
+	return n, nil
+}
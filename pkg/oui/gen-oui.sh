@@ -0,0 +1,14 @@
+#!/bin/bash
+# Refresh oui.csv from the IEEE's public MA-L (24-bit OUI) registry.
+#
+# The registry is committed rather than fetched at runtime so "homelab net
+# scan" works offline - which is exactly when you're hunting for a freshly
+# flashed Pi on the LAN.
+set -euo pipefail
+
+SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
+
+echo "Downloading IEEE MA-L registry..."
+curl -sL --fail "https://standards-oui.ieee.org/oui/oui.csv" -o "${SCRIPT_DIR}/oui.csv"
+
+echo "Successfully refreshed oui.csv ($(($(wc -l <"${SCRIPT_DIR}/oui.csv") - 1)) assignments)!"
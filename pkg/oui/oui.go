@@ -0,0 +1,70 @@
+// Package oui looks up the vendor a MAC address was assigned to, from an
+// embedded table of OUI assignments in the IEEE MA-L registry's CSV format.
+//
+// The committed oui.csv only holds the vendors this homelab itself runs
+// (Raspberry Pi, Hue, network gear), so anything else on the LAN has no
+// vendor. gen-oui.sh, which "make gen" (go generate ./...) runs, replaces
+// it with the full registry downloaded from the IEEE.
+package oui
+
+//go:generate ./gen-oui.sh
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+//go:embed oui.csv
+var registryCSV string
+
+// Randomized is what Vendor returns for a locally administered address -
+// one a phone or laptop randomizes per network, or a VM/container
+// interface makes up - which no vendor was ever assigned.
+const Randomized = "(randomized)"
+
+var (
+	loadOnce sync.Once
+	registry map[string]string
+)
+
+// Vendor returns the organization mac's OUI is assigned to, Randomized for
+// a locally administered address, or "" if it isn't in the registry.
+func Vendor(mac string) string {
+	hw, err := net.ParseMAC(mac)
+	if err != nil || len(hw) != 6 {
+		return ""
+	}
+	if hw[0]&0x02 != 0 {
+		return Randomized
+	}
+
+	loadOnce.Do(func() { registry = parse(registryCSV) })
+	return registry[fmt.Sprintf("%02X%02X%02X", hw[0], hw[1], hw[2])]
+}
+
+// parse reads the registry's Registry,Assignment,Organization Name,...
+// rows into a map of assignment (six upper-case hex digits) to organization.
+func parse(data string) map[string]string {
+	r := csv.NewReader(strings.NewReader(data))
+	r.FieldsPerRecord = -1
+
+	rows, err := r.ReadAll()
+	if err != nil {
+		// The file is embedded, so a parse error is a broken gen-oui.sh
+		// run rather than something to handle at runtime.
+		panic(fmt.Sprintf("oui: invalid oui.csv: %v", err))
+	}
+
+	m := make(map[string]string, len(rows))
+	for _, row := range rows[1:] {
+		if len(row) < 3 {
+			continue
+		}
+		m[strings.ToUpper(row[1])] = strings.TrimSpace(row[2])
+	}
+	return m
+}
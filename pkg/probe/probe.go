@@ -3,9 +3,11 @@
 package probe
 
 import (
+	"errors"
 	"net"
 	"time"
 
+	homelabssh "github.com/liamawhite/homelab/pkg/ssh"
 	"golang.org/x/crypto/ssh"
 )
 
@@ -49,14 +51,18 @@ func Table() map[string]string {
 type SSHResult struct {
 	Authenticated bool
 	K3sInstalled  bool
+	// HostKeyMismatch is set if the host presented a different key from
+	// the one pinned for it, so authentication was never attempted.
+	HostKeyMismatch bool
 }
 
 // SSH attempts a single SSH handshake and authentication against address,
 // and if it succeeds, also checks whether k3s is installed there.
-func SSH(address, user, password string) SSHResult {
-	client, err := dialSSH(address, user, password)
+func SSH(address string, creds homelabssh.Credentials) SSHResult {
+	client, err := dialSSH(address, creds)
 	if err != nil {
-		return SSHResult{}
+		var mismatch *homelabssh.HostKeyMismatchError
+		return SSHResult{HostKeyMismatch: errors.As(err, &mismatch)}
 	}
 	defer client.Close()
 
@@ -71,11 +77,17 @@ func SSH(address, user, password string) SSHResult {
 	return result
 }
 
-func dialSSH(address, user, password string) (*ssh.Client, error) {
+func dialSSH(address string, creds homelabssh.Credentials) (*ssh.Client, error) {
+	auth, closeAgent, err := creds.AuthMethods()
+	if err != nil {
+		return nil, err
+	}
+	defer closeAgent()
+
 	config := &ssh.ClientConfig{
-		User:            user,
-		Auth:            []ssh.AuthMethod{ssh.Password(password)},
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		User:            creds.User,
+		Auth:            auth,
+		HostKeyCallback: homelabssh.HostKeyCallback(),
 		Timeout:         timeout,
 	}
 
@@ -0,0 +1,109 @@
+package probe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"sync"
+	"time"
+)
+
+// sweepConcurrency bounds how many ARP triggers are in flight at once.
+const sweepConcurrency = 64
+
+// MaxSweepHosts is the largest number of addresses Sweep will probe - a
+// /20. Anything bigger isn't a single home LAN, and would take a while.
+const MaxSweepHosts = 4096
+
+// Sweep forces ARP resolution for every host address in prefix, then
+// returns the neighbor table entries (IP -> MAC) that fall inside it.
+//
+// Only hosts on a directly attached network can be found this way: for a
+// prefix that's routed elsewhere, the kernel ARPs for the gateway instead.
+// The local machine's own addresses never appear in its neighbor table.
+func Sweep(prefix netip.Prefix) (map[string]string, error) {
+	prefix = prefix.Masked()
+	if !prefix.Addr().Is4() {
+		return nil, fmt.Errorf("%s isn't an IPv4 prefix", prefix)
+	}
+	if 1<<(32-prefix.Bits()) > MaxSweepHosts {
+		return nil, fmt.Errorf("%s is too large to sweep (at most %d addresses)", prefix, MaxSweepHosts)
+	}
+
+	sem := make(chan struct{}, sweepConcurrency)
+	var wg sync.WaitGroup
+	for _, addr := range hosts(prefix) {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(address string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			triggerARP(address)
+		}(addr.String())
+	}
+	wg.Wait()
+
+	// Give every outstanding ARP request the same allowance Host does.
+	time.Sleep(timeout)
+
+	found := make(map[string]string)
+	for ip, mac := range Table() {
+		addr, err := netip.ParseAddr(ip)
+		if err == nil && prefix.Contains(addr) {
+			found[ip] = mac
+		}
+	}
+	return found, nil
+}
+
+// hosts returns prefix's host addresses: all of them, less the network and
+// broadcast addresses for prefixes that have them (/30 and larger).
+func hosts(prefix netip.Prefix) []netip.Addr {
+	var addrs []netip.Addr
+	for addr := prefix.Addr(); prefix.Contains(addr); addr = addr.Next() {
+		addrs = append(addrs, addr)
+	}
+	if prefix.Bits() <= 30 {
+		addrs = addrs[1 : len(addrs)-1]
+	}
+	return addrs
+}
+
+// HueBridge is what a Hue bridge says about itself.
+type HueBridge struct {
+	ID   string
+	Name string
+}
+
+// Hue checks whether address is a Hue bridge via its unauthenticated
+// /api/config endpoint, the same check lumenetes' hue.FetchInfo makes
+// (which lives in the lumenetes module, so can't be imported here): a
+// non-empty bridgeid is what confirms it.
+func Hue(ctx context.Context, address string) (HueBridge, bool) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s/api/config", address), nil)
+	if err != nil {
+		return HueBridge{}, false
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return HueBridge{}, false
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return HueBridge{}, false
+	}
+
+	var cfg struct {
+		BridgeID string `json:"bridgeid"`
+		Name     string `json:"name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&cfg); err != nil || cfg.BridgeID == "" {
+		return HueBridge{}, false
+	}
+	return HueBridge{ID: cfg.BridgeID, Name: cfg.Name}, true
+}
@@ -0,0 +1,243 @@
+package raspberry
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/liamawhite/homelab/pkg/ssh"
+)
+
+const (
+	// networkRevertAfter is how long after a static address change the node
+	// puts its previous network settings back, unless the change is
+	// confirmed first.
+	networkRevertAfter = 3 * time.Minute
+	// networkConfirmWithin is how long Provision tries to confirm the change
+	// by logging in again - comfortably inside networkRevertAfter, so a
+	// confirmation can't race the revert.
+	networkConfirmWithin = 2 * time.Minute
+
+	networkApplyUnit  = "homelab-network-apply"
+	networkRevertUnit = "homelab-network-revert"
+)
+
+// hostnamePattern is a single RFC 1123 DNS label, which is all hostnamectl
+// accepts as a static hostname.
+var hostnamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// hostnameMatches reports whether the node's hostname is already its
+// infra.yaml name. A node given by address alone has no name to set, so
+// trivially matches.
+func (p *Provisioner) hostnameMatches() (bool, error) {
+	if p.node.Name == "" {
+		return true, nil
+	}
+	out, _, err := p.sshClient.Execute("hostname")
+	if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(out) == p.node.Name, nil
+}
+
+// hostnameSettled reports whether there's nothing for setHostname to do:
+// the hostname already matches, or k3s is installed and so it has to stay
+// as it is.
+func (p *Provisioner) hostnameSettled() (bool, error) {
+	matches, err := p.hostnameMatches()
+	if err != nil || matches {
+		return matches, err
+	}
+	return p.k3sInstalled(), nil
+}
+
+// k3sInstalled reports whether k3s is installed on the node. k3s registers
+// the node under its hostname, so renaming a node it's already installed
+// on would leave the old Node behind and join a new one.
+func (p *Provisioner) k3sInstalled() bool {
+	_, _, err := p.sshClient.Execute("test -e /usr/local/bin/k3s")
+	return err == nil
+}
+
+// setHostname sets the node's hostname to its infra.yaml name, along with
+// the 127.0.1.1 entry in /etc/hosts that resolves it locally.
+func (p *Provisioner) setHostname() error {
+	matches, err := p.hostnameMatches()
+	if err != nil {
+		return fmt.Errorf("failed to read hostname: %w", err)
+	}
+	if matches {
+		slog.Info("Hostname already set", "hostname", p.node.Name)
+		return nil
+	}
+	if !hostnamePattern.MatchString(p.node.Name) {
+		return fmt.Errorf("node name %q isn't a valid hostname", p.node.Name)
+	}
+
+	if p.k3sInstalled() {
+		slog.Warn("Hostname differs from node name, but k3s is already installed under the old one - leaving it (remove the node from the cluster and re-create it to rename)", "node", p.node.Name)
+		return nil
+	}
+
+	slog.Info("Setting hostname", "hostname", p.node.Name)
+	if _, _, err := p.sshClient.ExecuteSudo("hostnamectl set-hostname " + p.node.Name); err != nil {
+		return fmt.Errorf("failed to set hostname: %w", err)
+	}
+	hosts := fmt.Sprintf(`if grep -q '^127\.0\.1\.1[[:space:]]' /etc/hosts; then sed -i 's/^127\.0\.1\.1[[:space:]].*/127.0.1.1\t%[1]s/' /etc/hosts; else printf '127.0.1.1\t%[1]s\n' >> /etc/hosts; fi`, p.node.Name)
+	if _, _, err := p.sshClient.ExecuteSudo("sh -c " + ssh.ShellQuote(hosts)); err != nil {
+		return fmt.Errorf("failed to update /etc/hosts: %w", err)
+	}
+	return nil
+}
+
+// ipv4Settings is the subset of a NetworkManager connection's ipv4.*
+// settings Provision manages.
+type ipv4Settings struct {
+	Method    string
+	Addresses string
+	Gateway   string
+	DNS       string
+}
+
+// args renders s as `nmcli connection modify` arguments.
+func (s ipv4Settings) args() string {
+	return fmt.Sprintf("ipv4.method %s ipv4.addresses %s ipv4.gateway %s ipv4.dns %s",
+		ssh.ShellQuote(s.Method), ssh.ShellQuote(s.Addresses), ssh.ShellQuote(s.Gateway), ssh.ShellQuote(s.DNS))
+}
+
+// wantIPv4 returns the settings the node's infra.yaml Network asks for.
+func (p *Provisioner) wantIPv4() ipv4Settings {
+	return ipv4Settings{
+		Method:    "manual",
+		Addresses: fmt.Sprintf("%s/%d", p.node.Address, p.node.Network.Prefix),
+		Gateway:   p.node.Network.Gateway,
+		DNS:       strings.Join(p.node.Network.DNS, ","),
+	}
+}
+
+// networkInterface returns the device to configure, defaulting to eth0.
+func (p *Provisioner) networkInterface() string {
+	if p.node.Network.Interface != "" {
+		return p.node.Network.Interface
+	}
+	return "eth0"
+}
+
+// connection returns the name of the NetworkManager connection active on
+// the node's interface, and its current ipv4 settings.
+func (p *Provisioner) connection() (string, ipv4Settings, error) {
+	out, _, err := p.sshClient.Execute("nmcli -g GENERAL.CONNECTION device show " + p.networkInterface())
+	if err != nil {
+		return "", ipv4Settings{}, fmt.Errorf("failed to find the NetworkManager connection on %s: %w", p.networkInterface(), err)
+	}
+	name := strings.TrimSpace(out)
+	if name == "" {
+		return "", ipv4Settings{}, fmt.Errorf("no active NetworkManager connection on %s", p.networkInterface())
+	}
+
+	out, _, err = p.sshClient.Execute("nmcli -g ipv4.method,ipv4.addresses,ipv4.gateway,ipv4.dns connection show " + ssh.ShellQuote(name))
+	if err != nil {
+		return "", ipv4Settings{}, fmt.Errorf("failed to read connection %q: %w", name, err)
+	}
+	// One line per field; empty trailing fields may be missing entirely.
+	fields := strings.Split(strings.TrimRight(out, "\n"), "\n")
+	for len(fields) < 4 {
+		fields = append(fields, "")
+	}
+	return name, ipv4Settings{
+		Method:    strings.TrimSpace(fields[0]),
+		Addresses: strings.TrimSpace(fields[1]),
+		Gateway:   strings.TrimSpace(fields[2]),
+		DNS:       strings.Join(strings.Fields(strings.ReplaceAll(fields[3], ",", " ")), ","),
+	}, nil
+}
+
+// networkMatches reports whether the node's connection already has its
+// infra.yaml static settings. A node without a Network is left on
+// whatever it has, so trivially matches.
+func (p *Provisioner) networkMatches() (bool, error) {
+	if p.node.Network == nil {
+		return true, nil
+	}
+	_, current, err := p.connection()
+	if err != nil {
+		return false, err
+	}
+	return current == p.wantIPv4(), nil
+}
+
+// configureNetwork gives the node its static address, gateway and DNS.
+//
+// A wrong setting could cut the node off, so the change is applied safely:
+// a timer on the node first arms a revert to the previous settings, then
+// the change is applied detached from this SSH session (which may drop),
+// and only once a fresh login succeeds and sees the new settings is the
+// revert cancelled. If that doesn't happen in time, the node reverts on its
+// own.
+func (p *Provisioner) configureNetwork(ctx context.Context) error {
+	if p.node.Network == nil {
+		return nil
+	}
+
+	name, current, err := p.connection()
+	if err != nil {
+		return err
+	}
+	want := p.wantIPv4()
+	if current == want {
+		slog.Info("Static address already configured", "connection", name, "address", want.Addresses)
+		return nil
+	}
+
+	// Clear out units left by an earlier, interrupted run, so the names
+	// are free.
+	p.sshClient.ExecuteSudo(fmt.Sprintf("sh -c 'systemctl stop %[1]s.timer %[1]s.service %[2]s.service; systemctl reset-failed %[1]s.timer %[1]s.service %[2]s.service; true'", networkRevertUnit, networkApplyUnit))
+
+	revert := fmt.Sprintf("nmcli connection modify %s %s && nmcli connection up %s", ssh.ShellQuote(name), current.args(), ssh.ShellQuote(name))
+	slog.Info("Arming network revert", "after", networkRevertAfter)
+	if _, _, err := p.sshClient.ExecuteSudo(fmt.Sprintf("systemd-run --unit=%s --collect --on-active=%d sh -c %s", networkRevertUnit, int(networkRevertAfter.Seconds()), ssh.ShellQuote(revert))); err != nil {
+		return fmt.Errorf("failed to arm network revert: %w", err)
+	}
+
+	apply := fmt.Sprintf("nmcli connection modify %s %s && nmcli connection up %s", ssh.ShellQuote(name), want.args(), ssh.ShellQuote(name))
+	slog.Info("Applying static address", "connection", name, "address", want.Addresses, "gateway", want.Gateway, "dns", want.DNS)
+	if _, _, err := p.sshClient.ExecuteSudo(fmt.Sprintf("systemd-run --unit=%s --collect sh -c %s", networkApplyUnit, ssh.ShellQuote(apply))); err != nil {
+		return fmt.Errorf("failed to apply static address (the revert is still armed): %w", err)
+	}
+
+	if err := p.confirmNetwork(ctx, want); err != nil {
+		return fmt.Errorf("%w - the node will put its previous network settings back within %s", err, networkRevertAfter)
+	}
+
+	if _, _, err := p.sshClient.ExecuteSudo(fmt.Sprintf("systemctl stop %s.timer", networkRevertUnit)); err != nil {
+		return fmt.Errorf("static address works, but cancelling the revert failed, so it will still be reverted: %w", err)
+	}
+	slog.Info("Static address confirmed", "address", want.Addresses)
+	return nil
+}
+
+// confirmNetwork logs in to the node afresh until it sees want applied, or
+// networkConfirmWithin passes.
+func (p *Provisioner) confirmNetwork(ctx context.Context, want ipv4Settings) error {
+	deadline := time.Now().Add(networkConfirmWithin)
+	for {
+		// Give NetworkManager a moment to bring the connection back up.
+		time.Sleep(5 * time.Second)
+
+		p.sshClient.Close()
+		if err := p.sshClient.Connect(ctx); err == nil {
+			if _, current, err := p.connection(); err == nil && current == want {
+				if out, _, err := p.sshClient.Execute("ip -4 -o addr show dev " + p.networkInterface()); err == nil && strings.Contains(out, " "+want.Addresses+" ") {
+					return nil
+				}
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("couldn't confirm the static address over SSH within %s", networkConfirmWithin)
+		}
+	}
+}
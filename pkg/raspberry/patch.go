@@ -0,0 +1,73 @@
+package raspberry
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/liamawhite/homelab/pkg/ssh"
+)
+
+// aptNoninteractive runs apt-get without prompting, keeping a locally
+// modified config file whenever a package ships a new version of it.
+const aptNoninteractive = "DEBIAN_FRONTEND=noninteractive apt-get -y -o Dpkg::Options::=--force-confdef -o Dpkg::Options::=--force-confold"
+
+// HasApt reports whether the node is apt-based at all (the x86 NixOS node,
+// for one, isn't).
+func HasApt(client *ssh.Client) bool {
+	_, _, err := client.Execute("command -v apt-get")
+	return err == nil
+}
+
+// PendingUpgrades returns how many packages an upgrade would install or
+// upgrade, going by the node's current package lists - which apt's daily
+// timer keeps fresh, so this doesn't need root or the network.
+func PendingUpgrades(client *ssh.Client) (int, error) {
+	out, _, err := client.Execute("apt-get -s -o Debug::NoLocking=1 full-upgrade")
+	if err != nil {
+		return 0, fmt.Errorf("failed to simulate upgrade: %w", err)
+	}
+	count := 0
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "Inst ") {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// Upgrade refreshes the node's package lists and upgrades every package.
+// It's a full-upgrade, as Raspberry Pi OS recommends, so new kernel and
+// firmware packages are pulled in rather than held back.
+func Upgrade(client *ssh.Client) error {
+	if _, _, err := client.ExecuteSudo(fmt.Sprintf("sh -c '%[1]s update && %[1]s full-upgrade && %[1]s autoremove'", aptNoninteractive)); err != nil {
+		return fmt.Errorf("failed to upgrade packages: %w", err)
+	}
+	return nil
+}
+
+// RebootRequired reports whether the node needs a reboot to pick up what's
+// been upgraded: either a package asked for one via
+// /var/run/reboot-required, or a newer kernel is installed than the one
+// running.
+func RebootRequired(client *ssh.Client) (bool, error) {
+	if _, _, err := client.Execute("test -e /var/run/reboot-required"); err == nil {
+		return true, nil
+	}
+
+	running, _, err := client.Execute("uname -r")
+	if err != nil {
+		return false, fmt.Errorf("failed to read running kernel: %w", err)
+	}
+	// Each installed kernel has its own modules directory; the running one
+	// should be the newest. Pis install several kernel flavours side by
+	// side (e.g. rpi-v8 and rpi-2712), so only compare within the running
+	// one's flavour.
+	running = strings.TrimSpace(running)
+	flavour := running[strings.LastIndex(running, "-")+1:]
+	newest, _, err := client.Execute(fmt.Sprintf("ls -v /lib/modules | grep -- '-%s$' | tail -n 1", flavour))
+	if err != nil {
+		return false, fmt.Errorf("failed to list installed kernels: %w", err)
+	}
+	newest = strings.TrimSpace(newest)
+	return newest != "" && newest != running, nil
+}
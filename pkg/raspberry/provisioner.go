@@ -4,19 +4,35 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/liamawhite/homelab/pkg/config"
 	"github.com/liamawhite/homelab/pkg/ssh"
 )
 
+// requiredPackages are what every node needs to run its share of the
+// cluster (Longhorn's iSCSI and NFS clients), and what CheckBootstrapped
+// checks for. optionalPackages are installed alongside them, but a node
+// without them still works - nvme-cli only feeds the pi-exporter's NVMe
+// health series - so adding one doesn't send every already-provisioned
+// node back through Provision; "homelab bootstrap" installs it there.
+var (
+	requiredPackages = []string{"open-iscsi", "nfs-common"}
+	optionalPackages = []string{"nvme-cli"}
+)
+
 type Provisioner struct {
 	sshClient *ssh.Client
+	node      config.NodeConfig
 }
 
-// NewProvisioner creates a new Raspberry Pi provisioner
-func NewProvisioner(client *ssh.Client) *Provisioner {
-	return &Provisioner{sshClient: client}
+// NewProvisioner creates a new Raspberry Pi provisioner for node, which
+// client is connected to: its hostname, static address (if it has a
+// Network) and boot configuration all come from node.
+func NewProvisioner(client *ssh.Client, node config.NodeConfig) *Provisioner {
+	return &Provisioner{sshClient: client, node: node}
 }
 
 // Provision provisions a Raspberry Pi node. It is safe to run repeatedly:
@@ -27,15 +43,23 @@ func (p *Provisioner) Provision(ctx context.Context) error {
 
 	needsReboot := false
 
-	// 1. Copy config.txt (only if it differs from what's already there)
-	changed, err := p.writeIfChanged("/boot/firmware/config.txt", ConfigTxt)
+	// 1. Set the hostname and static address. Neither needs a reboot.
+	if err := p.setHostname(); err != nil {
+		return err
+	}
+	if err := p.configureNetwork(ctx); err != nil {
+		return err
+	}
+
+	// 2. Copy config.txt (only if it differs from what's already there)
+	changed, err := p.writeIfChanged("/boot/firmware/config.txt", RenderConfigTxt(p.node.Boot))
 	if err != nil {
 		return fmt.Errorf("failed to copy config.txt: %w", err)
 	}
 	needsReboot = needsReboot || changed
 
-	// 2. Copy and apply eeprom.conf (only if it differs)
-	changed, err = p.writeIfChanged("/boot/firmware/eeprom.conf", EepromConf)
+	// 3. Copy and apply eeprom.conf (only if it differs)
+	changed, err = p.writeIfChanged("/boot/firmware/eeprom.conf", RenderEepromConf(p.node.Boot))
 	if err != nil {
 		return fmt.Errorf("failed to copy eeprom.conf: %w", err)
 	}
@@ -49,33 +73,34 @@ func (p *Provisioner) Provision(ctx context.Context) error {
 		slog.Info("eeprom.conf already up to date")
 	}
 
-	// 3. Update cmdline.txt
-	slog.Info("Updating cmdline.txt with cgroup parameters")
+	// 4. Update cmdline.txt
+	slog.Info("Updating cmdline.txt")
 	current, err := p.sshClient.ReadFile("/boot/firmware/cmdline.txt", true)
 	if err != nil {
 		return fmt.Errorf("failed to read cmdline.txt: %w", err)
 	}
 
-	updated := p.updateCmdline(current)
-	if updated != strings.TrimSpace(current) {
+	if !cmdlineUpToDate(current, p.node.Boot) {
+		updated := renderCmdline(current, p.node.Boot)
 		if err := p.sshClient.WriteFile("/boot/firmware/cmdline.txt", updated, true); err != nil {
 			return fmt.Errorf("failed to write cmdline.txt: %w", err)
 		}
-		slog.Info("Updated cmdline.txt with cgroup parameters")
+		slog.Info("Updated cmdline.txt", "cmdline", updated)
 		needsReboot = true
 	} else {
-		slog.Info("cmdline.txt already has required cgroup parameters")
+		slog.Info("cmdline.txt already has required parameters")
 	}
 
-	// 4. Install required packages (apt-get is already idempotent)
-	slog.Info("Installing required packages", "packages", "open-iscsi, nfs-common")
-	stdout, _, err := p.sshClient.ExecuteSudo("sh -c 'DEBIAN_FRONTEND=noninteractive apt-get update && DEBIAN_FRONTEND=noninteractive apt-get install -y open-iscsi nfs-common'")
+	// 5. Install packages (apt-get is already idempotent)
+	packages := strings.Join(slices.Concat(requiredPackages, optionalPackages), " ")
+	slog.Info("Installing packages", "packages", packages)
+	stdout, _, err := p.sshClient.ExecuteSudo("sh -c 'DEBIAN_FRONTEND=noninteractive apt-get update && DEBIAN_FRONTEND=noninteractive apt-get install -y " + packages + "'")
 	if err != nil {
 		slog.Error("Package installation failed", "output", stdout)
 		return fmt.Errorf("failed to install packages: %w", err)
 	}
 
-	// 5. Reboot and wait, but only if something that requires it changed
+	// 6. Reboot and wait, but only if something that requires it changed
 	if !needsReboot {
 		slog.Info("No changes requiring a reboot; provisioning already up to date")
 		return nil
@@ -124,6 +149,10 @@ func (p *Provisioner) fileMatches(path, content string) (bool, error) {
 // BootstrapStatus reports whether each part of Raspberry Pi provisioning is
 // already in place.
 type BootstrapStatus struct {
+	// Hostname is also true for a node k3s is already installed on, which
+	// keeps whatever hostname it was registered under.
+	Hostname   bool
+	Network    bool
 	ConfigTxt  bool
 	EepromConf bool
 	Cmdline    bool
@@ -132,7 +161,7 @@ type BootstrapStatus struct {
 
 // Bootstrapped reports whether every part of provisioning is already done.
 func (s BootstrapStatus) Bootstrapped() bool {
-	return s.ConfigTxt && s.EepromConf && s.Cmdline && s.Packages
+	return s.Hostname && s.Network && s.ConfigTxt && s.EepromConf && s.Cmdline && s.Packages
 }
 
 // CheckBootstrapped inspects the node's current state without changing
@@ -141,31 +170,18 @@ func (s BootstrapStatus) Bootstrapped() bool {
 func (p *Provisioner) CheckBootstrapped() BootstrapStatus {
 	var status BootstrapStatus
 
-	status.ConfigTxt, _ = p.fileMatches("/boot/firmware/config.txt", ConfigTxt)
-	status.EepromConf, _ = p.fileMatches("/boot/firmware/eeprom.conf", EepromConf)
+	status.Hostname, _ = p.hostnameSettled()
+	status.Network, _ = p.networkMatches()
+	status.ConfigTxt, _ = p.fileMatches("/boot/firmware/config.txt", RenderConfigTxt(p.node.Boot))
+	status.EepromConf, _ = p.fileMatches("/boot/firmware/eeprom.conf", RenderEepromConf(p.node.Boot))
 
 	if current, err := p.sshClient.ReadFile("/boot/firmware/cmdline.txt", true); err == nil {
-		status.Cmdline = p.updateCmdline(current) == strings.TrimSpace(current)
+		status.Cmdline = cmdlineUpToDate(current, p.node.Boot)
 	}
 
-	if _, _, err := p.sshClient.Execute("dpkg -s open-iscsi nfs-common"); err == nil {
+	if _, _, err := p.sshClient.Execute("dpkg -s " + strings.Join(requiredPackages, " ")); err == nil {
 		status.Packages = true
 	}
 
 	return status
 }
-
-// updateCmdline adds cgroup parameters to cmdline.txt if not present
-func (p *Provisioner) updateCmdline(current string) string {
-	current = strings.TrimSpace(current)
-
-	if !strings.Contains(current, "cgroup_memory=1") {
-		current += " cgroup_memory=1"
-	}
-
-	if !strings.Contains(current, "cgroup_enable=memory") {
-		current += " cgroup_enable=memory"
-	}
-
-	return current
-}
@@ -0,0 +1,90 @@
+package raspberry
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/liamawhite/homelab/pkg/config"
+)
+
+// cgroupArgs are the cmdline.txt args k3s needs on every Pi, to enable the
+// memory cgroup controller.
+var cgroupArgs = []string{"cgroup_memory=1", "cgroup_enable=memory"}
+
+// RenderConfigTxt returns the config.txt for a node with boot overrides:
+// ConfigTxt with the node's extra lines appended under an [all] filter, so
+// they aren't scoped to whichever model section ConfigTxt ends in.
+func RenderConfigTxt(boot config.BootConfig) string {
+	if len(boot.ConfigTxt) == 0 {
+		return ConfigTxt
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.TrimRight(ConfigTxt, "\n"))
+	b.WriteString("\n\n[all]\n")
+	for _, line := range boot.ConfigTxt {
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// RenderEepromConf returns the eeprom.conf for a node with boot overrides:
+// EepromConf with each overridden key's value replaced in place, and keys
+// it doesn't set appended, sorted so the result is stable.
+func RenderEepromConf(boot config.BootConfig) string {
+	if len(boot.Eeprom) == 0 {
+		return EepromConf
+	}
+
+	seen := map[string]bool{}
+	var lines []string
+	for _, line := range strings.Split(strings.TrimRight(EepromConf, "\n"), "\n") {
+		key, _, ok := strings.Cut(line, "=")
+		if value, overridden := boot.Eeprom[key]; ok && overridden {
+			line = key + "=" + value
+			seen[key] = true
+		}
+		lines = append(lines, line)
+	}
+
+	var added []string
+	for key := range boot.Eeprom {
+		if !seen[key] {
+			added = append(added, key)
+		}
+	}
+	sort.Strings(added)
+	for _, key := range added {
+		lines = append(lines, key+"="+boot.Eeprom[key])
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// renderCmdline returns current (the node's cmdline.txt) with the cgroup
+// args and the node's extra args added where not already present.
+// cmdline.txt is a single line of space-separated args, so presence is per
+// arg, not a substring match.
+func renderCmdline(current string, boot config.BootConfig) string {
+	args := strings.Fields(current)
+	present := map[string]bool{}
+	for _, arg := range args {
+		present[arg] = true
+	}
+
+	for _, arg := range append(append([]string{}, cgroupArgs...), boot.Cmdline...) {
+		if !present[arg] {
+			args = append(args, arg)
+			present[arg] = true
+		}
+	}
+	return strings.Join(args, " ")
+}
+
+// cmdlineUpToDate reports whether current already has every arg
+// renderCmdline would add. Like renderCmdline it goes arg by arg, so
+// spacing that differs from the rendered line doesn't count as a change.
+func cmdlineUpToDate(current string, boot config.BootConfig) bool {
+	return renderCmdline(current, boot) == strings.Join(strings.Fields(current), " ")
+}
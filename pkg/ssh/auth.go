@@ -0,0 +1,122 @@
+package ssh
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// Credentials is how a Client authenticates as User. Any combination of
+// methods can be configured; all of them are offered, in the order ssh(1)
+// tries them - the agent's keys, then KeyFile, then Password - so e.g. a
+// node with both a key and a password falls back to the password if the
+// key isn't authorized yet.
+type Credentials struct {
+	User     string
+	Password string
+	// KeyFile is a private key path; a leading ~/ is expanded to the
+	// user's home directory.
+	KeyFile string
+	// KeyPassphrase decrypts KeyFile, if it's encrypted.
+	KeyPassphrase string
+	// Agent offers the keys held by the ssh-agent at $SSH_AUTH_SOCK.
+	Agent bool
+}
+
+// Methods names the configured methods, for logging.
+func (c Credentials) Methods() string {
+	var methods []string
+	if c.Agent {
+		methods = append(methods, "agent")
+	}
+	if c.KeyFile != "" {
+		methods = append(methods, "key")
+	}
+	if c.Password != "" {
+		methods = append(methods, "password")
+	}
+	if len(methods) == 0 {
+		return "none"
+	}
+	return strings.Join(methods, ",")
+}
+
+// AuthMethods builds the ssh.AuthMethods for c, along with a func that
+// closes the agent connection they may hold open - call it once the
+// handshake is done, since nothing here forwards the agent afterwards.
+func (c Credentials) AuthMethods() ([]ssh.AuthMethod, func(), error) {
+	var methods []ssh.AuthMethod
+	cleanup := func() {}
+
+	if c.Agent {
+		sock := os.Getenv("SSH_AUTH_SOCK")
+		if sock == "" {
+			return nil, cleanup, fmt.Errorf("ssh agent auth requested but SSH_AUTH_SOCK is not set")
+		}
+		conn, err := net.Dial("unix", sock)
+		if err != nil {
+			return nil, cleanup, fmt.Errorf("failed to connect to ssh agent: %w", err)
+		}
+		cleanup = func() { conn.Close() }
+		methods = append(methods, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+	}
+
+	if c.KeyFile != "" {
+		signer, err := loadKey(c.KeyFile, c.KeyPassphrase)
+		if err != nil {
+			cleanup()
+			return nil, func() {}, err
+		}
+		methods = append(methods, ssh.PublicKeys(signer))
+	}
+
+	if c.Password != "" {
+		methods = append(methods, ssh.Password(c.Password))
+	}
+
+	if len(methods) == 0 {
+		return nil, cleanup, fmt.Errorf("no ssh auth method configured for user %s (set a password, key file or agent)", c.User)
+	}
+	return methods, cleanup, nil
+}
+
+// loadKey reads and parses the private key at path, decrypting it with
+// passphrase if it's encrypted.
+func loadKey(path, passphrase string) (ssh.Signer, error) {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to expand %s: %w", path, err)
+		}
+		path = filepath.Join(home, rest)
+	}
+
+	key, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key: %w", err)
+	}
+
+	if passphrase != "" {
+		signer, err := ssh.ParsePrivateKeyWithPassphrase(key, []byte(passphrase))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt private key %s: %w", path, err)
+		}
+		return signer, nil
+	}
+
+	signer, err := ssh.ParsePrivateKey(key)
+	var missing *ssh.PassphraseMissingError
+	if errors.As(err, &missing) {
+		return nil, fmt.Errorf("private key %s is encrypted but no passphrase is configured", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	return signer, nil
+}
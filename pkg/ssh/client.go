@@ -1,52 +1,37 @@
 package ssh
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net"
-	"os"
+	"strings"
 	"time"
 
 	"golang.org/x/crypto/ssh"
 )
 
 type Client struct {
-	Host       string
-	Port       int
-	User       string
-	AuthMethod ssh.AuthMethod
+	Host        string
+	Port        int
+	Credentials Credentials
+	// QuietExits logs commands that exit non-zero at debug rather than
+	// error, for callers running checks whose non-zero exit is an answer
+	// (test, dpkg -s) rather than a failure.
+	QuietExits bool
 	client     *ssh.Client
 }
 
-// NewClient creates a new SSH client with key authentication
-func NewClient(host, user, keyPath string) (*Client, error) {
-	key, err := os.ReadFile(keyPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read private key: %w", err)
-	}
-
-	signer, err := ssh.ParsePrivateKey(key)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse private key: %w", err)
-	}
-
+// NewClient creates a new SSH client authenticating with creds. Keys and
+// the agent are only loaded on Connect, so a bad key file surfaces there.
+func NewClient(host string, creds Credentials) *Client {
+	slog.Debug("Creating SSH client", "host", host, "user", creds.User, "auth_methods", creds.Methods())
 	return &Client{
-		Host:       host,
-		Port:       22,
-		User:       user,
-		AuthMethod: ssh.PublicKeys(signer),
-	}, nil
-}
-
-// NewClientWithPassword creates a new SSH client with password authentication
-func NewClientWithPassword(host, user, password string) *Client {
-	slog.Debug("Creating SSH client with password authentication", "host", host, "user", user, "password_length", len(password))
-	return &Client{
-		Host:       host,
-		Port:       22,
-		User:       user,
-		AuthMethod: ssh.Password(password),
+		Host:        host,
+		Port:        22,
+		Credentials: creds,
 	}
 }
 
@@ -64,20 +49,21 @@ func (c *Client) Connect(ctx context.Context) error {
 	conn.Close()
 	slog.Info("Host is reachable", "address", addr)
 
-	// Create a host key callback that logs but accepts all keys
-	hostKeyCallback := func(hostname string, remote net.Addr, key ssh.PublicKey) error {
-		slog.Debug("Accepting host key", "hostname", hostname, "key_type", key.Type(), "fingerprint", ssh.FingerprintSHA256(key))
-		return nil
+	auth, closeAgent, err := c.Credentials.AuthMethods()
+	if err != nil {
+		return err
 	}
+	defer closeAgent()
 
+	// Host keys are pinned trust-on-first-use (see KnownHosts)
 	config := &ssh.ClientConfig{
-		User:            c.User,
-		Auth:            []ssh.AuthMethod{c.AuthMethod},
-		HostKeyCallback: hostKeyCallback,
+		User:            c.Credentials.User,
+		Auth:            auth,
+		HostKeyCallback: HostKeyCallback(),
 		Timeout:         10 * time.Second,
 	}
 
-	slog.Info("Attempting SSH connection", "address", addr, "user", c.User, "timeout", "10s", "host_key_verification", "disabled")
+	slog.Info("Attempting SSH connection", "address", addr, "user", c.Credentials.User, "auth_methods", c.Credentials.Methods(), "timeout", "10s")
 
 	// Retry with exponential backoff
 	for i := 0; i < 3; i++ {
@@ -85,10 +71,17 @@ func (c *Client) Connect(ctx context.Context) error {
 
 		c.client, err = ssh.Dial("tcp", addr, config)
 		if err == nil {
-			slog.Info("SSH connection successful", "address", addr, "user", c.User)
+			slog.Info("SSH connection successful", "address", addr, "user", c.Credentials.User)
 			return nil
 		}
 
+		// A changed host key won't fix itself on retry
+		var mismatch *HostKeyMismatchError
+		if errors.As(err, &mismatch) {
+			slog.Error("SSH host key verification failed", "address", addr, "error", err.Error())
+			return err
+		}
+
 		slog.Warn("SSH connection attempt failed", "attempt", i+1, "error", err.Error())
 
 		if i < 2 {
@@ -98,12 +91,14 @@ func (c *Client) Connect(ctx context.Context) error {
 		}
 	}
 
-	slog.Error("SSH connection failed after all attempts", "address", addr, "user", c.User, "final_error", err.Error())
+	slog.Error("SSH connection failed after all attempts", "address", addr, "user", c.Credentials.User, "final_error", err.Error())
 
 	return fmt.Errorf("failed to connect after 3 attempts: %w", err)
 }
 
-// Execute runs a command over SSH
+// Execute runs a command over SSH, capturing its stdout and stderr
+// separately. If it fails, the error includes stderr; use ExitCode to get
+// its exit status.
 func (c *Client) Execute(cmd string) (stdout, stderr string, err error) {
 	session, err := c.client.NewSession()
 	if err != nil {
@@ -111,49 +106,48 @@ func (c *Client) Execute(cmd string) (stdout, stderr string, err error) {
 	}
 	defer session.Close()
 
-	var stdoutBuf, stderrBuf []byte
-	stdoutBuf, err = session.CombinedOutput(cmd)
-	if err != nil {
-		slog.Error("Command execution failed", "error", err.Error(), "output", string(stdoutBuf))
-		return string(stdoutBuf), "", fmt.Errorf("command failed: %w", err)
+	var stdoutBuf, stderrBuf bytes.Buffer
+	session.Stdout = &stdoutBuf
+	session.Stderr = &stderrBuf
+	if err := session.Run(cmd); err != nil {
+		level := slog.LevelError
+		var exitErr *ssh.ExitError
+		if c.QuietExits && errors.As(err, &exitErr) {
+			level = slog.LevelDebug
+		}
+		slog.Log(context.Background(), level, "Command execution failed", "error", err.Error(), "stdout", stdoutBuf.String(), "stderr", stderrBuf.String())
+		if msg := strings.TrimSpace(stderrBuf.String()); msg != "" {
+			err = fmt.Errorf("%w: %s", err, msg)
+		}
+		return stdoutBuf.String(), stderrBuf.String(), fmt.Errorf("command failed: %w", err)
 	}
 
-	return string(stdoutBuf), string(stderrBuf), nil
-}
-
-// ExecuteSudo runs a command with sudo
-func (c *Client) ExecuteSudo(cmd string) (stdout, stderr string, err error) {
-	return c.Execute("sudo " + cmd)
+	return stdoutBuf.String(), stderrBuf.String(), nil
 }
 
-// WriteFile writes content to a file via SSH
-func (c *Client) WriteFile(path, content string, useSudo bool) error {
-	// Use heredoc to safely handle any content including quotes and special characters
-	var cmd string
-	if useSudo {
-		cmd = fmt.Sprintf("sudo tee %s > /dev/null <<'EOFMARKER'\n%s\nEOFMARKER", path, content)
-	} else {
-		cmd = fmt.Sprintf("cat > %s <<'EOFMARKER'\n%s\nEOFMARKER", path, content)
+// ExitCode returns the exit status of the remote command that produced
+// err (as returned by Execute): 0 for a nil err, and -1 if the command
+// never reported one - it couldn't be started, or the connection dropped.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
 	}
-
-	stdout, _, err := c.Execute(cmd)
-	if err != nil {
-		slog.Error("Failed to write file", "path", path, "error", err.Error(), "output", stdout)
+	var exitErr *ssh.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitStatus()
 	}
-	return err
+	return -1
 }
 
-// ReadFile reads a file via SSH
-func (c *Client) ReadFile(path string, useSudo bool) (string, error) {
-	var cmd string
-	if useSudo {
-		cmd = fmt.Sprintf("sudo cat %s", path)
-	} else {
-		cmd = fmt.Sprintf("cat %s", path)
-	}
+// ShellQuote single-quotes s for a POSIX shell, for building commands to
+// Execute out of arbitrary strings.
+func ShellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
 
-	stdout, _, err := c.Execute(cmd)
-	return stdout, err
+// ExecuteSudo runs a command with sudo
+func (c *Client) ExecuteSudo(cmd string) (stdout, stderr string, err error) {
+	return c.Execute("sudo " + cmd)
 }
 
 // Reboot reboots the remote machine
@@ -166,6 +160,16 @@ func (c *Client) Reboot() error {
 	return nil
 }
 
+// PowerOff shuts the machine down. Like Reboot, the connection dropping as
+// it goes down isn't an error.
+func (c *Client) PowerOff() error {
+	_, _, err := c.ExecuteSudo("poweroff")
+	if err != nil && !isConnectionError(err) {
+		return err
+	}
+	return nil
+}
+
 // WaitForReboot waits for the machine to come back online after reboot
 func (c *Client) WaitForReboot(timeout time.Duration) error {
 	deadline := time.Now().Add(timeout)
@@ -193,6 +197,36 @@ func (c *Client) WaitForReboot(timeout time.Duration) error {
 	return fmt.Errorf("timeout waiting for reboot")
 }
 
+// BootID returns the kernel's random ID for the machine's current boot,
+// which changes every time it boots - for WaitForNewBoot.
+func (c *Client) BootID() (string, error) {
+	out, _, err := c.Execute("cat /proc/sys/kernel/random/boot_id")
+	if err != nil {
+		return "", fmt.Errorf("failed to read boot ID: %w", err)
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// WaitForNewBoot is WaitForReboot for a machine whose BootID was bootID
+// before rebooting: it only returns once it's reconnected to a later boot,
+// so a machine that's slow to go down can't be mistaken for one that's
+// already back.
+func (c *Client) WaitForNewBoot(bootID string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		if err := c.WaitForReboot(time.Until(deadline)); err != nil {
+			return err
+		}
+		if id, err := c.BootID(); err == nil && id != bootID {
+			return nil
+		}
+		c.Close()
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timeout waiting for reboot")
+		}
+	}
+}
+
 // Close closes the SSH connection
 func (c *Client) Close() error {
 	if c.client != nil {
@@ -0,0 +1,267 @@
+package ssh
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// KnownHosts pins each host's SSH host key in an OpenSSH-format
+// known_hosts file, trust-on-first-use: the first connection to a host
+// records its key, and every later connection must present the same one.
+// A changed key fails the connection with a *HostKeyMismatchError rather
+// than silently re-pinning - re-keying (e.g. after reflashing a node's SD
+// card) is a deliberate Trust call instead.
+type KnownHosts struct {
+	// Path is the known_hosts file; it's created on first use.
+	Path string
+	// Aliases maps an address that floats between hosts - the cluster
+	// VIP, which kube-vip moves to whichever node currently leads - to
+	// the addresses it may land on. A connection to an alias is accepted
+	// if the key matches any of those hosts' pinned keys, and is never
+	// pinned itself, since the key behind it legitimately changes.
+	Aliases map[string][]string
+
+	mu sync.Mutex
+}
+
+// HostKeyMismatchError is returned (wrapped) from Connect when a host
+// presents a different key from the one pinned for it.
+type HostKeyMismatchError struct {
+	Host   string
+	Pinned []string
+	Got    string
+}
+
+func (e *HostKeyMismatchError) Error() string {
+	if len(e.Pinned) == 0 {
+		return fmt.Sprintf("host key %s for %s matches none of the keys pinned for the hosts it aliases - connect to those hosts directly first to pin them", e.Got, e.Host)
+	}
+	return fmt.Sprintf("host key for %s has changed (pinned %s, got %s) - if the host was reinstalled, re-pin it with `homelab node trust`; otherwise something may be intercepting the connection", e.Host, strings.Join(e.Pinned, ", "), e.Got)
+}
+
+var (
+	defaultKnownHostsMu sync.Mutex
+	defaultKnownHosts   *KnownHosts
+)
+
+// UseKnownHosts sets the KnownHosts every Client (and pkg/probe's SSH
+// check) verifies host keys against. Until it's called, HostKeyCallback
+// rejects every host rather than falling back to accepting any key.
+func UseKnownHosts(kh *KnownHosts) {
+	defaultKnownHostsMu.Lock()
+	defer defaultKnownHostsMu.Unlock()
+	defaultKnownHosts = kh
+}
+
+// HostKeyCallback returns the callback for the KnownHosts set by
+// UseKnownHosts.
+func HostKeyCallback() ssh.HostKeyCallback {
+	defaultKnownHostsMu.Lock()
+	kh := defaultKnownHosts
+	defaultKnownHostsMu.Unlock()
+
+	if kh == nil {
+		return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			return fmt.Errorf("no known_hosts file configured to verify %s's host key against", hostname)
+		}
+	}
+	return kh.HostKeyCallback()
+}
+
+// HostKeyCallback verifies a host's key against Path, pinning it if the
+// host has never been seen before.
+func (k *KnownHosts) HostKeyCallback() ssh.HostKeyCallback {
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		k.mu.Lock()
+		defer k.mu.Unlock()
+
+		pinned, err := k.load()
+		if err != nil {
+			return err
+		}
+
+		host, port := splitHostPort(hostname)
+		fingerprint := ssh.FingerprintSHA256(key)
+
+		if aliased, ok := k.Aliases[host]; ok {
+			var want []ssh.PublicKey
+			for _, a := range aliased {
+				want = append(want, pinned[knownhosts.Normalize(net.JoinHostPort(a, port))]...)
+			}
+			if containsKey(want, key) {
+				slog.Debug("Host key matches an aliased host's pinned key", "host", host, "fingerprint", fingerprint)
+				return nil
+			}
+			return &HostKeyMismatchError{Host: host, Got: fingerprint}
+		}
+
+		entry := knownhosts.Normalize(hostname)
+		if want := pinned[entry]; len(want) > 0 {
+			if containsKey(want, key) {
+				slog.Debug("Host key matches pinned key", "host", host, "fingerprint", fingerprint)
+				return nil
+			}
+			return &HostKeyMismatchError{Host: host, Pinned: fingerprints(want), Got: fingerprint}
+		}
+
+		slog.Warn("Pinning host key on first connection", "host", host, "key_type", key.Type(), "fingerprint", fingerprint, "known_hosts", k.Path)
+		return k.write(entry, key, false)
+	}
+}
+
+// Trust fetches host's current key and pins it, replacing whatever was
+// pinned before - returning the previously pinned fingerprints (if any)
+// and the new one, so the caller can show what changed.
+func (k *KnownHosts) Trust(host string, port int) (previous []string, current string, err error) {
+	key, err := FetchHostKey(host, port)
+	if err != nil {
+		return nil, "", err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	pinned, err := k.load()
+	if err != nil {
+		return nil, "", err
+	}
+
+	entry := knownhosts.Normalize(net.JoinHostPort(host, strconv.Itoa(port)))
+	if err := k.write(entry, key, true); err != nil {
+		return nil, "", err
+	}
+	return fingerprints(pinned[entry]), ssh.FingerprintSHA256(key), nil
+}
+
+// errHostKeyCaptured aborts FetchHostKey's handshake once the key has been
+// seen - there's no need to authenticate just to read it.
+var errHostKeyCaptured = errors.New("host key captured")
+
+// FetchHostKey returns the key host presents, without verifying or
+// pinning it.
+func FetchHostKey(host string, port int) (ssh.PublicKey, error) {
+	var captured ssh.PublicKey
+	config := &ssh.ClientConfig{
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			captured = key
+			return errHostKeyCaptured
+		},
+		Timeout: 10 * time.Second,
+	}
+
+	client, err := ssh.Dial("tcp", net.JoinHostPort(host, strconv.Itoa(port)), config)
+	if client != nil {
+		client.Close()
+	}
+	if captured == nil {
+		return nil, fmt.Errorf("failed to fetch host key from %s: %w", host, err)
+	}
+	return captured, nil
+}
+
+// load parses Path into pinned keys by (normalized) host, treating a
+// missing file as empty.
+func (k *KnownHosts) load() (map[string][]ssh.PublicKey, error) {
+	data, err := os.ReadFile(k.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string][]ssh.PublicKey{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", k.Path, err)
+	}
+
+	pinned := make(map[string][]ssh.PublicKey)
+	for len(data) > 0 {
+		_, hosts, key, _, rest, err := ssh.ParseKnownHosts(data)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", k.Path, err)
+		}
+		for _, h := range hosts {
+			pinned[h] = append(pinned[h], key)
+		}
+		data = rest
+	}
+	return pinned, nil
+}
+
+// write appends a line pinning key for entry to Path, first dropping any
+// existing lines for entry if replace is set.
+func (k *KnownHosts) write(entry string, key ssh.PublicKey, replace bool) error {
+	data, err := os.ReadFile(k.Path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read %s: %w", k.Path, err)
+	}
+
+	var out bytes.Buffer
+	for _, line := range strings.SplitAfter(string(data), "\n") {
+		if line == "" {
+			continue
+		}
+		if replace {
+			if _, hosts, _, _, _, err := ssh.ParseKnownHosts([]byte(line)); err == nil && hasHost(hosts, entry) {
+				continue
+			}
+		}
+		out.WriteString(line)
+		if !strings.HasSuffix(line, "\n") {
+			out.WriteString("\n")
+		}
+	}
+	out.WriteString(knownhosts.Line([]string{entry}, key) + "\n")
+
+	if err := os.WriteFile(k.Path, out.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", k.Path, err)
+	}
+	return nil
+}
+
+// splitHostPort splits a "host:port" callback hostname, defaulting the
+// port to 22 if there isn't one.
+func splitHostPort(hostname string) (host, port string) {
+	host, port, err := net.SplitHostPort(hostname)
+	if err != nil {
+		return hostname, "22"
+	}
+	return host, port
+}
+
+func hasHost(hosts []string, entry string) bool {
+	for _, h := range hosts {
+		if h == entry {
+			return true
+		}
+	}
+	return false
+}
+
+func containsKey(keys []ssh.PublicKey, key ssh.PublicKey) bool {
+	for _, k := range keys {
+		if bytes.Equal(k.Marshal(), key.Marshal()) {
+			return true
+		}
+	}
+	return false
+}
+
+func fingerprints(keys []ssh.PublicKey) []string {
+	out := make([]string, len(keys))
+	for i, k := range keys {
+		out[i] = ssh.FingerprintSHA256(k)
+	}
+	return out
+}
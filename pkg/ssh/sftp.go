@@ -0,0 +1,243 @@
+package ssh
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/sftp"
+)
+
+// File transfers go over SFTP rather than through a shell, so any content -
+// binary, or containing whatever a heredoc would use as its end marker -
+// arrives byte for byte. SFTP runs as the login user, so a transfer with
+// useSudo is staged through a temp file the user owns - private to them,
+// as it may hold secrets - and a privileged install moves it into (or out
+// of) place.
+
+// FileInfo describes a remote file.
+type FileInfo struct {
+	Size int64
+	Mode os.FileMode
+	UID  int
+	GID  int
+}
+
+// WriteFile writes content to the remote file at path - text, so a
+// trailing newline is added if it lacks one. An existing file keeps its
+// mode (and, with useSudo, its ownership); a new one is created 0644,
+// owned by the login user - or root, with useSudo. See Upload.
+func (c *Client) WriteFile(path, content string, useSudo bool) error {
+	if !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+
+	mode, owner := os.FileMode(0644), ""
+	info, err := c.Stat(path, useSudo)
+	switch {
+	case err == nil:
+		mode = info.Mode.Perm()
+		if useSudo {
+			owner = fmt.Sprintf("%d:%d", info.UID, info.GID)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return err
+	}
+	return c.Upload(strings.NewReader(content), path, mode, owner, useSudo)
+}
+
+// ReadFile returns the contents of the remote file at path. See Download.
+func (c *Client) ReadFile(path string, useSudo bool) (string, error) {
+	var buf bytes.Buffer
+	if err := c.Download(path, &buf, useSudo); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Upload streams r into the remote file at path, replacing any existing
+// one, then checks the file's SHA-256 on the node matches what was sent.
+// The file is given mode, and owner - "user" or "user:group", by name or
+// ID - which needs useSudo; empty leaves it owned by the login user, or
+// root with useSudo.
+func (c *Client) Upload(r io.Reader, path string, mode os.FileMode, owner string, useSudo bool) error {
+	if owner != "" && !useSudo {
+		return fmt.Errorf("failed to upload %s: setting its owner needs sudo", path)
+	}
+
+	client, err := sftp.NewClient(c.client)
+	if err != nil {
+		return fmt.Errorf("failed to start SFTP session: %w", err)
+	}
+	defer client.Close()
+
+	target := path
+	if useSudo {
+		if target, err = c.tempFile(); err != nil {
+			return err
+		}
+		defer c.Execute("rm -f " + ShellQuote(target))
+	}
+
+	hash := sha256.New()
+	if err := writeRemote(client, target, io.TeeReader(r, hash)); err != nil {
+		return fmt.Errorf("failed to upload %s: %w", path, err)
+	}
+
+	if useSudo {
+		install := fmt.Sprintf("install -m %04o", mode.Perm())
+		if owner != "" {
+			user, group, _ := strings.Cut(owner, ":")
+			install += " -o " + ShellQuote(user)
+			if group != "" {
+				install += " -g " + ShellQuote(group)
+			}
+		}
+		if _, _, err := c.ExecuteSudo(fmt.Sprintf("%s %s %s", install, ShellQuote(target), ShellQuote(path))); err != nil {
+			return fmt.Errorf("failed to move upload into place at %s: %w", path, err)
+		}
+	} else if err := client.Chmod(path, mode.Perm()); err != nil {
+		return fmt.Errorf("failed to set mode of %s: %w", path, err)
+	}
+
+	return c.verifyChecksum(path, hex.EncodeToString(hash.Sum(nil)), useSudo)
+}
+
+// Download streams the remote file at path into w.
+func (c *Client) Download(path string, w io.Writer, useSudo bool) error {
+	client, err := sftp.NewClient(c.client)
+	if err != nil {
+		return fmt.Errorf("failed to start SFTP session: %w", err)
+	}
+	defer client.Close()
+
+	source := path
+	if useSudo {
+		if source, err = c.tempFile(); err != nil {
+			return err
+		}
+		defer c.Execute("rm -f " + ShellQuote(source))
+		// $(id -u)/$(id -g) expand in the login user's shell, before sudo,
+		// so the copy ends up readable by the SFTP session.
+		if _, _, err := c.Execute(fmt.Sprintf("sudo install -m 0600 -o $(id -u) -g $(id -g) %s %s", ShellQuote(path), ShellQuote(source))); err != nil {
+			return fmt.Errorf("failed to stage %s for download: %w", path, err)
+		}
+	}
+
+	f, err := client.Open(source)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteTo(w); err != nil {
+		return fmt.Errorf("failed to download %s: %w", path, err)
+	}
+	return nil
+}
+
+// Stat describes the remote file at path, or returns an error wrapping
+// os.ErrNotExist if there's no such file. useSudo is needed for files in
+// directories the login user can't read.
+func (c *Client) Stat(path string, useSudo bool) (*FileInfo, error) {
+	if useSudo {
+		out, _, err := c.ExecuteSudo("stat -c '%s %f %u %g' " + ShellQuote(path))
+		if err != nil {
+			// stat's own message is localized, so ask test instead.
+			if _, _, testErr := c.ExecuteSudo("test -e " + ShellQuote(path)); ExitCode(testErr) == 1 {
+				err = os.ErrNotExist
+			}
+			return nil, fmt.Errorf("failed to stat %s: %w", path, err)
+		}
+		return parseStat(out)
+	}
+
+	client, err := sftp.NewClient(c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start SFTP session: %w", err)
+	}
+	defer client.Close()
+
+	fi, err := client.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+	info := &FileInfo{Size: fi.Size(), Mode: fi.Mode()}
+	if st, ok := fi.Sys().(*sftp.FileStat); ok {
+		info.UID = int(st.UID)
+		info.GID = int(st.GID)
+	}
+	return info, nil
+}
+
+// writeRemote writes r to path over client, truncating path if it exists.
+func writeRemote(client *sftp.Client, path string, r io.Reader) error {
+	f, err := client.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return err
+	}
+	if _, err := f.ReadFrom(r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// tempFile creates an empty temp file on the node, owned by the login
+// user.
+func (c *Client) tempFile() (string, error) {
+	out, _, err := c.Execute("mktemp")
+	if err != nil {
+		return "", fmt.Errorf("failed to create remote temp file: %w", err)
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// verifyChecksum checks the SHA-256 of the remote file at path is want.
+func (c *Client) verifyChecksum(path, want string, useSudo bool) error {
+	cmd := "sha256sum " + ShellQuote(path)
+	if useSudo {
+		cmd = "sudo " + cmd
+	}
+	out, _, err := c.Execute(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to checksum %s: %w", path, err)
+	}
+	fields := strings.Fields(out)
+	if len(fields) == 0 || fields[0] != want {
+		return fmt.Errorf("checksum mismatch after uploading %s: sent %s, node has %q", path, want, strings.TrimSpace(out))
+	}
+	return nil
+}
+
+// parseStat parses `stat -c '%s %f %u %g'` output: size, raw mode in hex,
+// uid and gid.
+func parseStat(out string) (*FileInfo, error) {
+	fields := strings.Fields(out)
+	if len(fields) != 4 {
+		return nil, fmt.Errorf("unexpected stat output %q", out)
+	}
+	size, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected stat size %q", fields[0])
+	}
+	rawMode, err := strconv.ParseUint(fields[1], 16, 32)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected stat mode %q", fields[1])
+	}
+	uid, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return nil, fmt.Errorf("unexpected stat uid %q", fields[2])
+	}
+	gid, err := strconv.Atoi(fields[3])
+	if err != nil {
+		return nil, fmt.Errorf("unexpected stat gid %q", fields[3])
+	}
+	return &FileInfo{Size: size, Mode: (&sftp.FileStat{Mode: uint32(rawMode)}).FileMode(), UID: uid, GID: gid}, nil
+}
@@ -3,6 +3,12 @@
 package versions
 
 const (
+	// K3s is passed to get.k3s.io as INSTALL_K3S_VERSION by
+	// pkg/k3s.Installer, for both fresh installs and `homelab k3s upgrade`.
+	// os/modules/k3s.nix pins the NixOS node's nixpkgs k3s_1_NN attribute
+	// by hand to match - bump both together.
+	K3s = "v1.36.2+k3s1"
+
 	KubeVip    = "v1.2.1"
 	GatewayAPI = "v1.6.0"
 	Istio      = "1.30.2"
@@ -19,4 +25,6 @@ const (
 	// KubeRBACProxy is shared by prometheus-operator's and node-exporter's
 	// kube-rbac-proxy sidecars.
 	KubeRBACProxy = "0.19.1"
+	// Busybox is pi-exporter's image (httpd serving a CGI script).
+	Busybox = "1.37.0"
 )
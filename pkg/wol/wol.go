@@ -0,0 +1,54 @@
+// Package wol sends Wake-on-LAN magic packets.
+package wol
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+)
+
+// DefaultBroadcast is the limited broadcast address, which reaches every
+// host on the local network segment.
+const DefaultBroadcast = "255.255.255.255"
+
+// port is the conventional "discard" port magic packets are sent to; the
+// receiving NIC matches on the payload, not the port.
+const port = "9"
+
+// MagicPacket returns the Wake-on-LAN payload for mac: six 0xFF bytes
+// followed by the MAC address repeated sixteen times.
+func MagicPacket(mac string) ([]byte, error) {
+	hw, err := net.ParseMAC(mac)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MAC address %q: %w", mac, err)
+	}
+	if len(hw) != 6 {
+		return nil, fmt.Errorf("invalid MAC address %q: Wake-on-LAN needs a 48-bit address", mac)
+	}
+
+	packet := bytes.Repeat([]byte{0xff}, 6)
+	for range 16 {
+		packet = append(packet, hw...)
+	}
+	return packet, nil
+}
+
+// Send broadcasts a magic packet for mac to broadcast (e.g.
+// DefaultBroadcast, or a subnet's directed broadcast address).
+func Send(mac, broadcast string) error {
+	packet, err := MagicPacket(mac)
+	if err != nil {
+		return err
+	}
+
+	conn, err := net.Dial("udp4", net.JoinHostPort(broadcast, port))
+	if err != nil {
+		return fmt.Errorf("failed to open UDP socket to %s: %w", broadcast, err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write(packet); err != nil {
+		return fmt.Errorf("failed to send magic packet to %s: %w", broadcast, err)
+	}
+	return nil
+}